@@ -0,0 +1,102 @@
+package comparator
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+)
+
+/**
+ *
+ * @Author AiTao
+ * @Date 2026-10-21 09:40
+ * @Url
+ **/
+
+// BigInt 函数用于比较两个 *big.Int 或 big.Int 类型数据, 值为 nil 的指针小于任何数值.
+//
+// Example:
+// BigInt(big.NewInt(1), big.NewInt(2)) 返回 -1
+func BigInt(x, y interface{}) int {
+	a, b := bigArg(x).(*big.Int), bigArg(y).(*big.Int)
+	if a == nil || b == nil {
+		return compareNil(a == nil, b == nil)
+	}
+	return a.Cmp(b)
+}
+
+// BigFloat 函数用于比较两个 *big.Float 或 big.Float 类型数据, 比较结果与精度无关, 值为 nil 的指针小于任何数值.
+func BigFloat(x, y interface{}) int {
+	a, b := bigArg(x).(*big.Float), bigArg(y).(*big.Float)
+	if a == nil || b == nil {
+		return compareNil(a == nil, b == nil)
+	}
+	return a.Cmp(b)
+}
+
+// BigRat 函数用于比较两个 *big.Rat 或 big.Rat 类型数据, 值为 nil 的指针小于任何数值.
+func BigRat(x, y interface{}) int {
+	a, b := bigArg(x).(*big.Rat), bigArg(y).(*big.Rat)
+	if a == nil || b == nil {
+		return compareNil(a == nil, b == nil)
+	}
+	return a.Cmp(b)
+}
+
+// bigArg 函数用于将 math/big 中的数值类型统一转换为指针.
+func bigArg(x interface{}) interface{} {
+	switch v := x.(type) {
+	case big.Int:
+		return &v
+	case big.Float:
+		return &v
+	case big.Rat:
+		return &v
+	case *big.Int, *big.Float, *big.Rat:
+		return v
+	default:
+		panic(fmt.Sprintf("illegal argument: %T is not a math/big number", x))
+	}
+}
+
+func compareNil(x, y bool) int {
+	switch {
+	case x == y:
+		return 0
+	case x:
+		return -1
+	default:
+		return 1
+	}
+}
+
+var (
+	bigIntValueType   = bigIntType.Elem()
+	bigFloatValueType = bigFloatType.Elem()
+	bigRatValueType   = bigRatType.Elem()
+)
+
+// isBigValue 函数用于判断 t 是否为 big.Int、big.Float 或 big.Rat.
+func isBigValue(t reflect.Type) bool {
+	return t == bigIntValueType || t == bigFloatValueType || t == bigRatValueType
+}
+
+// compareBig 函数用于使用 Cmp 方法比较两个 big.Int、big.Float 或 big.Rat 类型的值, 当 va 不是这些类型时返回 false.
+func compareBig(va, vb reflect.Value) (int, bool) {
+	if !isBigValue(va.Type()) || va.Type() != vb.Type() {
+		return invalid, false
+	}
+	pa, ok1 := pointerOf(va)
+	pb, ok2 := pointerOf(vb)
+	if !ok1 || !ok2 {
+		return invalid, false
+	}
+	switch x := pa.Interface().(type) {
+	case *big.Int:
+		return fromResult(x.Cmp(pb.Interface().(*big.Int))), true
+	case *big.Float:
+		return fromResult(x.Cmp(pb.Interface().(*big.Float))), true
+	default:
+		return fromResult(x.(*big.Rat).Cmp(pb.Interface().(*big.Rat))), true
+	}
+}
@@ -0,0 +1,96 @@
+package comparator
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+)
+
+/**
+ *
+ * @Author AiTao
+ * @Date 2026-10-21 09:40
+ * @Url
+ **/
+
+func TestBigComparators(t *testing.T) {
+	huge, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+	tests := []struct {
+		name string
+		cmp  Type
+		x, y interface{}
+		want int
+	}{
+		{"int less", BigInt, big.NewInt(1), big.NewInt(2), -1},
+		{"int huge", BigInt, huge, big.NewInt(1), 1},
+		{"int value", BigInt, *big.NewInt(3), big.NewInt(3), 0},
+		{"int nil", BigInt, (*big.Int)(nil), big.NewInt(0), -1},
+		{"float precision", BigFloat, new(big.Float).SetPrec(200).SetFloat64(1.5), big.NewFloat(1.5), 0},
+		{"float inf", BigFloat, new(big.Float).SetInf(true), big.NewFloat(-1e300), -1},
+		{"rat", BigRat, big.NewRat(1, 3), big.NewRat(2, 6), 0},
+		{"rat less", BigRat, big.NewRat(1, 3), big.NewRat(1, 2), -1},
+	}
+	for _, tt := range tests {
+		if got := tt.cmp(tt.x, tt.y); got != tt.want {
+			t.Errorf("%s: got %d, want %d", tt.name, got, tt.want)
+		}
+	}
+}
+
+type ledger struct {
+	Name    string
+	Balance *big.Int
+	Rate    big.Rat
+	limit   big.Int
+	scale   *big.Float
+}
+
+func TestCompareBig(t *testing.T) {
+	newLedger := func(balance int64, rate *big.Rat, limit int64, scale float64) ledger {
+		a := ledger{Name: "a", Balance: big.NewInt(balance), Rate: *rate, scale: big.NewFloat(scale)}
+		a.limit.SetInt64(limit)
+		return a
+	}
+	tests := []struct {
+		name string
+		x, y interface{}
+		want int
+	}{
+		{"pointer", big.NewInt(1), big.NewInt(2), -1},
+		{"value", *big.NewRat(1, 2), *big.NewRat(2, 4), 0},
+		{"float", big.NewFloat(2), new(big.Float).SetPrec(10).SetInt64(2), 0},
+		{"negative", big.NewInt(-5), big.NewInt(3), -1},
+		{"slice", []*big.Int{big.NewInt(1), big.NewInt(9)}, []*big.Int{big.NewInt(1), big.NewInt(10)}, -1},
+		{"map", map[string]big.Int{"a": *big.NewInt(7)}, map[string]big.Int{"a": *big.NewInt(7)}, 0},
+		{"struct equal", newLedger(1, big.NewRat(1, 2), 3, 0.5), newLedger(1, big.NewRat(2, 4), 3, 0.5), 0},
+		{"struct balance", newLedger(2, big.NewRat(1, 2), 3, 0.5), newLedger(10, big.NewRat(1, 2), 3, 0.5), -1},
+		{"struct rate", newLedger(1, big.NewRat(2, 3), 3, 0.5), newLedger(1, big.NewRat(1, 2), 3, 0.5), 1},
+		{"unexported", newLedger(1, big.NewRat(1, 2), 30, 0.5), newLedger(1, big.NewRat(1, 2), 4, 0.5), 1},
+		{"unexported pointer", newLedger(1, big.NewRat(1, 2), 3, 0.25), newLedger(1, big.NewRat(1, 2), 3, 0.5), -1},
+		{"struct pointer", &[]ledger{newLedger(1, big.NewRat(1, 2), 3, 0.5)}[0], &[]ledger{newLedger(1, big.NewRat(1, 2), 3, 0.5)}[0], 0},
+	}
+	for _, tt := range tests {
+		if got := Compare(tt.x, tt.y); got != tt.want {
+			t.Errorf("%s: Compare() = %d, want %d", tt.name, got, tt.want)
+		}
+	}
+	if !Equals(newLedger(1, big.NewRat(1, 2), 3, 0.5), newLedger(1, big.NewRat(1, 2), 3, 0.5)) {
+		t.Error("Equals(ledger) = false, want true")
+	}
+	if ds := Diff(big.NewInt(1), big.NewInt(2)); len(ds) != 1 || ds[0].Path != "" {
+		t.Errorf("Diff(*big.Int) = %v, want a single change at the root", ds)
+	}
+}
+
+type wrapped struct {
+	inner struct{ at json.Number }
+}
+
+func TestCompareUnexportedStruct(t *testing.T) {
+	// 不可导出字段中的结构体不能触发 panic
+	var x, y wrapped
+	x.inner.at, y.inner.at = "1", "2"
+	if got := Compare(x, y); got != -1 {
+		t.Errorf("Compare() = %d, want -1", got)
+	}
+}
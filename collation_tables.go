@@ -0,0 +1,2104 @@
+// Code generated by go run ./internal/gen/collation. DO NOT EDIT.
+
+package comparator
+
+// 本文件中的数据来源于 Unicode 排序算法的 DUCET 13.0.0(allkeys.txt) 以及 Perl v5.36.0 中 Unicode::Collate 提供的
+// CLDR 语言区域定制规则.
+
+// ducet 为 DUCET 中拉丁文、希腊文、西里尔文以及常用标点符号的排序元素, 每个排序元素按照
+// 主权重(16位)、次权重(11位)、第三权重(5位)的顺序打包为一个 uint32.
+var ducet = map[string][]uint32{
+	"\u0000":       {0x00000000},
+	"\u0001":       {0x00000000},
+	"\u0002":       {0x00000000},
+	"\u0003":       {0x00000000},
+	"\u0004":       {0x00000000},
+	"\u0005":       {0x00000000},
+	"\u0006":       {0x00000000},
+	"\u0007":       {0x00000000},
+	"\u0008":       {0x00000000},
+	"\u000e":       {0x00000000},
+	"\u000f":       {0x00000000},
+	"\u0010":       {0x00000000},
+	"\u0011":       {0x00000000},
+	"\u0012":       {0x00000000},
+	"\u0013":       {0x00000000},
+	"\u0014":       {0x00000000},
+	"\u0015":       {0x00000000},
+	"\u0016":       {0x00000000},
+	"\u0017":       {0x00000000},
+	"\u0018":       {0x00000000},
+	"\u0019":       {0x00000000},
+	"\u001a":       {0x00000000},
+	"\u001b":       {0x00000000},
+	"\u001c":       {0x00000000},
+	"\u001d":       {0x00000000},
+	"\u001e":       {0x00000000},
+	"\u001f":       {0x00000000},
+	"\u007f":       {0x00000000},
+	"\u0080":       {0x00000000},
+	"\u0081":       {0x00000000},
+	"\u0082":       {0x00000000},
+	"\u0083":       {0x00000000},
+	"\u0084":       {0x00000000},
+	"\u0086":       {0x00000000},
+	"\u0087":       {0x00000000},
+	"\u0088":       {0x00000000},
+	"\u0089":       {0x00000000},
+	"\u008a":       {0x00000000},
+	"\u008b":       {0x00000000},
+	"\u008c":       {0x00000000},
+	"\u008d":       {0x00000000},
+	"\u008e":       {0x00000000},
+	"\u008f":       {0x00000000},
+	"\u0090":       {0x00000000},
+	"\u0091":       {0x00000000},
+	"\u0092":       {0x00000000},
+	"\u0093":       {0x00000000},
+	"\u0094":       {0x00000000},
+	"\u0095":       {0x00000000},
+	"\u0096":       {0x00000000},
+	"\u0097":       {0x00000000},
+	"\u0098":       {0x00000000},
+	"\u0099":       {0x00000000},
+	"\u009a":       {0x00000000},
+	"\u009b":       {0x00000000},
+	"\u009c":       {0x00000000},
+	"\u009d":       {0x00000000},
+	"\u009e":       {0x00000000},
+	"\u009f":       {0x00000000},
+	"\u00ad":       {0x00000000},
+	"\u200b":       {0x00000000},
+	"\u200c":       {0x00000000},
+	"\u200d":       {0x00000000},
+	"\u200e":       {0x00000000},
+	"\u200f":       {0x00000000},
+	"\u202a":       {0x00000000},
+	"\u202b":       {0x00000000},
+	"\u202c":       {0x00000000},
+	"\u202d":       {0x00000000},
+	"\u202e":       {0x00000000},
+	"\u2060":       {0x00000000},
+	"\u2066":       {0x00000000},
+	"\u2067":       {0x00000000},
+	"\u2068":       {0x00000000},
+	"\u2069":       {0x00000000},
+	"\u206a":       {0x00000000},
+	"\u206b":       {0x00000000},
+	"\u206c":       {0x00000000},
+	"\u206d":       {0x00000000},
+	"\u206e":       {0x00000000},
+	"\u206f":       {0x00000000},
+	"\u0009":       {0x02010402},
+	"\u000a":       {0x02020402},
+	"\u000b":       {0x02030402},
+	"\u000c":       {0x02040402},
+	"\u000d":       {0x02050402},
+	"\u0020":       {0x02090402},
+	"!":            {0x02670402},
+	"\u0022":       {0x031d0402},
+	"#":            {0x03ac0402},
+	"%":            {0x03ad0402},
+	"&":            {0x03a90402},
+	"'":            {0x03160402},
+	"(":            {0x03280402},
+	")":            {0x03290402},
+	"*":            {0x03a10402},
+	"+":            {0x06660402},
+	",":            {0x02230402},
+	"-":            {0x020d0402},
+	".":            {0x027e0402},
+	"/":            {0x03a60402},
+	":":            {0x02400402},
+	";":            {0x023a0402},
+	"<":            {0x066a0402},
+	"=":            {0x066b0402},
+	">":            {0x066c0402},
+	"?":            {0x026d0402},
+	"@":            {0x03a00402},
+	"[":            {0x032a0402},
+	"\u005c":       {0x03a70402},
+	"]":            {0x032b0402},
+	"^":            {0x04b70402},
+	"_":            {0x020b0402},
+	"`":            {0x04b40402},
+	"{":            {0x032c0402},
+	"|":            {0x066e0402},
+	"}":            {0x032d0402},
+	"~":            {0x06700402},
+	"\u0085":       {0x02060402},
+	"\u00a0":       {0x0209041b},
+	"\u00a1":       {0x02680402},
+	"\u00a6":       {0x066f0402},
+	"\u00a7":       {0x039a0402},
+	"\u00a8":       {0x04bb0402},
+	"\u00a9":       {0x05d20402},
+	"\u00ab":       {0x03260402},
+	"\u00ac":       {0x066d0402},
+	"\u00ae":       {0x05d40402},
+	"\u00af":       {0x04b80402},
+	"\u00b0":       {0x052a0402},
+	"\u00b1":       {0x06670402},
+	"\u00b4":       {0x04b50402},
+	"\u00b6":       {0x039c0402},
+	"\u00b7":       {0x02930402},
+	"\u00b8":       {0x04be0402},
+	"\u00bb":       {0x03270402},
+	"\u00bf":       {0x026e0402},
+	"\u00d7":       {0x06690402},
+	"\u00f7":       {0x06680402},
+	"\u02b9":       {0x04c50402},
+	"\u02ba":       {0x04c70402},
+	"\u02c2":       {0x04c80402},
+	"\u02c3":       {0x04c90402},
+	"\u02c4":       {0x04ca0402},
+	"\u02c5":       {0x04cb0402},
+	"\u02c6":       {0x04cc0402},
+	"\u02c7":       {0x04cd0402},
+	"\u02c8":       {0x04ce0402},
+	"\u02c9":       {0x04cf0402},
+	"\u02ca":       {0x04d00402},
+	"\u02cb":       {0x04d10402},
+	"\u02cc":       {0x04d20402},
+	"\u02cd":       {0x04d30402},
+	"\u02ce":       {0x04d40402},
+	"\u02cf":       {0x04d50402},
+	"\u02d2":       {0x04d60402},
+	"\u02d3":       {0x04d70402},
+	"\u02d4":       {0x04d80402},
+	"\u02d5":       {0x04d90402},
+	"\u02d6":       {0x04dc0402},
+	"\u02d7":       {0x04dd0402},
+	"\u02d8":       {0x04b90402},
+	"\u02d9":       {0x04ba0402},
+	"\u02da":       {0x04bc0402},
+	"\u02db":       {0x04bf0402},
+	"\u02dc":       {0x04b60402},
+	"\u02dd":       {0x04bd0402},
+	"\u02de":       {0x04de0402},
+	"\u02df":       {0x04df0402},
+	"\u02e5":       {0x04e00402},
+	"\u02e6":       {0x04e10402},
+	"\u02e7":       {0x04e20402},
+	"\u02e8":       {0x04e30402},
+	"\u02e9":       {0x04e40402},
+	"\u02ea":       {0x04e50402},
+	"\u02eb":       {0x04e60402},
+	"\u02ec":       {0x04e70402},
+	"\u02ed":       {0x04e80402},
+	"\u02ef":       {0x04e90402},
+	"\u02f0":       {0x04ea0402},
+	"\u02f1":       {0x04eb0402},
+	"\u02f2":       {0x04ec0402},
+	"\u02f3":       {0x04ed0402},
+	"\u02f4":       {0x04ee0402},
+	"\u02f5":       {0x04ef0402},
+	"\u02f6":       {0x04f00402},
+	"\u02f7":       {0x04f10402},
+	"\u02f8":       {0x04f20402},
+	"\u02f9":       {0x04f30402},
+	"\u02fa":       {0x04f40402},
+	"\u02fb":       {0x04f50402},
+	"\u02fc":       {0x04f60402},
+	"\u02fd":       {0x04f70402},
+	"\u02fe":       {0x04f80402},
+	"\u02ff":       {0x04f90402},
+	"\u034f":       {0x00000000},
+	"\u0374":       {0x04c50402},
+	"\u0375":       {0x04c60402},
+	"\u037e":       {0x023a0402},
+	"\u0384":       {0x04b50402},
+	"\u0385":       {0x04bb0402, 0x00000482},
+	"\u0387":       {0x02930402},
+	"\u03f6":       {0x06610402},
+	"\u0482":       {0x052b0402},
+	"\u0488":       {0x00000000},
+	"\u0489":       {0x00000000},
+	"\u1fbd":       {0x04c00402},
+	"\u1fbf":       {0x04c00402},
+	"\u1fc0":       {0x04c20402},
+	"\u1fc1":       {0x04bb0402, 0x00000542},
+	"\u1fcd":       {0x04c00402, 0x000004a2},
+	"\u1fce":       {0x04c00402, 0x00000482},
+	"\u1fcf":       {0x04c00402, 0x00000542},
+	"\u1fdd":       {0x04c10402, 0x000004a2},
+	"\u1fde":       {0x04c10402, 0x00000482},
+	"\u1fdf":       {0x04c10402, 0x00000542},
+	"\u1fed":       {0x04bb0402, 0x000004a2},
+	"\u1fee":       {0x04bb0402, 0x00000482},
+	"\u1fef":       {0x04b40402},
+	"\u1ffd":       {0x04b50402},
+	"\u1ffe":       {0x04c10402},
+	"\u2000":       {0x02090404},
+	"\u2001":       {0x02090404},
+	"\u2002":       {0x02090404},
+	"\u2003":       {0x02090404},
+	"\u2004":       {0x02090404},
+	"\u2005":       {0x02090404},
+	"\u2006":       {0x02090404},
+	"\u2007":       {0x0209041b},
+	"\u2008":       {0x02090404},
+	"\u2009":       {0x02090404},
+	"\u200a":       {0x02090404},
+	"\u2010":       {0x02130402},
+	"\u2011":       {0x0213041b},
+	"\u2012":       {0x02140402},
+	"\u2013":       {0x02150402},
+	"\u2014":       {0x02160402},
+	"\u2015":       {0x02170402},
+	"\u2016":       {0x03940402},
+	"\u2017":       {0x020c0402},
+	"\u2018":       {0x03170402},
+	"\u2019":       {0x03180402},
+	"\u201a":       {0x03190402},
+	"\u201b":       {0x031a0402},
+	"\u201c":       {0x031e0402},
+	"\u201d":       {0x031f0402},
+	"\u201e":       {0x03200402},
+	"\u201f":       {0x03210402},
+	"\u2020":       {0x03b30402},
+	"\u2021":       {0x03b40402},
+	"\u2022":       {0x03b90402},
+	"\u2023":       {0x03ba0402},
+	"\u2024":       {0x027e0404},
+	"\u2025":       {0x027e0404, 0x027e0404},
+	"\u2026":       {0x027e0404, 0x027e0404, 0x027e0404},
+	"\u2027":       {0x03bb0402},
+	"\u2028":       {0x02070402},
+	"\u2029":       {0x02080402},
+	"\u202f":       {0x0209041b},
+	"\u2030":       {0x03af0402},
+	"\u2031":       {0x03b10402},
+	"\u2032":       {0x03bf0402},
+	"\u2033":       {0x03bf0404, 0x03bf0404},
+	"\u2034":       {0x03bf0404, 0x03bf0404, 0x03bf0404},
+	"\u2035":       {0x03c00402},
+	"\u2036":       {0x03c00404, 0x03c00404},
+	"\u2037":       {0x03c00404, 0x03c00404, 0x03c00404},
+	"\u2038":       {0x03c30402},
+	"\u2039":       {0x031b0402},
+	"\u203a":       {0x031c0402},
+	"\u203b":       {0x03c40402},
+	"\u203c":       {0x02670404, 0x02670404},
+	"\u203d":       {0x027c0402},
+	"\u203e":       {0x020a0402},
+	"\u203f":       {0x03c50402},
+	"\u2040":       {0x03c70402},
+	"\u2041":       {0x03c90402},
+	"\u2042":       {0x03ca0402},
+	"\u2043":       {0x03bc0402},
+	"\u2044":       {0x06760402},
+	"\u2045":       {0x03340402},
+	"\u2046":       {0x03350402},
+	"\u2047":       {0x026d0404, 0x026d0404},
+	"\u2048":       {0x026d0404, 0x02670404},
+	"\u2049":       {0x02670404, 0x026d0404},
+	"\u204a":       {0x03aa0402},
+	"\u204b":       {0x039d0402},
+	"\u204c":       {0x03bd0402},
+	"\u204d":       {0x03be0402},
+	"\u204e":       {0x03a20402},
+	"\u204f":       {0x023c0402},
+	"\u2050":       {0x03c80402},
+	"\u2051":       {0x03a30402},
+	"\u2052":       {0x06720402},
+	"\u2053":       {0x021a0402},
+	"\u2054":       {0x03c60402},
+	"\u2055":       {0x02f90402},
+	"\u2056":       {0x02fa0402},
+	"\u2057":       {0x03bf0404, 0x03bf0404, 0x03bf0404, 0x03bf0404},
+	"\u2058":       {0x02fb0402},
+	"\u2059":       {0x02fc0402},
+	"\u205a":       {0x02fd0402},
+	"\u205b":       {0x02fe0402},
+	"\u205c":       {0x02ff0402},
+	"\u205d":       {0x03000402},
+	"\u205e":       {0x03010402},
+	"\u205f":       {0x02090404},
+	"\u2061":       {0x00000000},
+	"\u2062":       {0x00000000},
+	"\u2063":       {0x00000000},
+	"\u2064":       {0x00000000},
+	"\u0332":       {0x00000422},
+	"\u0313":       {0x00000442},
+	"\u0343":       {0x00000442},
+	"\u0486":       {0x00000442},
+	"\u0314":       {0x00000462},
+	"\u0485":       {0x00000462},
+	"\u0301":       {0x00000482},
+	"\u0341":       {0x00000482},
+	"\u0300":       {0x000004a2},
+	"\u0340":       {0x000004a2},
+	"\u0306":       {0x000004c2},
+	"\u0302":       {0x000004e2},
+	"\u030c":       {0x00000502},
+	"\u030a":       {0x00000522},
+	"\u0342":       {0x00000542},
+	"\u0308":       {0x00000562},
+	"\u0344":       {0x00000562, 0x00000482},
+	"\u030b":       {0x00000582},
+	"\u0303":       {0x000005a2},
+	"\u0307":       {0x000005c2},
+	"\u0338":       {0x000005e2},
+	"\u0327":       {0x00000602},
+	"\u0328":       {0x00000622},
+	"\u0304":       {0x00000642},
+	"\u030d":       {0x00000662},
+	"\u030e":       {0x00000662},
+	"\u0312":       {0x00000662},
+	"\u0315":       {0x00000662},
+	"\u031a":       {0x00000662},
+	"\u033d":       {0x00000662},
+	"\u033e":       {0x00000662},
+	"\u033f":       {0x00000662},
+	"\u0346":       {0x00000662},
+	"\u034a":       {0x00000662},
+	"\u034b":       {0x00000662},
+	"\u034c":       {0x00000662},
+	"\u0350":       {0x00000662},
+	"\u0351":       {0x00000662},
+	"\u0352":       {0x00000662},
+	"\u0357":       {0x00000662},
+	"\u035b":       {0x00000662},
+	"\u035d":       {0x00000662},
+	"\u035e":       {0x00000662},
+	"\u0484":       {0x00000662},
+	"\u0487":       {0x00000662},
+	"\u0316":       {0x00000682},
+	"\u0317":       {0x00000682},
+	"\u0318":       {0x00000682},
+	"\u0319":       {0x00000682},
+	"\u031c":       {0x00000682},
+	"\u031d":       {0x00000682},
+	"\u031e":       {0x00000682},
+	"\u031f":       {0x00000682},
+	"\u0320":       {0x00000682},
+	"\u0329":       {0x00000682},
+	"\u032a":       {0x00000682},
+	"\u032b":       {0x00000682},
+	"\u032c":       {0x00000682},
+	"\u032f":       {0x00000682},
+	"\u0333":       {0x00000682},
+	"\u033a":       {0x00000682},
+	"\u033b":       {0x00000682},
+	"\u033c":       {0x00000682},
+	"\u0347":       {0x00000682},
+	"\u0348":       {0x00000682},
+	"\u0349":       {0x00000682},
+	"\u034d":       {0x00000682},
+	"\u034e":       {0x00000682},
+	"\u0353":       {0x00000682},
+	"\u0354":       {0x00000682},
+	"\u0355":       {0x00000682},
+	"\u0356":       {0x00000682},
+	"\u0359":       {0x00000682},
+	"\u035a":       {0x00000682},
+	"\u035c":       {0x00000682},
+	"\u035f":       {0x00000682},
+	"\u0362":       {0x00000682},
+	"\u0336":       {0x000006a2},
+	"\u0337":       {0x000006a2},
+	"\u0335":       {0x00000722},
+	"\u0305":       {0x00000742},
+	"\u0309":       {0x00000762},
+	"\u030f":       {0x00000782},
+	"\u0310":       {0x000007a2},
+	"\u0311":       {0x000007c2},
+	"\u031b":       {0x000007e2},
+	"\u0321":       {0x00000802},
+	"\u0322":       {0x00000822},
+	"\u0323":       {0x00000842},
+	"\u0324":       {0x00000862},
+	"\u0325":       {0x00000882},
+	"\u0326":       {0x000008a2},
+	"\u032d":       {0x000008c2},
+	"\u032e":       {0x000008e2},
+	"\u0330":       {0x00000902},
+	"\u0331":       {0x00000922},
+	"\u0334":       {0x00000942},
+	"\u0339":       {0x00000962},
+	"\u0345":       {0x00000982},
+	"\u0358":       {0x000009a2},
+	"\u0360":       {0x000009c2},
+	"\u0361":       {0x000009e2},
+	"\u0483":       {0x00000a02},
+	"\u02d0":       {0x1f460402},
+	"\u02d1":       {0x1f470402},
+	"\u00a4":       {0x1f620402},
+	"\u00a2":       {0x1f630402},
+	"$":            {0x1f640402},
+	"\u00a3":       {0x1f650402},
+	"\u00a5":       {0x1f660402},
+	"\u20a0":       {0x1f780402},
+	"\u20a1":       {0x1f790402},
+	"\u20a2":       {0x1f7a0402},
+	"\u20a3":       {0x1f7b0402},
+	"\u20a4":       {0x1f7c0402},
+	"\u20a5":       {0x1f7d0402},
+	"\u20a6":       {0x1f7e0402},
+	"\u20a7":       {0x1f7f0402},
+	"\u20a9":       {0x1f800402},
+	"\u20aa":       {0x1f810402},
+	"\u20ab":       {0x1f820402},
+	"\u20ac":       {0x1f830402},
+	"\u20ad":       {0x1f840402},
+	"\u20ae":       {0x1f850402},
+	"\u20af":       {0x1f860402},
+	"\u20b0":       {0x1f870402},
+	"\u20b1":       {0x1f880402},
+	"\u20b2":       {0x1f890402},
+	"\u20b3":       {0x1f8a0402},
+	"\u20b4":       {0x1f8b0402},
+	"\u20b5":       {0x1f8c0402},
+	"\u20b6":       {0x1f8d0402},
+	"\u20b7":       {0x1f8e0402},
+	"\u20b8":       {0x1f8f0402},
+	"\u20b9":       {0x1f900402},
+	"\u20ba":       {0x1f920402},
+	"\u20bb":       {0x1f930402},
+	"\u20bc":       {0x1f940402},
+	"\u20bd":       {0x1f950402},
+	"\u20be":       {0x1f960402},
+	"\u20bf":       {0x1f970402},
+	"0":            {0x1f980402},
+	"1":            {0x1f990402},
+	"\u00b9":       {0x1f990414},
+	"\u00bd":       {0x1f99041e, 0x0676041e, 0x1f9a041e},
+	"\u00bc":       {0x1f99041e, 0x0676041e, 0x1f9c041e},
+	"2":            {0x1f9a0402},
+	"\u00b2":       {0x1f9a0414},
+	"3":            {0x1f9b0402},
+	"\u00b3":       {0x1f9b0414},
+	"\u00be":       {0x1f9b041e, 0x0676041e, 0x1f9c041e},
+	"4":            {0x1f9c0402},
+	"5":            {0x1f9d0402},
+	"6":            {0x1f9e0402},
+	"7":            {0x1f9f0402},
+	"8":            {0x1fa00402},
+	"9":            {0x1fa10402},
+	"a":            {0x1fa20402},
+	"\u0363":       {0x1fa20404},
+	"A":            {0x1fa20408},
+	"\u00aa":       {0x1fa20414},
+	"\u00e1":       {0x1fa20402, 0x00000482},
+	"\u00c1":       {0x1fa20408, 0x00000482},
+	"\u00e0":       {0x1fa20402, 0x000004a2},
+	"\u00c0":       {0x1fa20408, 0x000004a2},
+	"\u0103":       {0x1fa20402, 0x000004c2},
+	"\u0102":       {0x1fa20408, 0x000004c2},
+	"\u1eaf":       {0x1fa20402, 0x000004c2, 0x00000482},
+	"\u1eae":       {0x1fa20408, 0x000004c2, 0x00000482},
+	"\u1eb1":       {0x1fa20402, 0x000004c2, 0x000004a2},
+	"\u1eb0":       {0x1fa20408, 0x000004c2, 0x000004a2},
+	"\u1eb5":       {0x1fa20402, 0x000004c2, 0x000005a2},
+	"\u1eb4":       {0x1fa20408, 0x000004c2, 0x000005a2},
+	"\u1eb3":       {0x1fa20402, 0x000004c2, 0x00000762},
+	"\u1eb2":       {0x1fa20408, 0x000004c2, 0x00000762},
+	"\u00e2":       {0x1fa20402, 0x000004e2},
+	"\u00c2":       {0x1fa20408, 0x000004e2},
+	"\u1ea5":       {0x1fa20402, 0x000004e2, 0x00000482},
+	"\u1ea4":       {0x1fa20408, 0x000004e2, 0x00000482},
+	"\u1ea7":       {0x1fa20402, 0x000004e2, 0x000004a2},
+	"\u1ea6":       {0x1fa20408, 0x000004e2, 0x000004a2},
+	"\u1eab":       {0x1fa20402, 0x000004e2, 0x000005a2},
+	"\u1eaa":       {0x1fa20408, 0x000004e2, 0x000005a2},
+	"\u1ea9":       {0x1fa20402, 0x000004e2, 0x00000762},
+	"\u1ea8":       {0x1fa20408, 0x000004e2, 0x00000762},
+	"\u01ce":       {0x1fa20402, 0x00000502},
+	"\u01cd":       {0x1fa20408, 0x00000502},
+	"\u00e5":       {0x1fa20402, 0x00000522},
+	"\u00c5":       {0x1fa20408, 0x00000522},
+	"\u01fb":       {0x1fa20402, 0x00000522, 0x00000482},
+	"\u01fa":       {0x1fa20408, 0x00000522, 0x00000482},
+	"\u00e4":       {0x1fa20402, 0x00000562},
+	"\u00c4":       {0x1fa20408, 0x00000562},
+	"\u01df":       {0x1fa20402, 0x00000562, 0x00000642},
+	"\u01de":       {0x1fa20408, 0x00000562, 0x00000642},
+	"\u00e3":       {0x1fa20402, 0x000005a2},
+	"\u00c3":       {0x1fa20408, 0x000005a2},
+	"\u0227":       {0x1fa20402, 0x000005c2},
+	"\u0226":       {0x1fa20408, 0x000005c2},
+	"\u01e1":       {0x1fa20402, 0x000005c2, 0x00000642},
+	"\u01e0":       {0x1fa20408, 0x000005c2, 0x00000642},
+	"\u0105":       {0x1fa20402, 0x00000622},
+	"\u0104":       {0x1fa20408, 0x00000622},
+	"\u0101":       {0x1fa20402, 0x00000642},
+	"\u0100":       {0x1fa20408, 0x00000642},
+	"\u1ea3":       {0x1fa20402, 0x00000762},
+	"\u1ea2":       {0x1fa20408, 0x00000762},
+	"\u0201":       {0x1fa20402, 0x00000782},
+	"\u0200":       {0x1fa20408, 0x00000782},
+	"\u0203":       {0x1fa20402, 0x000007c2},
+	"\u0202":       {0x1fa20408, 0x000007c2},
+	"\u1ea1":       {0x1fa20402, 0x00000842},
+	"\u1ea0":       {0x1fa20408, 0x00000842},
+	"\u1eb7":       {0x1fa20402, 0x00000842, 0x000004c2},
+	"\u1eb6":       {0x1fa20408, 0x00000842, 0x000004c2},
+	"\u1ead":       {0x1fa20402, 0x00000842, 0x000004e2},
+	"\u1eac":       {0x1fa20408, 0x00000842, 0x000004e2},
+	"\u1e01":       {0x1fa20402, 0x00000882},
+	"\u1e00":       {0x1fa20408, 0x00000882},
+	"\u00e6":       {0x1fa20404, 0x00002304, 0x20070404},
+	"\u00c6":       {0x1fa2040a, 0x00002304, 0x2007040a},
+	"\u01fd":       {0x1fa20404, 0x00002304, 0x20070404, 0x00000482},
+	"\u01fc":       {0x1fa2040a, 0x00002304, 0x2007040a, 0x00000482},
+	"\u01e3":       {0x1fa20404, 0x00002304, 0x20070404, 0x00000642},
+	"\u01e2":       {0x1fa2040a, 0x00002304, 0x2007040a, 0x00000642},
+	"\u1e9a":       {0x1fa20404, 0x22e50404},
+	"\u023a":       {0x1fa70408},
+	"\u0250":       {0x1fad0402},
+	"\u0251":       {0x1fb10402},
+	"\u0252":       {0x1fb70402},
+	"b":            {0x1fbc0402},
+	"B":            {0x1fbc0408},
+	"\u1e03":       {0x1fbc0402, 0x000005c2},
+	"\u1e02":       {0x1fbc0408, 0x000005c2},
+	"\u1e05":       {0x1fbc0402, 0x00000842},
+	"\u1e04":       {0x1fbc0408, 0x00000842},
+	"\u1e07":       {0x1fbc0402, 0x00000922},
+	"\u1e06":       {0x1fbc0408, 0x00000922},
+	"\u0299":       {0x1fc00402},
+	"\u0180":       {0x1fc40402},
+	"\u0243":       {0x1fc40408},
+	"\u0253":       {0x1fcd0402},
+	"\u0181":       {0x1fcd0408},
+	"\u0183":       {0x1fd10402},
+	"\u0182":       {0x1fd10408},
+	"c":            {0x1fd60402},
+	"\u0368":       {0x1fd60404},
+	"C":            {0x1fd60408},
+	"\u0107":       {0x1fd60402, 0x00000482},
+	"\u0106":       {0x1fd60408, 0x00000482},
+	"\u0109":       {0x1fd60402, 0x000004e2},
+	"\u0108":       {0x1fd60408, 0x000004e2},
+	"\u010d":       {0x1fd60402, 0x00000502},
+	"\u010c":       {0x1fd60408, 0x00000502},
+	"\u010b":       {0x1fd60402, 0x000005c2},
+	"\u010a":       {0x1fd60408, 0x000005c2},
+	"\u00e7":       {0x1fd60402, 0x00000602},
+	"\u00c7":       {0x1fd60408, 0x00000602},
+	"\u1e09":       {0x1fd60402, 0x00000602, 0x00000482},
+	"\u1e08":       {0x1fd60408, 0x00000602, 0x00000482},
+	"\u023c":       {0x1fdb0402},
+	"\u023b":       {0x1fdb0408},
+	"\u0188":       {0x1fe10402},
+	"\u0187":       {0x1fe10408},
+	"\u0255":       {0x1fe50402},
+	"d":            {0x1feb0402},
+	"\u0369":       {0x1feb0404},
+	"D":            {0x1feb0408},
+	"\u010f":       {0x1feb0402, 0x00000502},
+	"\u010e":       {0x1feb0408, 0x00000502},
+	"\u1e0b":       {0x1feb0402, 0x000005c2},
+	"\u1e0a":       {0x1feb0408, 0x000005c2},
+	"\u1e11":       {0x1feb0402, 0x00000602},
+	"\u1e10":       {0x1feb0408, 0x00000602},
+	"\u0111":       {0x1feb0402, 0x00000722},
+	"\u0110":       {0x1feb0408, 0x00000722},
+	"\u1e0d":       {0x1feb0402, 0x00000842},
+	"\u1e0c":       {0x1feb0408, 0x00000842},
+	"\u1e13":       {0x1feb0402, 0x000008c2},
+	"\u1e12":       {0x1feb0408, 0x000008c2},
+	"\u1e0f":       {0x1feb0402, 0x00000922},
+	"\u1e0e":       {0x1feb0408, 0x00000922},
+	"\u00f0":       {0x1feb0404, 0x00002304},
+	"\u00d0":       {0x1feb040a, 0x00002304},
+	"\u0238":       {0x1feb0404, 0x1fbc0404},
+	"\u01f3":       {0x1feb0404, 0x22860404},
+	"\u02a3":       {0x1feb0404, 0x22860404},
+	"\u01f2":       {0x1feb040a, 0x22860404},
+	"\u01f1":       {0x1feb040a, 0x2286040a},
+	"\u01c6":       {0x1feb0404, 0x22860404, 0x00000504},
+	"\u01c5":       {0x1feb040a, 0x22860404, 0x00000504},
+	"\u01c4":       {0x1feb040a, 0x2286040a, 0x00000504},
+	"\u02a5":       {0x1feb0404, 0x22990404},
+	"\u02a4":       {0x1feb0404, 0x22a30404},
+	"\u0256":       {0x1ff40402},
+	"\u0189":       {0x1ff40408},
+	"\u0257":       {0x1ff80402},
+	"\u018a":       {0x1ff80408},
+	"\u018c":       {0x1ffd0402},
+	"\u018b":       {0x1ffd0408},
+	"\u0221":       {0x20010402},
+	"\u1e9f":       {0x20060402},
+	"e":            {0x20070402},
+	"\u0364":       {0x20070404},
+	"E":            {0x20070408},
+	"\u00e9":       {0x20070402, 0x00000482},
+	"\u00c9":       {0x20070408, 0x00000482},
+	"\u00e8":       {0x20070402, 0x000004a2},
+	"\u00c8":       {0x20070408, 0x000004a2},
+	"\u0115":       {0x20070402, 0x000004c2},
+	"\u0114":       {0x20070408, 0x000004c2},
+	"\u00ea":       {0x20070402, 0x000004e2},
+	"\u00ca":       {0x20070408, 0x000004e2},
+	"\u1ebf":       {0x20070402, 0x000004e2, 0x00000482},
+	"\u1ebe":       {0x20070408, 0x000004e2, 0x00000482},
+	"\u1ec1":       {0x20070402, 0x000004e2, 0x000004a2},
+	"\u1ec0":       {0x20070408, 0x000004e2, 0x000004a2},
+	"\u1ec5":       {0x20070402, 0x000004e2, 0x000005a2},
+	"\u1ec4":       {0x20070408, 0x000004e2, 0x000005a2},
+	"\u1ec3":       {0x20070402, 0x000004e2, 0x00000762},
+	"\u1ec2":       {0x20070408, 0x000004e2, 0x00000762},
+	"\u011b":       {0x20070402, 0x00000502},
+	"\u011a":       {0x20070408, 0x00000502},
+	"\u00eb":       {0x20070402, 0x00000562},
+	"\u00cb":       {0x20070408, 0x00000562},
+	"\u1ebd":       {0x20070402, 0x000005a2},
+	"\u1ebc":       {0x20070408, 0x000005a2},
+	"\u0117":       {0x20070402, 0x000005c2},
+	"\u0116":       {0x20070408, 0x000005c2},
+	"\u0229":       {0x20070402, 0x00000602},
+	"\u0228":       {0x20070408, 0x00000602},
+	"\u1e1d":       {0x20070402, 0x00000602, 0x000004c2},
+	"\u1e1c":       {0x20070408, 0x00000602, 0x000004c2},
+	"\u0119":       {0x20070402, 0x00000622},
+	"\u0118":       {0x20070408, 0x00000622},
+	"\u0113":       {0x20070402, 0x00000642},
+	"\u0112":       {0x20070408, 0x00000642},
+	"\u1e17":       {0x20070402, 0x00000642, 0x00000482},
+	"\u1e16":       {0x20070408, 0x00000642, 0x00000482},
+	"\u1e15":       {0x20070402, 0x00000642, 0x000004a2},
+	"\u1e14":       {0x20070408, 0x00000642, 0x000004a2},
+	"\u1ebb":       {0x20070402, 0x00000762},
+	"\u1eba":       {0x20070408, 0x00000762},
+	"\u0205":       {0x20070402, 0x00000782},
+	"\u0204":       {0x20070408, 0x00000782},
+	"\u0207":       {0x20070402, 0x000007c2},
+	"\u0206":       {0x20070408, 0x000007c2},
+	"\u1eb9":       {0x20070402, 0x00000842},
+	"\u1eb8":       {0x20070408, 0x00000842},
+	"\u1ec7":       {0x20070402, 0x00000842, 0x000004e2},
+	"\u1ec6":       {0x20070408, 0x00000842, 0x000004e2},
+	"\u1e19":       {0x20070402, 0x000008c2},
+	"\u1e18":       {0x20070408, 0x000008c2},
+	"\u1e1b":       {0x20070402, 0x00000902},
+	"\u1e1a":       {0x20070408, 0x00000902},
+	"\u0247":       {0x200e0402},
+	"\u0246":       {0x200e0408},
+	"\u01dd":       {0x20150402},
+	"\u018e":       {0x20150408},
+	"\u0259":       {0x201a0402},
+	"\u018f":       {0x201a0408},
+	"\u025b":       {0x201f0402},
+	"\u0190":       {0x201f0408},
+	"\u0258":       {0x20240402},
+	"\u025a":       {0x20280402},
+	"\u025c":       {0x202c0402},
+	"\u025d":       {0x20320402},
+	"\u025e":       {0x20360402},
+	"\u029a":       {0x203a0402},
+	"\u0264":       {0x203e0402},
+	"f":            {0x20420402},
+	"F":            {0x20420408},
+	"\u1e1f":       {0x20420402, 0x000005c2},
+	"\u1e1e":       {0x20420408, 0x000005c2},
+	"\u02a9":       {0x20420404, 0x21370404},
+	"\u0192":       {0x204b0402},
+	"\u0191":       {0x204b0408},
+	"g":            {0x20510402},
+	"G":            {0x20510408},
+	"\u01f5":       {0x20510402, 0x00000482},
+	"\u01f4":       {0x20510408, 0x00000482},
+	"\u011f":       {0x20510402, 0x000004c2},
+	"\u011e":       {0x20510408, 0x000004c2},
+	"\u011d":       {0x20510402, 0x000004e2},
+	"\u011c":       {0x20510408, 0x000004e2},
+	"\u01e7":       {0x20510402, 0x00000502},
+	"\u01e6":       {0x20510408, 0x00000502},
+	"\u0121":       {0x20510402, 0x000005c2},
+	"\u0120":       {0x20510408, 0x000005c2},
+	"\u0123":       {0x20510402, 0x00000602},
+	"\u0122":       {0x20510408, 0x00000602},
+	"\u1e21":       {0x20510402, 0x00000642},
+	"\u1e20":       {0x20510408, 0x00000642},
+	"\u0261":       {0x20550402},
+	"\u0262":       {0x205a0402},
+	"\u01e5":       {0x205e0402},
+	"\u01e4":       {0x205e0408},
+	"\u0260":       {0x20630402},
+	"\u0193":       {0x20630408},
+	"\u029b":       {0x20670402},
+	"\u0263":       {0x206d0402},
+	"\u0194":       {0x206d0408},
+	"\u02e0":       {0x206d0414},
+	"\u01a3":       {0x20710402},
+	"\u01a2":       {0x20710408},
+	"h":            {0x20750402},
+	"\u036a":       {0x20750404},
+	"H":            {0x20750408},
+	"\u02b0":       {0x20750414},
+	"\u0125":       {0x20750402, 0x000004e2},
+	"\u0124":       {0x20750408, 0x000004e2},
+	"\u021f":       {0x20750402, 0x00000502},
+	"\u021e":       {0x20750408, 0x00000502},
+	"\u1e27":       {0x20750402, 0x00000562},
+	"\u1e26":       {0x20750408, 0x00000562},
+	"\u1e23":       {0x20750402, 0x000005c2},
+	"\u1e22":       {0x20750408, 0x000005c2},
+	"\u1e29":       {0x20750402, 0x00000602},
+	"\u1e28":       {0x20750408, 0x00000602},
+	"\u0127":       {0x20750402, 0x00000722},
+	"\u0126":       {0x20750408, 0x00000722},
+	"\u1e25":       {0x20750402, 0x00000842},
+	"\u1e24":       {0x20750408, 0x00000842},
+	"\u1e2b":       {0x20750402, 0x000008e2},
+	"\u1e2a":       {0x20750408, 0x000008e2},
+	"\u1e96":       {0x20750402, 0x00000922},
+	"\u029c":       {0x20790402},
+	"\u0195":       {0x207d0402},
+	"\u01f6":       {0x207d0408},
+	"\u0266":       {0x20820402},
+	"\u02b1":       {0x20820414},
+	"\u0267":       {0x208a0402},
+	"\u02bb":       {0x208e0402},
+	"\u02bd":       {0x208f0402},
+	"i":            {0x20900402},
+	"\u0365":       {0x20900404},
+	"I":            {0x20900408},
+	"\u00ed":       {0x20900402, 0x00000482},
+	"\u00cd":       {0x20900408, 0x00000482},
+	"\u00ec":       {0x20900402, 0x000004a2},
+	"\u00cc":       {0x20900408, 0x000004a2},
+	"\u012d":       {0x20900402, 0x000004c2},
+	"\u012c":       {0x20900408, 0x000004c2},
+	"\u00ee":       {0x20900402, 0x000004e2},
+	"\u00ce":       {0x20900408, 0x000004e2},
+	"\u01d0":       {0x20900402, 0x00000502},
+	"\u01cf":       {0x20900408, 0x00000502},
+	"\u00ef":       {0x20900402, 0x00000562},
+	"\u00cf":       {0x20900408, 0x00000562},
+	"\u1e2f":       {0x20900402, 0x00000562, 0x00000482},
+	"\u1e2e":       {0x20900408, 0x00000562, 0x00000482},
+	"\u0129":       {0x20900402, 0x000005a2},
+	"\u0128":       {0x20900408, 0x000005a2},
+	"\u0130":       {0x20900408, 0x000005c2},
+	"\u012f":       {0x20900402, 0x00000622},
+	"\u012e":       {0x20900408, 0x00000622},
+	"\u012b":       {0x20900402, 0x00000642},
+	"\u012a":       {0x20900408, 0x00000642},
+	"\u1ec9":       {0x20900402, 0x00000762},
+	"\u1ec8":       {0x20900408, 0x00000762},
+	"\u0209":       {0x20900402, 0x00000782},
+	"\u0208":       {0x20900408, 0x00000782},
+	"\u020b":       {0x20900402, 0x000007c2},
+	"\u020a":       {0x20900408, 0x000007c2},
+	"\u1ecb":       {0x20900402, 0x00000842},
+	"\u1eca":       {0x20900408, 0x00000842},
+	"\u1e2d":       {0x20900402, 0x00000902},
+	"\u1e2c":       {0x20900408, 0x00000902},
+	"\u0133":       {0x20900404, 0x20ab0404},
+	"\u0132":       {0x2090040a, 0x20ab040a},
+	"\u0131":       {0x20940402},
+	"\u026a":       {0x20980402},
+	"\u0268":       {0x209f0402},
+	"\u0197":       {0x209f0408},
+	"\u0269":       {0x20a60402},
+	"\u0196":       {0x20a60408},
+	"j":            {0x20ab0402},
+	"J":            {0x20ab0408},
+	"\u02b2":       {0x20ab0414},
+	"\u0135":       {0x20ab0402, 0x000004e2},
+	"\u0134":       {0x20ab0408, 0x000004e2},
+	"\u01f0":       {0x20ab0402, 0x00000502},
+	"\u0237":       {0x20af0402},
+	"\u0249":       {0x20b40402},
+	"\u0248":       {0x20b40408},
+	"\u029d":       {0x20b80402},
+	"\u025f":       {0x20bc0402},
+	"\u0284":       {0x20c00402},
+	"k":            {0x20c40402},
+	"K":            {0x20c40408},
+	"\u1e31":       {0x20c40402, 0x00000482},
+	"\u1e30":       {0x20c40408, 0x00000482},
+	"\u01e9":       {0x20c40402, 0x00000502},
+	"\u01e8":       {0x20c40408, 0x00000502},
+	"\u0137":       {0x20c40402, 0x00000602},
+	"\u0136":       {0x20c40408, 0x00000602},
+	"\u1e33":       {0x20c40402, 0x00000842},
+	"\u1e32":       {0x20c40408, 0x00000842},
+	"\u1e35":       {0x20c40402, 0x00000922},
+	"\u1e34":       {0x20c40408, 0x00000922},
+	"\u0199":       {0x20ca0402},
+	"\u0198":       {0x20ca0408},
+	"\u029e":       {0x20d20402},
+	"l":            {0x20d60402},
+	"L":            {0x20d60408},
+	"\u02e1":       {0x20d60414},
+	"\u013a":       {0x20d60402, 0x00000482},
+	"\u0139":       {0x20d60408, 0x00000482},
+	"\u013e":       {0x20d60402, 0x00000502},
+	"\u013d":       {0x20d60408, 0x00000502},
+	"\u013c":       {0x20d60402, 0x00000602},
+	"\u013b":       {0x20d60408, 0x00000602},
+	"\u0142":       {0x20d60402, 0x00000722},
+	"\u0141":       {0x20d60408, 0x00000722},
+	"\u1e37":       {0x20d60402, 0x00000842},
+	"\u1e36":       {0x20d60408, 0x00000842},
+	"\u1e39":       {0x20d60402, 0x00000842, 0x00000642},
+	"\u1e38":       {0x20d60408, 0x00000842, 0x00000642},
+	"\u1e3d":       {0x20d60402, 0x000008c2},
+	"\u1e3c":       {0x20d60408, 0x000008c2},
+	"\u1e3b":       {0x20d60402, 0x00000922},
+	"\u1e3a":       {0x20d60408, 0x00000922},
+	"\u0140":       {0x20d60402, 0x00002302},
+	"l\u00b7":      {0x20d60402, 0x00002302},
+	"l\u0387":      {0x20d60402, 0x00002302},
+	"\u013f":       {0x20d60408, 0x00002302},
+	"L\u00b7":      {0x20d60408, 0x00002302},
+	"L\u0387":      {0x20d60408, 0x00002302},
+	"\u01c9":       {0x20d60404, 0x20ab0404},
+	"\u01c8":       {0x20d6040a, 0x20ab0404},
+	"\u01c7":       {0x20d6040a, 0x20ab040a},
+	"\u1efb":       {0x20d60404, 0x20d60404},
+	"\u1efa":       {0x20d6040a, 0x20d6040a},
+	"\u02aa":       {0x20d60404, 0x21d20404},
+	"\u02ab":       {0x20d60404, 0x22860404},
+	"\u029f":       {0x20da0402},
+	"\u019a":       {0x20e10402},
+	"\u023d":       {0x20e10408},
+	"\u026b":       {0x20e60402},
+	"\u026c":       {0x20ec0402},
+	"\u026d":       {0x20f20402},
+	"\u0234":       {0x20f70402},
+	"\u026e":       {0x20fc0402},
+	"\u019b":       {0x21010402},
+	"\u028e":       {0x21050402},
+	"m":            {0x21090402},
+	"\u036b":       {0x21090404},
+	"M":            {0x21090408},
+	"\u1e3f":       {0x21090402, 0x00000482},
+	"\u1e3e":       {0x21090408, 0x00000482},
+	"\u1e41":       {0x21090402, 0x000005c2},
+	"\u1e40":       {0x21090408, 0x000005c2},
+	"\u1e43":       {0x21090402, 0x00000842},
+	"\u1e42":       {0x21090408, 0x00000842},
+	"\u0271":       {0x21100402},
+	"n":            {0x21180402},
+	"N":            {0x21180408},
+	"\u0144":       {0x21180402, 0x00000482},
+	"\u0143":       {0x21180408, 0x00000482},
+	"\u01f9":       {0x21180402, 0x000004a2},
+	"\u01f8":       {0x21180408, 0x000004a2},
+	"\u0148":       {0x21180402, 0x00000502},
+	"\u0147":       {0x21180408, 0x00000502},
+	"\u00f1":       {0x21180402, 0x000005a2},
+	"\u00d1":       {0x21180408, 0x000005a2},
+	"\u1e45":       {0x21180402, 0x000005c2},
+	"\u1e44":       {0x21180408, 0x000005c2},
+	"\u0146":       {0x21180402, 0x00000602},
+	"\u0145":       {0x21180408, 0x00000602},
+	"\u1e47":       {0x21180402, 0x00000842},
+	"\u1e46":       {0x21180408, 0x00000842},
+	"\u1e4b":       {0x21180402, 0x000008c2},
+	"\u1e4a":       {0x21180408, 0x000008c2},
+	"\u1e49":       {0x21180402, 0x00000922},
+	"\u1e48":       {0x21180408, 0x00000922},
+	"\u01cc":       {0x21180404, 0x20ab0404},
+	"\u01cb":       {0x2118040a, 0x20ab0404},
+	"\u01ca":       {0x2118040a, 0x20ab040a},
+	"\u0274":       {0x211c0402},
+	"\u0272":       {0x21230402},
+	"\u019d":       {0x21230408},
+	"\u019e":       {0x21270402},
+	"\u0220":       {0x21270408},
+	"\u0273":       {0x212d0402},
+	"\u0235":       {0x21310402},
+	"\u014b":       {0x21370402},
+	"\u014a":       {0x21370408},
+	"o":            {0x213c0402},
+	"\u0366":       {0x213c0404},
+	"O":            {0x213c0408},
+	"\u00ba":       {0x213c0414},
+	"\u00f3":       {0x213c0402, 0x00000482},
+	"\u00d3":       {0x213c0408, 0x00000482},
+	"\u00f2":       {0x213c0402, 0x000004a2},
+	"\u00d2":       {0x213c0408, 0x000004a2},
+	"\u014f":       {0x213c0402, 0x000004c2},
+	"\u014e":       {0x213c0408, 0x000004c2},
+	"\u00f4":       {0x213c0402, 0x000004e2},
+	"\u00d4":       {0x213c0408, 0x000004e2},
+	"\u1ed1":       {0x213c0402, 0x000004e2, 0x00000482},
+	"\u1ed0":       {0x213c0408, 0x000004e2, 0x00000482},
+	"\u1ed3":       {0x213c0402, 0x000004e2, 0x000004a2},
+	"\u1ed2":       {0x213c0408, 0x000004e2, 0x000004a2},
+	"\u1ed7":       {0x213c0402, 0x000004e2, 0x000005a2},
+	"\u1ed6":       {0x213c0408, 0x000004e2, 0x000005a2},
+	"\u1ed5":       {0x213c0402, 0x000004e2, 0x00000762},
+	"\u1ed4":       {0x213c0408, 0x000004e2, 0x00000762},
+	"\u01d2":       {0x213c0402, 0x00000502},
+	"\u01d1":       {0x213c0408, 0x00000502},
+	"\u00f6":       {0x213c0402, 0x00000562},
+	"\u00d6":       {0x213c0408, 0x00000562},
+	"\u022b":       {0x213c0402, 0x00000562, 0x00000642},
+	"\u022a":       {0x213c0408, 0x00000562, 0x00000642},
+	"\u0151":       {0x213c0402, 0x00000582},
+	"\u0150":       {0x213c0408, 0x00000582},
+	"\u00f5":       {0x213c0402, 0x000005a2},
+	"\u00d5":       {0x213c0408, 0x000005a2},
+	"\u1e4d":       {0x213c0402, 0x000005a2, 0x00000482},
+	"\u1e4c":       {0x213c0408, 0x000005a2, 0x00000482},
+	"\u1e4f":       {0x213c0402, 0x000005a2, 0x00000562},
+	"\u1e4e":       {0x213c0408, 0x000005a2, 0x00000562},
+	"\u022d":       {0x213c0402, 0x000005a2, 0x00000642},
+	"\u022c":       {0x213c0408, 0x000005a2, 0x00000642},
+	"\u022f":       {0x213c0402, 0x000005c2},
+	"\u022e":       {0x213c0408, 0x000005c2},
+	"\u0231":       {0x213c0402, 0x000005c2, 0x00000642},
+	"\u0230":       {0x213c0408, 0x000005c2, 0x00000642},
+	"\u00f8":       {0x213c0402, 0x000005e2},
+	"\u00d8":       {0x213c0408, 0x000005e2},
+	"\u01ff":       {0x213c0402, 0x000005e2, 0x00000482},
+	"\u01fe":       {0x213c0408, 0x000005e2, 0x00000482},
+	"\u01eb":       {0x213c0402, 0x00000622},
+	"\u01ea":       {0x213c0408, 0x00000622},
+	"\u01ed":       {0x213c0402, 0x00000622, 0x00000642},
+	"\u01ec":       {0x213c0408, 0x00000622, 0x00000642},
+	"\u014d":       {0x213c0402, 0x00000642},
+	"\u014c":       {0x213c0408, 0x00000642},
+	"\u1e53":       {0x213c0402, 0x00000642, 0x00000482},
+	"\u1e52":       {0x213c0408, 0x00000642, 0x00000482},
+	"\u1e51":       {0x213c0402, 0x00000642, 0x000004a2},
+	"\u1e50":       {0x213c0408, 0x00000642, 0x000004a2},
+	"\u1ecf":       {0x213c0402, 0x00000762},
+	"\u1ece":       {0x213c0408, 0x00000762},
+	"\u020d":       {0x213c0402, 0x00000782},
+	"\u020c":       {0x213c0408, 0x00000782},
+	"\u020f":       {0x213c0402, 0x000007c2},
+	"\u020e":       {0x213c0408, 0x000007c2},
+	"\u01a1":       {0x213c0402, 0x000007e2},
+	"\u01a0":       {0x213c0408, 0x000007e2},
+	"\u1edb":       {0x213c0402, 0x000007e2, 0x00000482},
+	"\u1eda":       {0x213c0408, 0x000007e2, 0x00000482},
+	"\u1edd":       {0x213c0402, 0x000007e2, 0x000004a2},
+	"\u1edc":       {0x213c0408, 0x000007e2, 0x000004a2},
+	"\u1ee1":       {0x213c0402, 0x000007e2, 0x000005a2},
+	"\u1ee0":       {0x213c0408, 0x000007e2, 0x000005a2},
+	"\u1edf":       {0x213c0402, 0x000007e2, 0x00000762},
+	"\u1ede":       {0x213c0408, 0x000007e2, 0x00000762},
+	"\u1ee3":       {0x213c0402, 0x000007e2, 0x00000842},
+	"\u1ee2":       {0x213c0408, 0x000007e2, 0x00000842},
+	"\u1ecd":       {0x213c0402, 0x00000842},
+	"\u1ecc":       {0x213c0408, 0x00000842},
+	"\u1ed9":       {0x213c0402, 0x00000842, 0x000004e2},
+	"\u1ed8":       {0x213c0408, 0x00000842, 0x000004e2},
+	"\u0153":       {0x213c0404, 0x00002304, 0x20070404},
+	"\u0152":       {0x213c040a, 0x00002304, 0x2007040a},
+	"\u0276":       {0x21430402},
+	"\u0254":       {0x214f0402},
+	"\u0186":       {0x214f0408},
+	"\u0275":       {0x215c0402},
+	"\u019f":       {0x215c0408},
+	"\u0277":       {0x21610402},
+	"\u0223":       {0x21660402},
+	"\u0222":       {0x21660408},
+	"p":            {0x216b0402},
+	"P":            {0x216b0408},
+	"\u1e55":       {0x216b0402, 0x00000482},
+	"\u1e54":       {0x216b0408, 0x00000482},
+	"\u1e57":       {0x216b0402, 0x000005c2},
+	"\u1e56":       {0x216b0408, 0x000005c2},
+	"\u01a5":       {0x21740402},
+	"\u01a4":       {0x21740408},
+	"\u0278":       {0x217b0402},
+	"q":            {0x21800402},
+	"Q":            {0x21800408},
+	"\u0239":       {0x21800404, 0x216b0404},
+	"\u02a0":       {0x21870402},
+	"\u024b":       {0x218b0402},
+	"\u024a":       {0x218b0408},
+	"\u0138":       {0x218f0402},
+	"r":            {0x21930402},
+	"\u036c":       {0x21930404},
+	"R":            {0x21930408},
+	"\u02b3":       {0x21930414},
+	"\u0155":       {0x21930402, 0x00000482},
+	"\u0154":       {0x21930408, 0x00000482},
+	"\u0159":       {0x21930402, 0x00000502},
+	"\u0158":       {0x21930408, 0x00000502},
+	"\u1e59":       {0x21930402, 0x000005c2},
+	"\u1e58":       {0x21930408, 0x000005c2},
+	"\u0157":       {0x21930402, 0x00000602},
+	"\u0156":       {0x21930408, 0x00000602},
+	"\u0211":       {0x21930402, 0x00000782},
+	"\u0210":       {0x21930408, 0x00000782},
+	"\u0213":       {0x21930402, 0x000007c2},
+	"\u0212":       {0x21930408, 0x000007c2},
+	"\u1e5b":       {0x21930402, 0x00000842},
+	"\u1e5a":       {0x21930408, 0x00000842},
+	"\u1e5d":       {0x21930402, 0x00000842, 0x00000642},
+	"\u1e5c":       {0x21930408, 0x00000842, 0x00000642},
+	"\u1e5f":       {0x21930402, 0x00000922},
+	"\u1e5e":       {0x21930408, 0x00000922},
+	"\u20a8":       {0x2193040a, 0x21d20404},
+	"\u0280":       {0x21980402},
+	"\u01a6":       {0x21980408},
+	"\u024d":       {0x219f0402},
+	"\u024c":       {0x219f0408},
+	"\u0279":       {0x21a40402},
+	"\u02b4":       {0x21a40414},
+	"\u027a":       {0x21a90402},
+	"\u027b":       {0x21ae0402},
+	"\u02b5":       {0x21ae0414},
+	"\u027c":       {0x21b30402},
+	"\u027d":       {0x21b70402},
+	"\u027e":       {0x21bc0402},
+	"\u027f":       {0x21c10402},
+	"\u0281":       {0x21ca0402},
+	"\u02b6":       {0x21ca0414},
+	"s":            {0x21d20402},
+	"S":            {0x21d20408},
+	"\u02e2":       {0x21d20414},
+	"\u015b":       {0x21d20402, 0x00000482},
+	"\u015a":       {0x21d20408, 0x00000482},
+	"\u1e65":       {0x21d20402, 0x00000482, 0x000005c2},
+	"\u1e64":       {0x21d20408, 0x00000482, 0x000005c2},
+	"\u015d":       {0x21d20402, 0x000004e2},
+	"\u015c":       {0x21d20408, 0x000004e2},
+	"\u0161":       {0x21d20402, 0x00000502},
+	"\u0160":       {0x21d20408, 0x00000502},
+	"\u1e67":       {0x21d20402, 0x00000502, 0x000005c2},
+	"\u1e66":       {0x21d20408, 0x00000502, 0x000005c2},
+	"\u1e61":       {0x21d20402, 0x000005c2},
+	"\u1e60":       {0x21d20408, 0x000005c2},
+	"\u015f":       {0x21d20402, 0x00000602},
+	"\u015e":       {0x21d20408, 0x00000602},
+	"\u1e63":       {0x21d20402, 0x00000842},
+	"\u1e62":       {0x21d20408, 0x00000842},
+	"\u1e69":       {0x21d20402, 0x00000842, 0x000005c2},
+	"\u1e68":       {0x21d20408, 0x00000842, 0x000005c2},
+	"\u0219":       {0x21d20402, 0x000008a2},
+	"\u0218":       {0x21d20408, 0x000008a2},
+	"\u017f":       {0x21d20404, 0x00002324},
+	"\u1e9b":       {0x21d20404, 0x00002324, 0x000005c2},
+	"\u00df":       {0x21d20404, 0x00002304, 0x21d20404},
+	"\u1e9e":       {0x21d2040a, 0x00002304, 0x21d2040a},
+	"\u0282":       {0x21da0402},
+	"\u023f":       {0x21de0402},
+	"\u1e9c":       {0x21e20402},
+	"\u1e9d":       {0x21e30402},
+	"\u0283":       {0x21e40402},
+	"\u01a9":       {0x21e40408},
+	"\u01aa":       {0x21ea0402},
+	"\u0285":       {0x21ee0402},
+	"\u0286":       {0x21f30402},
+	"t":            {0x21f70402},
+	"\u036d":       {0x21f70404},
+	"T":            {0x21f70408},
+	"\u0165":       {0x21f70402, 0x00000502},
+	"\u0164":       {0x21f70408, 0x00000502},
+	"\u1e97":       {0x21f70402, 0x00000562},
+	"\u1e6b":       {0x21f70402, 0x000005c2},
+	"\u1e6a":       {0x21f70408, 0x000005c2},
+	"\u0163":       {0x21f70402, 0x00000602},
+	"\u0162":       {0x21f70408, 0x00000602},
+	"\u1e6d":       {0x21f70402, 0x00000842},
+	"\u1e6c":       {0x21f70408, 0x00000842},
+	"\u021b":       {0x21f70402, 0x000008a2},
+	"\u021a":       {0x21f70408, 0x000008a2},
+	"\u1e71":       {0x21f70402, 0x000008c2},
+	"\u1e70":       {0x21f70408, 0x000008c2},
+	"\u1e6f":       {0x21f70402, 0x00000922},
+	"\u1e6e":       {0x21f70408, 0x00000922},
+	"\u02a8":       {0x21f70404, 0x1fe50404},
+	"\u01be":       {0x21f70404, 0x21d20404},
+	"\u02a6":       {0x21f70404, 0x21d20404},
+	"\u02a7":       {0x21f70404, 0x21e40404},
+	"\u0167":       {0x21fc0402},
+	"\u0166":       {0x21fc0408},
+	"\u023e":       {0x22000408},
+	"\u01ab":       {0x22020402},
+	"\u01ad":       {0x22060402},
+	"\u01ac":       {0x22060408},
+	"\u0288":       {0x220a0402},
+	"\u01ae":       {0x220a0408},
+	"\u0236":       {0x220e0402},
+	"\u0287":       {0x22130402},
+	"u":            {0x22170402},
+	"\u0367":       {0x22170404},
+	"U":            {0x22170408},
+	"\u00fa":       {0x22170402, 0x00000482},
+	"\u00da":       {0x22170408, 0x00000482},
+	"\u00f9":       {0x22170402, 0x000004a2},
+	"\u00d9":       {0x22170408, 0x000004a2},
+	"\u016d":       {0x22170402, 0x000004c2},
+	"\u016c":       {0x22170408, 0x000004c2},
+	"\u00fb":       {0x22170402, 0x000004e2},
+	"\u00db":       {0x22170408, 0x000004e2},
+	"\u01d4":       {0x22170402, 0x00000502},
+	"\u01d3":       {0x22170408, 0x00000502},
+	"\u016f":       {0x22170402, 0x00000522},
+	"\u016e":       {0x22170408, 0x00000522},
+	"\u00fc":       {0x22170402, 0x00000562},
+	"\u00dc":       {0x22170408, 0x00000562},
+	"\u01d8":       {0x22170402, 0x00000562, 0x00000482},
+	"\u01d7":       {0x22170408, 0x00000562, 0x00000482},
+	"\u01dc":       {0x22170402, 0x00000562, 0x000004a2},
+	"\u01db":       {0x22170408, 0x00000562, 0x000004a2},
+	"\u01da":       {0x22170402, 0x00000562, 0x00000502},
+	"\u01d9":       {0x22170408, 0x00000562, 0x00000502},
+	"\u01d6":       {0x22170402, 0x00000562, 0x00000642},
+	"\u01d5":       {0x22170408, 0x00000562, 0x00000642},
+	"\u0171":       {0x22170402, 0x00000582},
+	"\u0170":       {0x22170408, 0x00000582},
+	"\u0169":       {0x22170402, 0x000005a2},
+	"\u0168":       {0x22170408, 0x000005a2},
+	"\u1e79":       {0x22170402, 0x000005a2, 0x00000482},
+	"\u1e78":       {0x22170408, 0x000005a2, 0x00000482},
+	"\u0173":       {0x22170402, 0x00000622},
+	"\u0172":       {0x22170408, 0x00000622},
+	"\u016b":       {0x22170402, 0x00000642},
+	"\u016a":       {0x22170408, 0x00000642},
+	"\u1e7b":       {0x22170402, 0x00000642, 0x00000562},
+	"\u1e7a":       {0x22170408, 0x00000642, 0x00000562},
+	"\u1ee7":       {0x22170402, 0x00000762},
+	"\u1ee6":       {0x22170408, 0x00000762},
+	"\u0215":       {0x22170402, 0x00000782},
+	"\u0214":       {0x22170408, 0x00000782},
+	"\u0217":       {0x22170402, 0x000007c2},
+	"\u0216":       {0x22170408, 0x000007c2},
+	"\u01b0":       {0x22170402, 0x000007e2},
+	"\u01af":       {0x22170408, 0x000007e2},
+	"\u1ee9":       {0x22170402, 0x000007e2, 0x00000482},
+	"\u1ee8":       {0x22170408, 0x000007e2, 0x00000482},
+	"\u1eeb":       {0x22170402, 0x000007e2, 0x000004a2},
+	"\u1eea":       {0x22170408, 0x000007e2, 0x000004a2},
+	"\u1eef":       {0x22170402, 0x000007e2, 0x000005a2},
+	"\u1eee":       {0x22170408, 0x000007e2, 0x000005a2},
+	"\u1eed":       {0x22170402, 0x000007e2, 0x00000762},
+	"\u1eec":       {0x22170408, 0x000007e2, 0x00000762},
+	"\u1ef1":       {0x22170402, 0x000007e2, 0x00000842},
+	"\u1ef0":       {0x22170408, 0x000007e2, 0x00000842},
+	"\u1ee5":       {0x22170402, 0x00000842},
+	"\u1ee4":       {0x22170408, 0x00000842},
+	"\u1e73":       {0x22170402, 0x00000862},
+	"\u1e72":       {0x22170408, 0x00000862},
+	"\u1e77":       {0x22170402, 0x000008c2},
+	"\u1e76":       {0x22170408, 0x000008c2},
+	"\u1e75":       {0x22170402, 0x00000902},
+	"\u1e74":       {0x22170408, 0x00000902},
+	"\u0289":       {0x22220402},
+	"\u0244":       {0x22220408},
+	"\u0265":       {0x222c0402},
+	"\u02ae":       {0x22300402},
+	"\u02af":       {0x22340402},
+	"\u026f":       {0x22380402},
+	"\u019c":       {0x22380408},
+	"\u0270":       {0x223e0402},
+	"\u028a":       {0x22420402},
+	"\u01b1":       {0x22420408},
+	"v":            {0x22470402},
+	"\u036e":       {0x22470404},
+	"V":            {0x22470408},
+	"\u1e7d":       {0x22470402, 0x000005a2},
+	"\u1e7c":       {0x22470408, 0x000005a2},
+	"\u1e7f":       {0x22470402, 0x00000842},
+	"\u1e7e":       {0x22470408, 0x00000842},
+	"\u028b":       {0x224e0402},
+	"\u01b2":       {0x224e0408},
+	"\u1efd":       {0x22540402},
+	"\u1efc":       {0x22540408},
+	"\u028c":       {0x22550402},
+	"\u0245":       {0x22550408},
+	"w":            {0x22590402},
+	"W":            {0x22590408},
+	"\u02b7":       {0x22590414},
+	"\u1e83":       {0x22590402, 0x00000482},
+	"\u1e82":       {0x22590408, 0x00000482},
+	"\u1e81":       {0x22590402, 0x000004a2},
+	"\u1e80":       {0x22590408, 0x000004a2},
+	"\u0175":       {0x22590402, 0x000004e2},
+	"\u0174":       {0x22590408, 0x000004e2},
+	"\u1e98":       {0x22590402, 0x00000522},
+	"\u1e85":       {0x22590402, 0x00000562},
+	"\u1e84":       {0x22590408, 0x00000562},
+	"\u1e87":       {0x22590402, 0x000005c2},
+	"\u1e86":       {0x22590408, 0x000005c2},
+	"\u1e89":       {0x22590402, 0x00000842},
+	"\u1e88":       {0x22590408, 0x00000842},
+	"\u028d":       {0x22600402},
+	"x":            {0x22640402},
+	"\u036f":       {0x22640404},
+	"X":            {0x22640408},
+	"\u02e3":       {0x22640414},
+	"\u1e8d":       {0x22640402, 0x00000562},
+	"\u1e8c":       {0x22640408, 0x00000562},
+	"\u1e8b":       {0x22640402, 0x000005c2},
+	"\u1e8a":       {0x22640408, 0x000005c2},
+	"y":            {0x22700402},
+	"Y":            {0x22700408},
+	"\u02b8":       {0x22700414},
+	"\u00fd":       {0x22700402, 0x00000482},
+	"\u00dd":       {0x22700408, 0x00000482},
+	"\u1ef3":       {0x22700402, 0x000004a2},
+	"\u1ef2":       {0x22700408, 0x000004a2},
+	"\u0177":       {0x22700402, 0x000004e2},
+	"\u0176":       {0x22700408, 0x000004e2},
+	"\u1e99":       {0x22700402, 0x00000522},
+	"\u00ff":       {0x22700402, 0x00000562},
+	"\u0178":       {0x22700408, 0x00000562},
+	"\u1ef9":       {0x22700402, 0x000005a2},
+	"\u1ef8":       {0x22700408, 0x000005a2},
+	"\u1e8f":       {0x22700402, 0x000005c2},
+	"\u1e8e":       {0x22700408, 0x000005c2},
+	"\u0233":       {0x22700402, 0x00000642},
+	"\u0232":       {0x22700408, 0x00000642},
+	"\u1ef7":       {0x22700402, 0x00000762},
+	"\u1ef6":       {0x22700408, 0x00000762},
+	"\u1ef5":       {0x22700402, 0x00000842},
+	"\u1ef4":       {0x22700408, 0x00000842},
+	"\u028f":       {0x22740402},
+	"\u024f":       {0x22780402},
+	"\u024e":       {0x22780408},
+	"\u01b4":       {0x227c0402},
+	"\u01b3":       {0x227c0408},
+	"\u1eff":       {0x22800402},
+	"\u1efe":       {0x22800408},
+	"\u021d":       {0x22820402},
+	"\u021c":       {0x22820408},
+	"z":            {0x22860402},
+	"Z":            {0x22860408},
+	"\u017a":       {0x22860402, 0x00000482},
+	"\u0179":       {0x22860408, 0x00000482},
+	"\u1e91":       {0x22860402, 0x000004e2},
+	"\u1e90":       {0x22860408, 0x000004e2},
+	"\u017e":       {0x22860402, 0x00000502},
+	"\u017d":       {0x22860408, 0x00000502},
+	"\u017c":       {0x22860402, 0x000005c2},
+	"\u017b":       {0x22860408, 0x000005c2},
+	"\u1e93":       {0x22860402, 0x00000842},
+	"\u1e92":       {0x22860408, 0x00000842},
+	"\u1e95":       {0x22860402, 0x00000922},
+	"\u1e94":       {0x22860408, 0x00000922},
+	"\u018d":       {0x22860404, 0x22590404},
+	"\u01b6":       {0x228b0402},
+	"\u01b5":       {0x228b0408},
+	"\u0225":       {0x22910402},
+	"\u0224":       {0x22910408},
+	"\u0290":       {0x22950402},
+	"\u0291":       {0x22990402},
+	"\u0240":       {0x229d0402},
+	"\u0292":       {0x22a30402},
+	"\u01b7":       {0x22a30408},
+	"\u01ef":       {0x22a30402, 0x00000502},
+	"\u01ee":       {0x22a30408, 0x00000502},
+	"\u01b9":       {0x22a80402},
+	"\u01b8":       {0x22a80408},
+	"\u01ba":       {0x22ad0402},
+	"\u0293":       {0x22b10402},
+	"\u00fe":       {0x22b50402},
+	"\u00de":       {0x22b50408},
+	"\u01bf":       {0x22bb0402},
+	"\u01f7":       {0x22bb0408},
+	"\u01bb":       {0x22c70402},
+	"\u01a8":       {0x22ce0402},
+	"\u01a7":       {0x22ce0408},
+	"\u01bd":       {0x22d20402},
+	"\u01bc":       {0x22d20408},
+	"\u0185":       {0x22d60402},
+	"\u0184":       {0x22d60408},
+	"\u0294":       {0x22da0402},
+	"\u0242":       {0x22de0402},
+	"\u0241":       {0x22de0408},
+	"\u02c0":       {0x22e20402},
+	"\u02bc":       {0x22e30402},
+	"\u0149":       {0x22e30404, 0x21180404},
+	"\u02ee":       {0x22e40402},
+	"\u02be":       {0x22e50402},
+	"\u0295":       {0x22e90402},
+	"\u02e4":       {0x22e90414},
+	"\u02bf":       {0x22ed0402},
+	"\u02c1":       {0x22ee0402},
+	"\u02a1":       {0x22f20402},
+	"\u02a2":       {0x22f60402},
+	"\u0296":       {0x22fa0402},
+	"\u01c0":       {0x22fe0402},
+	"\u01c1":       {0x23020402},
+	"\u01c2":       {0x23060402},
+	"\u01c3":       {0x230a0402},
+	"\u0297":       {0x230e0402},
+	"\u0298":       {0x23120402},
+	"\u02ac":       {0x23160402},
+	"\u02ad":       {0x231a0402},
+	"\u03b1":       {0x231e0402},
+	"\u0391":       {0x231e0408},
+	"\u1f00":       {0x231e0402, 0x00000442},
+	"\u1f08":       {0x231e0408, 0x00000442},
+	"\u1f04":       {0x231e0402, 0x00000442, 0x00000482},
+	"\u1f0c":       {0x231e0408, 0x00000442, 0x00000482},
+	"\u1f84":       {0x231e0402, 0x00000442, 0x00000482, 0x00000982},
+	"\u1f8c":       {0x231e0408, 0x00000442, 0x00000482, 0x00000982},
+	"\u1f02":       {0x231e0402, 0x00000442, 0x000004a2},
+	"\u1f0a":       {0x231e0408, 0x00000442, 0x000004a2},
+	"\u1f82":       {0x231e0402, 0x00000442, 0x000004a2, 0x00000982},
+	"\u1f8a":       {0x231e0408, 0x00000442, 0x000004a2, 0x00000982},
+	"\u1f06":       {0x231e0402, 0x00000442, 0x00000542},
+	"\u1f0e":       {0x231e0408, 0x00000442, 0x00000542},
+	"\u1f86":       {0x231e0402, 0x00000442, 0x00000542, 0x00000982},
+	"\u1f8e":       {0x231e0408, 0x00000442, 0x00000542, 0x00000982},
+	"\u1f80":       {0x231e0402, 0x00000442, 0x00000982},
+	"\u1f88":       {0x231e0408, 0x00000442, 0x00000982},
+	"\u1f01":       {0x231e0402, 0x00000462},
+	"\u1f09":       {0x231e0408, 0x00000462},
+	"\u1f05":       {0x231e0402, 0x00000462, 0x00000482},
+	"\u1f0d":       {0x231e0408, 0x00000462, 0x00000482},
+	"\u1f85":       {0x231e0402, 0x00000462, 0x00000482, 0x00000982},
+	"\u1f8d":       {0x231e0408, 0x00000462, 0x00000482, 0x00000982},
+	"\u1f03":       {0x231e0402, 0x00000462, 0x000004a2},
+	"\u1f0b":       {0x231e0408, 0x00000462, 0x000004a2},
+	"\u1f83":       {0x231e0402, 0x00000462, 0x000004a2, 0x00000982},
+	"\u1f8b":       {0x231e0408, 0x00000462, 0x000004a2, 0x00000982},
+	"\u1f07":       {0x231e0402, 0x00000462, 0x00000542},
+	"\u1f0f":       {0x231e0408, 0x00000462, 0x00000542},
+	"\u1f87":       {0x231e0402, 0x00000462, 0x00000542, 0x00000982},
+	"\u1f8f":       {0x231e0408, 0x00000462, 0x00000542, 0x00000982},
+	"\u1f81":       {0x231e0402, 0x00000462, 0x00000982},
+	"\u1f89":       {0x231e0408, 0x00000462, 0x00000982},
+	"\u03ac":       {0x231e0402, 0x00000482},
+	"\u1f71":       {0x231e0402, 0x00000482},
+	"\u0386":       {0x231e0408, 0x00000482},
+	"\u1fbb":       {0x231e0408, 0x00000482},
+	"\u1fb4":       {0x231e0402, 0x00000482, 0x00000982},
+	"\u1f70":       {0x231e0402, 0x000004a2},
+	"\u1fba":       {0x231e0408, 0x000004a2},
+	"\u1fb2":       {0x231e0402, 0x000004a2, 0x00000982},
+	"\u1fb0":       {0x231e0402, 0x000004c2},
+	"\u1fb8":       {0x231e0408, 0x000004c2},
+	"\u1fb6":       {0x231e0402, 0x00000542},
+	"\u1fb7":       {0x231e0402, 0x00000542, 0x00000982},
+	"\u1fb1":       {0x231e0402, 0x00000642},
+	"\u1fb9":       {0x231e0408, 0x00000642},
+	"\u1fb3":       {0x231e0402, 0x00000982},
+	"\u1fbc":       {0x231e0408, 0x00000982},
+	"\u03b2":       {0x231f0402},
+	"\u03d0":       {0x231f0404},
+	"\u0392":       {0x231f0408},
+	"\u03b3":       {0x23200402},
+	"\u0393":       {0x23200408},
+	"\u03b4":       {0x23220402},
+	"\u0394":       {0x23220408},
+	"\u03b5":       {0x23230402},
+	"\u03f5":       {0x23230404},
+	"\u0395":       {0x23230408},
+	"\u1f10":       {0x23230402, 0x00000442},
+	"\u1f18":       {0x23230408, 0x00000442},
+	"\u1f14":       {0x23230402, 0x00000442, 0x00000482},
+	"\u1f1c":       {0x23230408, 0x00000442, 0x00000482},
+	"\u1f12":       {0x23230402, 0x00000442, 0x000004a2},
+	"\u1f1a":       {0x23230408, 0x00000442, 0x000004a2},
+	"\u1f11":       {0x23230402, 0x00000462},
+	"\u1f19":       {0x23230408, 0x00000462},
+	"\u1f15":       {0x23230402, 0x00000462, 0x00000482},
+	"\u1f1d":       {0x23230408, 0x00000462, 0x00000482},
+	"\u1f13":       {0x23230402, 0x00000462, 0x000004a2},
+	"\u1f1b":       {0x23230408, 0x00000462, 0x000004a2},
+	"\u03ad":       {0x23230402, 0x00000482},
+	"\u1f73":       {0x23230402, 0x00000482},
+	"\u0388":       {0x23230408, 0x00000482},
+	"\u1fc9":       {0x23230408, 0x00000482},
+	"\u1f72":       {0x23230402, 0x000004a2},
+	"\u1fc8":       {0x23230408, 0x000004a2},
+	"\u03dd":       {0x23240402},
+	"\u03dc":       {0x23240408},
+	"\u0377":       {0x23250402},
+	"\u0376":       {0x23250408},
+	"\u03db":       {0x23260402},
+	"\u03da":       {0x23260408},
+	"\u03b6":       {0x23270402},
+	"\u0396":       {0x23270408},
+	"\u0371":       {0x23280402},
+	"\u0370":       {0x23280408},
+	"\u03b7":       {0x23290402},
+	"\u0397":       {0x23290408},
+	"\u1f20":       {0x23290402, 0x00000442},
+	"\u1f28":       {0x23290408, 0x00000442},
+	"\u1f24":       {0x23290402, 0x00000442, 0x00000482},
+	"\u1f2c":       {0x23290408, 0x00000442, 0x00000482},
+	"\u1f94":       {0x23290402, 0x00000442, 0x00000482, 0x00000982},
+	"\u1f9c":       {0x23290408, 0x00000442, 0x00000482, 0x00000982},
+	"\u1f22":       {0x23290402, 0x00000442, 0x000004a2},
+	"\u1f2a":       {0x23290408, 0x00000442, 0x000004a2},
+	"\u1f92":       {0x23290402, 0x00000442, 0x000004a2, 0x00000982},
+	"\u1f9a":       {0x23290408, 0x00000442, 0x000004a2, 0x00000982},
+	"\u1f26":       {0x23290402, 0x00000442, 0x00000542},
+	"\u1f2e":       {0x23290408, 0x00000442, 0x00000542},
+	"\u1f96":       {0x23290402, 0x00000442, 0x00000542, 0x00000982},
+	"\u1f9e":       {0x23290408, 0x00000442, 0x00000542, 0x00000982},
+	"\u1f90":       {0x23290402, 0x00000442, 0x00000982},
+	"\u1f98":       {0x23290408, 0x00000442, 0x00000982},
+	"\u1f21":       {0x23290402, 0x00000462},
+	"\u1f29":       {0x23290408, 0x00000462},
+	"\u1f25":       {0x23290402, 0x00000462, 0x00000482},
+	"\u1f2d":       {0x23290408, 0x00000462, 0x00000482},
+	"\u1f95":       {0x23290402, 0x00000462, 0x00000482, 0x00000982},
+	"\u1f9d":       {0x23290408, 0x00000462, 0x00000482, 0x00000982},
+	"\u1f23":       {0x23290402, 0x00000462, 0x000004a2},
+	"\u1f2b":       {0x23290408, 0x00000462, 0x000004a2},
+	"\u1f93":       {0x23290402, 0x00000462, 0x000004a2, 0x00000982},
+	"\u1f9b":       {0x23290408, 0x00000462, 0x000004a2, 0x00000982},
+	"\u1f27":       {0x23290402, 0x00000462, 0x00000542},
+	"\u1f2f":       {0x23290408, 0x00000462, 0x00000542},
+	"\u1f97":       {0x23290402, 0x00000462, 0x00000542, 0x00000982},
+	"\u1f9f":       {0x23290408, 0x00000462, 0x00000542, 0x00000982},
+	"\u1f91":       {0x23290402, 0x00000462, 0x00000982},
+	"\u1f99":       {0x23290408, 0x00000462, 0x00000982},
+	"\u03ae":       {0x23290402, 0x00000482},
+	"\u1f75":       {0x23290402, 0x00000482},
+	"\u0389":       {0x23290408, 0x00000482},
+	"\u1fcb":       {0x23290408, 0x00000482},
+	"\u1fc4":       {0x23290402, 0x00000482, 0x00000982},
+	"\u1f74":       {0x23290402, 0x000004a2},
+	"\u1fca":       {0x23290408, 0x000004a2},
+	"\u1fc2":       {0x23290402, 0x000004a2, 0x00000982},
+	"\u1fc6":       {0x23290402, 0x00000542},
+	"\u1fc7":       {0x23290402, 0x00000542, 0x00000982},
+	"\u1fc3":       {0x23290402, 0x00000982},
+	"\u1fcc":       {0x23290408, 0x00000982},
+	"\u03b8":       {0x232a0402},
+	"\u03d1":       {0x232a0404},
+	"\u0398":       {0x232a0408},
+	"\u03f4":       {0x232a040a},
+	"\u03b9":       {0x232b0402},
+	"\u1fbe":       {0x232b0402},
+	"\u037a":       {0x232b0404},
+	"\u0399":       {0x232b0408},
+	"\u1f30":       {0x232b0402, 0x00000442},
+	"\u1f38":       {0x232b0408, 0x00000442},
+	"\u1f34":       {0x232b0402, 0x00000442, 0x00000482},
+	"\u1f3c":       {0x232b0408, 0x00000442, 0x00000482},
+	"\u1f32":       {0x232b0402, 0x00000442, 0x000004a2},
+	"\u1f3a":       {0x232b0408, 0x00000442, 0x000004a2},
+	"\u1f36":       {0x232b0402, 0x00000442, 0x00000542},
+	"\u1f3e":       {0x232b0408, 0x00000442, 0x00000542},
+	"\u1f31":       {0x232b0402, 0x00000462},
+	"\u1f39":       {0x232b0408, 0x00000462},
+	"\u1f35":       {0x232b0402, 0x00000462, 0x00000482},
+	"\u1f3d":       {0x232b0408, 0x00000462, 0x00000482},
+	"\u1f33":       {0x232b0402, 0x00000462, 0x000004a2},
+	"\u1f3b":       {0x232b0408, 0x00000462, 0x000004a2},
+	"\u1f37":       {0x232b0402, 0x00000462, 0x00000542},
+	"\u1f3f":       {0x232b0408, 0x00000462, 0x00000542},
+	"\u03af":       {0x232b0402, 0x00000482},
+	"\u1f77":       {0x232b0402, 0x00000482},
+	"\u038a":       {0x232b0408, 0x00000482},
+	"\u1fdb":       {0x232b0408, 0x00000482},
+	"\u1f76":       {0x232b0402, 0x000004a2},
+	"\u1fda":       {0x232b0408, 0x000004a2},
+	"\u1fd0":       {0x232b0402, 0x000004c2},
+	"\u1fd8":       {0x232b0408, 0x000004c2},
+	"\u1fd6":       {0x232b0402, 0x00000542},
+	"\u03ca":       {0x232b0402, 0x00000562},
+	"\u03aa":       {0x232b0408, 0x00000562},
+	"\u0390":       {0x232b0402, 0x00000562, 0x00000482},
+	"\u1fd3":       {0x232b0402, 0x00000562, 0x00000482},
+	"\u1fd2":       {0x232b0402, 0x00000562, 0x000004a2},
+	"\u1fd7":       {0x232b0402, 0x00000562, 0x00000542},
+	"\u1fd1":       {0x232b0402, 0x00000642},
+	"\u1fd9":       {0x232b0408, 0x00000642},
+	"\u03f3":       {0x232c0402},
+	"\u037f":       {0x232c0408},
+	"\u03ba":       {0x232d0402},
+	"\u03f0":       {0x232d0404},
+	"\u039a":       {0x232d0408},
+	"\u03d7":       {0x232d0404, 0x231e0404, 0x232b0404},
+	"\u03cf":       {0x232d040a, 0x231e0404, 0x232b0404},
+	"\u03bb":       {0x232e0402},
+	"\u039b":       {0x232e0408},
+	"\u03bc":       {0x23300402},
+	"\u00b5":       {0x23300404},
+	"\u039c":       {0x23300408},
+	"\u03bd":       {0x23310402},
+	"\u039d":       {0x23310408},
+	"\u03be":       {0x23320402},
+	"\u039e":       {0x23320408},
+	"\u03bf":       {0x23330402},
+	"\u039f":       {0x23330408},
+	"\u1f40":       {0x23330402, 0x00000442},
+	"\u1f48":       {0x23330408, 0x00000442},
+	"\u1f44":       {0x23330402, 0x00000442, 0x00000482},
+	"\u1f4c":       {0x23330408, 0x00000442, 0x00000482},
+	"\u1f42":       {0x23330402, 0x00000442, 0x000004a2},
+	"\u1f4a":       {0x23330408, 0x00000442, 0x000004a2},
+	"\u1f41":       {0x23330402, 0x00000462},
+	"\u1f49":       {0x23330408, 0x00000462},
+	"\u1f45":       {0x23330402, 0x00000462, 0x00000482},
+	"\u1f4d":       {0x23330408, 0x00000462, 0x00000482},
+	"\u1f43":       {0x23330402, 0x00000462, 0x000004a2},
+	"\u1f4b":       {0x23330408, 0x00000462, 0x000004a2},
+	"\u03cc":       {0x23330402, 0x00000482},
+	"\u1f79":       {0x23330402, 0x00000482},
+	"\u038c":       {0x23330408, 0x00000482},
+	"\u1ff9":       {0x23330408, 0x00000482},
+	"\u1f78":       {0x23330402, 0x000004a2},
+	"\u1ff8":       {0x23330408, 0x000004a2},
+	"\u03c0":       {0x23340402},
+	"\u03d6":       {0x23340404},
+	"\u03a0":       {0x23340408},
+	"\u03fb":       {0x23360402},
+	"\u03fa":       {0x23360408},
+	"\u03df":       {0x23370402},
+	"\u03de":       {0x23370408},
+	"\u03d9":       {0x23380402},
+	"\u03d8":       {0x23380408},
+	"\u03c1":       {0x23390402},
+	"\u03f1":       {0x23390404},
+	"\u03a1":       {0x23390408},
+	"\u1fe4":       {0x23390402, 0x00000442},
+	"\u1fe5":       {0x23390402, 0x00000462},
+	"\u1fec":       {0x23390408, 0x00000462},
+	"\u03fc":       {0x233b0402},
+	"\u03c3":       {0x233c0402},
+	"\u03f2":       {0x233c0404},
+	"\u03a3":       {0x233c0408},
+	"\u03f9":       {0x233c040a},
+	"\u03c2":       {0x233c0419},
+	"\u037c":       {0x233d0402},
+	"\u03fe":       {0x233d0408},
+	"\u037b":       {0x233e0402},
+	"\u03fd":       {0x233e0408},
+	"\u037d":       {0x233f0402},
+	"\u03ff":       {0x233f0408},
+	"\u03c4":       {0x23400402},
+	"\u03a4":       {0x23400408},
+	"\u03c5":       {0x23410402},
+	"\u03a5":       {0x23410408},
+	"\u03d2":       {0x2341040a},
+	"\u1f50":       {0x23410402, 0x00000442},
+	"\u1f54":       {0x23410402, 0x00000442, 0x00000482},
+	"\u1f52":       {0x23410402, 0x00000442, 0x000004a2},
+	"\u1f56":       {0x23410402, 0x00000442, 0x00000542},
+	"\u1f51":       {0x23410402, 0x00000462},
+	"\u1f59":       {0x23410408, 0x00000462},
+	"\u1f55":       {0x23410402, 0x00000462, 0x00000482},
+	"\u1f5d":       {0x23410408, 0x00000462, 0x00000482},
+	"\u1f53":       {0x23410402, 0x00000462, 0x000004a2},
+	"\u1f5b":       {0x23410408, 0x00000462, 0x000004a2},
+	"\u1f57":       {0x23410402, 0x00000462, 0x00000542},
+	"\u1f5f":       {0x23410408, 0x00000462, 0x00000542},
+	"\u03cd":       {0x23410402, 0x00000482},
+	"\u1f7b":       {0x23410402, 0x00000482},
+	"\u038e":       {0x23410408, 0x00000482},
+	"\u1feb":       {0x23410408, 0x00000482},
+	"\u03d3":       {0x2341040a, 0x00000482},
+	"\u1f7a":       {0x23410402, 0x000004a2},
+	"\u1fea":       {0x23410408, 0x000004a2},
+	"\u1fe0":       {0x23410402, 0x000004c2},
+	"\u1fe8":       {0x23410408, 0x000004c2},
+	"\u1fe6":       {0x23410402, 0x00000542},
+	"\u03cb":       {0x23410402, 0x00000562},
+	"\u03ab":       {0x23410408, 0x00000562},
+	"\u03d4":       {0x2341040a, 0x00000562},
+	"\u03b0":       {0x23410402, 0x00000562, 0x00000482},
+	"\u1fe3":       {0x23410402, 0x00000562, 0x00000482},
+	"\u1fe2":       {0x23410402, 0x00000562, 0x000004a2},
+	"\u1fe7":       {0x23410402, 0x00000562, 0x00000542},
+	"\u1fe1":       {0x23410402, 0x00000642},
+	"\u1fe9":       {0x23410408, 0x00000642},
+	"\u03c6":       {0x23420402},
+	"\u03d5":       {0x23420404},
+	"\u03a6":       {0x23420408},
+	"\u03c7":       {0x23430402},
+	"\u03a7":       {0x23430408},
+	"\u03c8":       {0x23440402},
+	"\u03a8":       {0x23440408},
+	"\u03c9":       {0x23460402},
+	"\u03a9":       {0x23460408},
+	"\u1f60":       {0x23460402, 0x00000442},
+	"\u1f68":       {0x23460408, 0x00000442},
+	"\u1f64":       {0x23460402, 0x00000442, 0x00000482},
+	"\u1f6c":       {0x23460408, 0x00000442, 0x00000482},
+	"\u1fa4":       {0x23460402, 0x00000442, 0x00000482, 0x00000982},
+	"\u1fac":       {0x23460408, 0x00000442, 0x00000482, 0x00000982},
+	"\u1f62":       {0x23460402, 0x00000442, 0x000004a2},
+	"\u1f6a":       {0x23460408, 0x00000442, 0x000004a2},
+	"\u1fa2":       {0x23460402, 0x00000442, 0x000004a2, 0x00000982},
+	"\u1faa":       {0x23460408, 0x00000442, 0x000004a2, 0x00000982},
+	"\u1f66":       {0x23460402, 0x00000442, 0x00000542},
+	"\u1f6e":       {0x23460408, 0x00000442, 0x00000542},
+	"\u1fa6":       {0x23460402, 0x00000442, 0x00000542, 0x00000982},
+	"\u1fae":       {0x23460408, 0x00000442, 0x00000542, 0x00000982},
+	"\u1fa0":       {0x23460402, 0x00000442, 0x00000982},
+	"\u1fa8":       {0x23460408, 0x00000442, 0x00000982},
+	"\u1f61":       {0x23460402, 0x00000462},
+	"\u1f69":       {0x23460408, 0x00000462},
+	"\u1f65":       {0x23460402, 0x00000462, 0x00000482},
+	"\u1f6d":       {0x23460408, 0x00000462, 0x00000482},
+	"\u1fa5":       {0x23460402, 0x00000462, 0x00000482, 0x00000982},
+	"\u1fad":       {0x23460408, 0x00000462, 0x00000482, 0x00000982},
+	"\u1f63":       {0x23460402, 0x00000462, 0x000004a2},
+	"\u1f6b":       {0x23460408, 0x00000462, 0x000004a2},
+	"\u1fa3":       {0x23460402, 0x00000462, 0x000004a2, 0x00000982},
+	"\u1fab":       {0x23460408, 0x00000462, 0x000004a2, 0x00000982},
+	"\u1f67":       {0x23460402, 0x00000462, 0x00000542},
+	"\u1f6f":       {0x23460408, 0x00000462, 0x00000542},
+	"\u1fa7":       {0x23460402, 0x00000462, 0x00000542, 0x00000982},
+	"\u1faf":       {0x23460408, 0x00000462, 0x00000542, 0x00000982},
+	"\u1fa1":       {0x23460402, 0x00000462, 0x00000982},
+	"\u1fa9":       {0x23460408, 0x00000462, 0x00000982},
+	"\u03ce":       {0x23460402, 0x00000482},
+	"\u1f7d":       {0x23460402, 0x00000482},
+	"\u038f":       {0x23460408, 0x00000482},
+	"\u1ffb":       {0x23460408, 0x00000482},
+	"\u1ff4":       {0x23460402, 0x00000482, 0x00000982},
+	"\u1f7c":       {0x23460402, 0x000004a2},
+	"\u1ffa":       {0x23460408, 0x000004a2},
+	"\u1ff2":       {0x23460402, 0x000004a2, 0x00000982},
+	"\u1ff6":       {0x23460402, 0x00000542},
+	"\u1ff7":       {0x23460402, 0x00000542, 0x00000982},
+	"\u1ff3":       {0x23460402, 0x00000982},
+	"\u1ffc":       {0x23460408, 0x00000982},
+	"\u03e1":       {0x23480402},
+	"\u03e0":       {0x23480408},
+	"\u0373":       {0x23490402},
+	"\u0372":       {0x23490408},
+	"\u03f8":       {0x234a0402},
+	"\u03f7":       {0x234a0408},
+	"\u03e3":       {0x236a0402},
+	"\u03e2":       {0x236a0408},
+	"\u03e5":       {0x236f0402},
+	"\u03e4":       {0x236f0408},
+	"\u03e7":       {0x23700402},
+	"\u03e6":       {0x23700408},
+	"\u03e9":       {0x23730402},
+	"\u03e8":       {0x23730408},
+	"\u03eb":       {0x237a0402},
+	"\u03ea":       {0x237a0408},
+	"\u03ed":       {0x237d0402},
+	"\u03ec":       {0x237d0408},
+	"\u03ef":       {0x23810402},
+	"\u03ee":       {0x23810408},
+	"\u0430":       {0x23870402},
+	"\u0410":       {0x23870408},
+	"\u04d1":       {0x23870402, 0x000004c2},
+	"\u04d0":       {0x23870408, 0x000004c2},
+	"\u04d3":       {0x23870402, 0x00000562},
+	"\u04d2":       {0x23870408, 0x00000562},
+	"\u04d9":       {0x238b0402},
+	"\u04d8":       {0x238b0408},
+	"\u04db":       {0x238b0402, 0x00000562},
+	"\u04da":       {0x238b0408, 0x00000562},
+	"\u04d5":       {0x238f0402},
+	"\u04d4":       {0x238f0408},
+	"\u0431":       {0x23930402},
+	"\u0411":       {0x23930408},
+	"\u0432":       {0x23970402},
+	"\u0412":       {0x23970408},
+	"\u0433":       {0x239b0402},
+	"\u0413":       {0x239b0408},
+	"\u0453":       {0x239b0402, 0x00000482},
+	"\u0403":       {0x239b0408, 0x00000482},
+	"\u0491":       {0x239b0404, 0x00002324},
+	"\u0490":       {0x239b040a, 0x00002324},
+	"\u0493":       {0x239f0402},
+	"\u0492":       {0x239f0408},
+	"\u04fb":       {0x23a30402},
+	"\u04fa":       {0x23a30408},
+	"\u0495":       {0x23a70402},
+	"\u0494":       {0x23a70408},
+	"\u04f7":       {0x23ab0402},
+	"\u04f6":       {0x23ab0408},
+	"\u0434":       {0x23af0402},
+	"\u0414":       {0x23af0408},
+	"\u0501":       {0x23b30402},
+	"\u0500":       {0x23b30408},
+	"\u0452":       {0x23b50402},
+	"\u0402":       {0x23b50408},
+	"\u0503":       {0x23ba0402},
+	"\u0502":       {0x23ba0408},
+	"\u0499":       {0x23bb0402},
+	"\u0498":       {0x23bb0408},
+	"\u0435":       {0x23bf0402},
+	"\u0415":       {0x23bf0408},
+	"\u0450":       {0x23bf0402, 0x000004a2},
+	"\u0400":       {0x23bf0408, 0x000004a2},
+	"\u04d7":       {0x23bf0402, 0x000004c2},
+	"\u04d6":       {0x23bf0408, 0x000004c2},
+	"\u0451":       {0x23bf0402, 0x00000562},
+	"\u0401":       {0x23bf0408, 0x00000562},
+	"\u0454":       {0x23c30402},
+	"\u0404":       {0x23c30408},
+	"\u0436":       {0x23c70402},
+	"\u0416":       {0x23c70408},
+	"\u04c2":       {0x23c70402, 0x000004c2},
+	"\u04c1":       {0x23c70408, 0x000004c2},
+	"\u04dd":       {0x23c70402, 0x00000562},
+	"\u04dc":       {0x23c70408, 0x00000562},
+	"\u052b":       {0x23cb0402},
+	"\u052a":       {0x23cb0408},
+	"\u0497":       {0x23cd0402},
+	"\u0496":       {0x23cd0408},
+	"\u0437":       {0x23d10402},
+	"\u0417":       {0x23d10408},
+	"\u04df":       {0x23d10402, 0x00000562},
+	"\u04de":       {0x23d10408, 0x00000562},
+	"\u0505":       {0x23d60402},
+	"\u0504":       {0x23d60408},
+	"\u0511":       {0x23d70402},
+	"\u0510":       {0x23d70408},
+	"\u0455":       {0x23d90402},
+	"\u0405":       {0x23d90408},
+	"\u04e1":       {0x23de0402},
+	"\u04e0":       {0x23de0408},
+	"\u0507":       {0x23e30402},
+	"\u0506":       {0x23e30408},
+	"\u0438":       {0x23e50402},
+	"\u0418":       {0x23e50408},
+	"\u045d":       {0x23e50402, 0x000004a2},
+	"\u040d":       {0x23e50408, 0x000004a2},
+	"\u04e5":       {0x23e50402, 0x00000562},
+	"\u04e4":       {0x23e50408, 0x00000562},
+	"\u04e3":       {0x23e50402, 0x00000642},
+	"\u04e2":       {0x23e50408, 0x00000642},
+	"\u048b":       {0x23e90402},
+	"\u048a":       {0x23e90408},
+	"\u0456":       {0x23ed0402},
+	"\u0406":       {0x23ed0408},
+	"\u0457":       {0x23ed0402, 0x00000562},
+	"\u0407":       {0x23ed0408, 0x00000562},
+	"\u0439":       {0x23f20402},
+	"\u0438\u0306": {0x23f20402},
+	"\u0419":       {0x23f20408},
+	"\u0418\u0306": {0x23f20408},
+	"\u0458":       {0x23f60402},
+	"\u0408":       {0x23f60408},
+	"\u043a":       {0x23fb0402},
+	"\u041a":       {0x23fb0408},
+	"\u045c":       {0x23fb0402, 0x00000482},
+	"\u040c":       {0x23fb0408, 0x00000482},
+	"\u049b":       {0x23ff0402},
+	"\u049a":       {0x23ff0408},
+	"\u04c4":       {0x24030402},
+	"\u04c3":       {0x24030408},
+	"\u04a1":       {0x24070402},
+	"\u04a0":       {0x24070408},
+	"\u049f":       {0x240b0402},
+	"\u049e":       {0x240b0408},
+	"\u049d":       {0x240f0402},
+	"\u049c":       {0x240f0408},
+	"\u051f":       {0x24130402},
+	"\u051e":       {0x24130408},
+	"\u051b":       {0x24140402},
+	"\u051a":       {0x24140408},
+	"\u043b":       {0x24150402},
+	"\u041b":       {0x24150408},
+	"\u04c6":       {0x241a0402},
+	"\u04c5":       {0x241a0408},
+	"\u052f":       {0x241e0402},
+	"\u052e":       {0x241e0408},
+	"\u0513":       {0x241f0402},
+	"\u0512":       {0x241f0408},
+	"\u0521":       {0x24200402},
+	"\u0520":       {0x24200408},
+	"\u0459":       {0x24210402},
+	"\u0409":       {0x24210408},
+	"\u0509":       {0x24260402},
+	"\u0508":       {0x24260408},
+	"\u0515":       {0x24270402},
+	"\u0514":       {0x24270408},
+	"\u043c":       {0x24280402},
+	"\u041c":       {0x24280408},
+	"\u04ce":       {0x242c0402},
+	"\u04cd":       {0x242c0408},
+	"\u043d":       {0x24310402},
+	"\u041d":       {0x24310408},
+	"\u0529":       {0x24350402},
+	"\u0528":       {0x24350408},
+	"\u04ca":       {0x24360402},
+	"\u04c9":       {0x24360408},
+	"\u04a3":       {0x243a0402},
+	"\u04a2":       {0x243a0408},
+	"\u04c8":       {0x243e0402},
+	"\u04c7":       {0x243e0408},
+	"\u0523":       {0x24420402},
+	"\u0522":       {0x24420408},
+	"\u04a5":       {0x24430402},
+	"\u04a4":       {0x24430408},
+	"\u045a":       {0x24470402},
+	"\u040a":       {0x24470408},
+	"\u050b":       {0x244b0402},
+	"\u050a":       {0x244b0408},
+	"\u043e":       {0x244c0402},
+	"\u041e":       {0x244c0408},
+	"\u04e7":       {0x244c0402, 0x00000562},
+	"\u04e6":       {0x244c0408, 0x00000562},
+	"\u04e9":       {0x24500402},
+	"\u04e8":       {0x24500408},
+	"\u04eb":       {0x24500402, 0x00000562},
+	"\u04ea":       {0x24500408, 0x00000562},
+	"\u043f":       {0x24540402},
+	"\u041f":       {0x24540408},
+	"\u0525":       {0x24580402},
+	"\u0524":       {0x24580408},
+	"\u04a7":       {0x24590402},
+	"\u04a6":       {0x24590408},
+	"\u0481":       {0x245d0402},
+	"\u0480":       {0x245d0408},
+	"\u0440":       {0x24610402},
+	"\u0420":       {0x24610408},
+	"\u048f":       {0x24650402},
+	"\u048e":       {0x24650408},
+	"\u0517":       {0x24690402},
+	"\u0516":       {0x24690408},
+	"\u0441":       {0x246a0402},
+	"\u0421":       {0x246a0408},
+	"\u050d":       {0x246e0402},
+	"\u050c":       {0x246e0408},
+	"\u04ab":       {0x246f0402},
+	"\u04aa":       {0x246f0408},
+	"\u0442":       {0x24730402},
+	"\u0422":       {0x24730408},
+	"\u050f":       {0x24780402},
+	"\u050e":       {0x24780408},
+	"\u04ad":       {0x24790402},
+	"\u04ac":       {0x24790408},
+	"\u045b":       {0x247e0402},
+	"\u040b":       {0x247e0408},
+	"\u0443":       {0x24820402},
+	"\u0423":       {0x24820408},
+	"\u045e":       {0x24820402, 0x000004c2},
+	"\u040e":       {0x24820408, 0x000004c2},
+	"\u04f1":       {0x24820402, 0x00000562},
+	"\u04f0":       {0x24820408, 0x00000562},
+	"\u04f3":       {0x24820402, 0x00000582},
+	"\u04f2":       {0x24820408, 0x00000582},
+	"\u04ef":       {0x24820402, 0x00000642},
+	"\u04ee":       {0x24820408, 0x00000642},
+	"\u04af":       {0x24860402},
+	"\u04ae":       {0x24860408},
+	"\u04b1":       {0x248a0402},
+	"\u04b0":       {0x248a0408},
+	"\u0479":       {0x248f0402},
+	"\u0478":       {0x248f0408},
+	"\u0444":       {0x24930402},
+	"\u0424":       {0x24930408},
+	"\u0445":       {0x24970402},
+	"\u0425":       {0x24970408},
+	"\u04fd":       {0x249b0402},
+	"\u04fc":       {0x249b0408},
+	"\u04ff":       {0x249f0402},
+	"\u04fe":       {0x249f0408},
+	"\u04b3":       {0x24a30402},
+	"\u04b2":       {0x24a30408},
+	"\u04bb":       {0x24a70402},
+	"\u04ba":       {0x24a70408},
+	"\u0527":       {0x24ab0402},
+	"\u0526":       {0x24ab0408},
+	"\u0461":       {0x24ad0402},
+	"\u0460":       {0x24ad0408},
+	"\u047f":       {0x24b10402},
+	"\u047e":       {0x24b10408},
+	"\u047d":       {0x24b60402},
+	"\u047c":       {0x24b60408},
+	"\u047b":       {0x24ba0402},
+	"\u047a":       {0x24ba0408},
+	"\u0446":       {0x24be0402},
+	"\u0426":       {0x24be0408},
+	"\u04b5":       {0x24c40402},
+	"\u04b4":       {0x24c40408},
+	"\u0447":       {0x24c90402},
+	"\u0427":       {0x24c90408},
+	"\u04f5":       {0x24c90402, 0x00000562},
+	"\u04f4":       {0x24c90408, 0x00000562},
+	"\u052d":       {0x24cd0402},
+	"\u052c":       {0x24cd0408},
+	"\u04b7":       {0x24cf0402},
+	"\u04b6":       {0x24cf0408},
+	"\u04cc":       {0x24d30402},
+	"\u04cb":       {0x24d30408},
+	"\u04b9":       {0x24d70402},
+	"\u04b8":       {0x24d70408},
+	"\u04bd":       {0x24dc0402},
+	"\u04bc":       {0x24dc0408},
+	"\u04bf":       {0x24e00402},
+	"\u04be":       {0x24e00408},
+	"\u045f":       {0x24e40402},
+	"\u040f":       {0x24e40408},
+	"\u0448":       {0x24e80402},
+	"\u0428":       {0x24e80408},
+	"\u0449":       {0x24ed0402},
+	"\u0429":       {0x24ed0408},
+	"\u044a":       {0x24f40402},
+	"\u042a":       {0x24f40408},
+	"\u044b":       {0x24f90402},
+	"\u042b":       {0x24f90408},
+	"\u04f9":       {0x24f90402, 0x00000562},
+	"\u04f8":       {0x24f90408, 0x00000562},
+	"\u044c":       {0x24fd0402},
+	"\u042c":       {0x24fd0408},
+	"\u048d":       {0x25010402},
+	"\u048c":       {0x25010408},
+	"\u0463":       {0x25050402},
+	"\u0462":       {0x25050408},
+	"\u044d":       {0x250a0402},
+	"\u042d":       {0x250a0408},
+	"\u04ed":       {0x250a0402, 0x00000562},
+	"\u04ec":       {0x250a0408, 0x00000562},
+	"\u044e":       {0x250e0402},
+	"\u042e":       {0x250e0408},
+	"\u044f":       {0x25140402},
+	"\u042f":       {0x25140408},
+	"\u0519":       {0x25180402},
+	"\u0518":       {0x25180408},
+	"\u0465":       {0x25190402},
+	"\u0464":       {0x25190408},
+	"\u0467":       {0x251d0402},
+	"\u0466":       {0x251d0408},
+	"\u046b":       {0x25220402},
+	"\u046a":       {0x25220408},
+	"\u0469":       {0x25270402},
+	"\u0468":       {0x25270408},
+	"\u046d":       {0x252c0402},
+	"\u046c":       {0x252c0408},
+	"\u046f":       {0x25300402},
+	"\u046e":       {0x25300408},
+	"\u0471":       {0x25340402},
+	"\u0470":       {0x25340408},
+	"\u0473":       {0x25380402},
+	"\u0472":       {0x25380408},
+	"\u0475":       {0x253c0402},
+	"\u0474":       {0x253c0408},
+	"\u0477":       {0x253c0402, 0x00000782},
+	"\u0476":       {0x253c0408, 0x00000782},
+	"\u04a9":       {0x25410402},
+	"\u04a8":       {0x25410408},
+	"\u051d":       {0x25450402},
+	"\u051c":       {0x25450408},
+	"\u04cf":       {0x25460402},
+	"\u04c0":       {0x25460408},
+}
+
+// collationTailorings 为各个语言区域对 ducet 的定制规则, 规则中的排序元素会覆盖 ducet 中的同名条目.
+var collationTailorings = map[string]map[string][]uint32{
+	// 德语使用 DUCET 的默认顺序, 无需定制
+	"de": {},
+	"de-u-co-phonebk": {
+		"\u00e4":  {0x1fa20422, 0x20070422},
+		"a\u0308": {0x1fa20422, 0x20070422},
+		"\u00c4":  {0x1fa20428, 0x20070428},
+		"A\u0308": {0x1fa20428, 0x20070428},
+		"\u01df":  {0x1fa20422, 0x20070422, 0x00000642},
+		"\u01de":  {0x1fa20428, 0x20070428, 0x00000642},
+		"\u00f6":  {0x213c0422, 0x20070422},
+		"o\u0308": {0x213c0422, 0x20070422},
+		"\u00d6":  {0x213c0428, 0x20070428},
+		"O\u0308": {0x213c0428, 0x20070428},
+		"\u022b":  {0x213c0422, 0x20070422, 0x00000642},
+		"\u022a":  {0x213c0428, 0x20070428, 0x00000642},
+		"\u00fc":  {0x22170422, 0x20070422},
+		"u\u0308": {0x22170422, 0x20070422},
+		"\u00dc":  {0x22170428, 0x20070428},
+		"U\u0308": {0x22170428, 0x20070428},
+		"\u01dc":  {0x22170422, 0x20070422, 0x000004a2},
+		"\u01db":  {0x22170428, 0x20070428, 0x000004a2},
+		"\u01d8":  {0x22170422, 0x20070422, 0x00000482},
+		"\u01d7":  {0x22170428, 0x20070428, 0x00000482},
+		"\u01d6":  {0x22170422, 0x20070422, 0x00000642},
+		"\u01d5":  {0x22170428, 0x20070428, 0x00000642},
+		"\u01da":  {0x22170422, 0x20070422, 0x00000502},
+		"\u01d9":  {0x22170428, 0x20070428, 0x00000502},
+	},
+	"es": {
+		"\u00f1":  {0x21190402},
+		"n\u0303": {0x21190402},
+		"\u00d1":  {0x21190408},
+		"N\u0303": {0x21190408},
+	},
+	"es-u-co-trad": {
+		"ch":      {0x1fd70402},
+		"Ch":      {0x1fd70407},
+		"CH":      {0x1fd70408},
+		"ll":      {0x20d70402},
+		"Ll":      {0x20d70407},
+		"LL":      {0x20d70408},
+		"\u00f1":  {0x21190402},
+		"n\u0303": {0x21190402},
+		"\u00d1":  {0x21190408},
+		"N\u0303": {0x21190408},
+	},
+	"sv": {
+		"\u0111":  {0x1feb0422},
+		"d\u0335": {0x1feb0422},
+		"\u0110":  {0x1feb0428},
+		"D\u0335": {0x1feb0428},
+		"\u00f0":  {0x1feb0442},
+		"\u1dd9":  {0x1feb0442},
+		"\u00d0":  {0x1feb0448},
+		"\u00fe":  {0x21f70403, 0x20750403},
+		"\u00de":  {0x21f70409, 0x20750409},
+		"w":       {0x22470422},
+		"W":       {0x22470428},
+		"\u00fc":  {0x22700422},
+		"u\u0308": {0x22700422},
+		"\u00dc":  {0x22700428},
+		"U\u0308": {0x22700428},
+		"\u01dc":  {0x22700422, 0x000004a2},
+		"\u01db":  {0x22700428, 0x000004a2},
+		"\u01d8":  {0x22700422, 0x00000482},
+		"\u01d7":  {0x22700428, 0x00000482},
+		"\u01d6":  {0x22700422, 0x00000642},
+		"\u01d5":  {0x22700428, 0x00000642},
+		"\u01da":  {0x22700422, 0x00000502},
+		"\u01d9":  {0x22700428, 0x00000502},
+		"\u0171":  {0x22700442},
+		"u\u030b": {0x22700442},
+		"\u0170":  {0x22700448},
+		"U\u030b": {0x22700448},
+		"\u00e5":  {0x22fb0402},
+		"a\u030a": {0x22fb0402},
+		"\u00c5":  {0x22fb0408},
+		"A\u030a": {0x22fb0408},
+		"\u212b":  {0x22fb0408},
+		"\u01fb":  {0x22fb0402, 0x00000482},
+		"\u01fa":  {0x22fb0408, 0x00000482},
+		"\u00e4":  {0x22fc0402},
+		"a\u0308": {0x22fc0402},
+		"\u00c4":  {0x22fc0408},
+		"A\u0308": {0x22fc0408},
+		"\u01df":  {0x22fc0402, 0x00000642},
+		"\u01de":  {0x22fc0408, 0x00000642},
+		"\u00e6":  {0x22fc0422},
+		"\u1dd4":  {0x22fc0422},
+		"\u00c6":  {0x22fc0428},
+		"\u1d2d":  {0x22fc0434},
+		"\u01fd":  {0x22fc0422, 0x00000482},
+		"\u01fc":  {0x22fc0428, 0x00000482},
+		"\u01e3":  {0x22fc0422, 0x00000642},
+		"\u01e2":  {0x22fc0428, 0x00000642},
+		"\u0119":  {0x22fc0442},
+		"e\u0328": {0x22fc0442},
+		"\u0118":  {0x22fc0448},
+		"E\u0328": {0x22fc0448},
+		"\u00f6":  {0x22fd0402},
+		"o\u0308": {0x22fd0402},
+		"\u00d6":  {0x22fd0408},
+		"O\u0308": {0x22fd0408},
+		"\u022b":  {0x22fd0402, 0x00000642},
+		"\u022a":  {0x22fd0408, 0x00000642},
+		"\u00f8":  {0x22fd0422},
+		"o\u0338": {0x22fd0422},
+		"\u00d8":  {0x22fd0428},
+		"O\u0338": {0x22fd0428},
+		"\u01ff":  {0x22fd0422, 0x00000482},
+		"\u01fe":  {0x22fd0428, 0x00000482},
+		"\u0151":  {0x22fd0442},
+		"o\u030b": {0x22fd0442},
+		"\u0150":  {0x22fd0448},
+		"O\u030b": {0x22fd0448},
+		"\u0153":  {0x22fd0462},
+		"\u0152":  {0x22fd0468},
+		"\u00f4":  {0x22fd0482},
+		"o\u0302": {0x22fd0482},
+		"\u00d4":  {0x22fd0488},
+		"O\u0302": {0x22fd0488},
+		"\u1ed3":  {0x22fd0482, 0x000004a2},
+		"\u1ed2":  {0x22fd0488, 0x000004a2},
+		"\u1ed1":  {0x22fd0482, 0x00000482},
+		"\u1ed0":  {0x22fd0488, 0x00000482},
+		"\u1ed7":  {0x22fd0482, 0x000005a2},
+		"\u1ed6":  {0x22fd0488, 0x000005a2},
+		"\u1ed5":  {0x22fd0482, 0x00000762},
+		"\u1ed4":  {0x22fd0488, 0x00000762},
+		"\u1ed9":  {0x22fd0482, 0x00000842},
+		"\u1ed8":  {0x22fd0488, 0x00000842},
+	},
+}
@@ -0,0 +1,154 @@
+package comparator
+
+//go:generate go run ./internal/gen/collation -o collation_tables.go
+
+import (
+	"bytes"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+/**
+ *
+ * @Author AiTao
+ * @Date 2026-10-18 15:40
+ * @Url
+ **/
+
+// Strength 表示 Collator 的比较强度, 强度越高区分的差异越细.
+type Strength int
+
+const (
+	Primary   Strength = iota + 1 // 只区分基本字母, 例如 "a" = "á" = "A"
+	Secondary                     // 额外区分重音等附加符号, 例如 "a" = "A" < "á"
+	Tertiary                      // 额外区分大小写, 例如 "a" < "A" < "á"
+)
+
+// Collator 是基于 Unicode 排序算法(UCA)的字符串比较器, 按照多个层次依次比较排序元素的权重.
+// 内置的 DUCET 表只包含拉丁文、希腊文、西里尔文以及常用标点符号, 其余字符使用 UCA 的隐式权重,
+// 其中汉字按照码点排列在所有内置字符之后. 可变权重字符(空格、标点等)按照 non-ignorable 方式处理.
+type Collator struct {
+	strength  Strength
+	tailoring map[string][]uint32
+	maxLen    int // 排序元素表中最长条目的字符数
+}
+
+// NewCollator 函数用于创建一个指定语言区域与比较强度的 Collator, 语言区域使用 BCP 47 格式, 例如 "de"、"sv-SE".
+// 目前支持的定制规则包括:
+//
+//	de              德语, 与 DUCET 的默认顺序相同, ä、ö、ü 与 a、o、u 仅在第二层次上存在差异
+//	de-u-co-phonebk 德语电话簿排序, ä、ö、ü 分别按照 ae、oe、ue 排列
+//	es              西班牙语, ñ 排列在 n 之后
+//	es-u-co-trad    传统西班牙语, 在 es 的基础上 ch、ll 分别作为独立的字母排列在 c、l 之后
+//	sv              瑞典语, å、ä、ö 排列在 z 之后, w 与 v 仅在第二层次上存在差异
+//
+// 未知的语言区域使用 DUCET 的默认顺序.
+//
+// Example:
+// NewCollator("sv", Primary).Compare("ö", "z") 返回 1
+// sort.Slice(s, func(i, j int) bool { return c.Compare(s[i], s[j]) < 0 })
+func NewCollator(locale string, strength Strength) *Collator {
+	if strength < Primary || strength > Tertiary {
+		strength = Tertiary
+	}
+	c := &Collator{strength: strength, tailoring: collationTailorings[collationLocale(locale)], maxLen: 2}
+	for k := range c.tailoring {
+		if n := utf8.RuneCountInString(k); n > c.maxLen {
+			c.maxLen = n
+		}
+	}
+	return c
+}
+
+// collationLocale 函数用于将语言区域转换为 collationTailorings 中的键, 忽略大小写与地区代码, 保留排序类型扩展.
+func collationLocale(locale string) string {
+	tag := strings.ToLower(strings.ReplaceAll(locale, "_", "-"))
+	lang, ext, _ := strings.Cut(tag, "-u-")
+	lang, _, _ = strings.Cut(lang, "-")
+	if _, co, ok := strings.Cut("-"+ext, "-co-"); ok {
+		co, _, _ = strings.Cut(co, "-")
+		return lang + "-u-co-" + co
+	}
+	return lang
+}
+
+// Compare 函数用于比较两个字符串, 可以作为 Type 类型的比较器使用, 例如 StringComparator(c.Compare).
+func (c *Collator) Compare(x, y interface{}) int {
+	return bytes.Compare(c.Key(x.(string)), c.Key(y.(string)))
+}
+
+// Key 函数用于生成字符串的排序键, 两个字符串排序键的字节序与 Compare 的比较结果一致,
+// 适用于需要多次比较同一字符串的场景, 例如预先计算排序键后存储到数据库中.
+func (c *Collator) Key(s string) []byte {
+	ces := c.elements(s)
+	key := make([]byte, 0, len(ces)*2*int(c.strength)+4)
+	for level := Primary; level <= c.strength; level++ {
+		if level > Primary {
+			key = append(key, 0, 0)
+		}
+		for _, ce := range ces {
+			if w := collationWeight(ce, level); w != 0 {
+				key = append(key, byte(w>>8), byte(w))
+			}
+		}
+	}
+	return key
+}
+
+func collationWeight(ce uint32, level Strength) uint32 {
+	switch level {
+	case Primary:
+		return ce >> 16
+	case Secondary:
+		return ce >> 5 & 0x7ff
+	default:
+		return ce & 0x1f
+	}
+}
+
+// elements 函数用于将字符串转换为排序元素序列, 每个位置优先匹配最长的条目.
+func (c *Collator) elements(s string) []uint32 {
+	var ces []uint32
+	ends := make([]int, 0, c.maxLen)
+	for len(s) > 0 {
+		ce, n := c.lookup(s, ends)
+		ces = append(ces, ce...)
+		s = s[n:]
+	}
+	return ces
+}
+
+// lookup 函数用于查找 s 开头最长的排序元素条目, ends 为复用的缓冲区, 用于记录前 maxLen 个字符的结束位置.
+func (c *Collator) lookup(s string, ends []int) ([]uint32, int) {
+	ends = ends[:0]
+	for end := 0; end < len(s) && len(ends) < c.maxLen; {
+		_, n := utf8.DecodeRuneInString(s[end:])
+		end += n
+		ends = append(ends, end)
+	}
+	for i := len(ends) - 1; i >= 0; i-- {
+		if ce, ok := c.tailoring[s[:ends[i]]]; ok {
+			return ce, ends[i]
+		}
+		if ce, ok := ducet[s[:ends[i]]]; ok {
+			return ce, ends[i]
+		}
+	}
+	r, n := utf8.DecodeRuneInString(s)
+	return implicitWeights(r), n
+}
+
+// implicitWeights 函数用于按照 UCA 的规则计算未收录字符的隐式权重.
+func implicitWeights(r rune) []uint32 {
+	base := uint32(0xFBC0)
+	switch {
+	case r >= 0x4E00 && r <= 0x9FFF, r >= 0xF900 && r <= 0xFAFF && unicode.Is(unicode.Han, r):
+		base = 0xFB40
+	case unicode.Is(unicode.Han, r):
+		base = 0xFB80
+	}
+	aaaa := base + uint32(r>>15)
+	bbbb := uint32(r&0x7FFF) | 0x8000
+	return []uint32{aaaa<<16 | 0x20<<5 | 0x02, bbbb << 16}
+}
@@ -0,0 +1,141 @@
+package comparator
+
+import (
+	"bytes"
+	"sort"
+	"strings"
+	"testing"
+)
+
+/**
+ *
+ * @Author AiTao
+ * @Date 2026-10-18 15:40
+ * @Url
+ **/
+
+func TestCollatorStrength(t *testing.T) {
+	tests := []struct {
+		strength Strength
+		a, b     string
+		want     int
+	}{
+		{Primary, "a", "A", 0},
+		{Primary, "a", "á", 0},
+		{Primary, "résumé", "RESUME", 0},
+		{Primary, "a", "b", -1},
+		{Secondary, "a", "A", 0},
+		{Secondary, "a", "á", -1},
+		{Secondary, "á", "b", -1},
+		{Tertiary, "a", "A", -1},
+		{Tertiary, "A", "á", -1},
+		{Tertiary, "e\u0301", "\u00e9", 0},
+		{Tertiary, "apple", "Banana", -1},
+		{Tertiary, "coté", "côte", -1},
+		{Tertiary, "α", "β", -1},
+		{Tertiary, "z", "α", -1},
+		{Tertiary, "я", "中", -1},
+		{Tertiary, "a", "", 1},
+	}
+	for _, tt := range tests {
+		c := NewCollator("", tt.strength)
+		if got := c.Compare(tt.a, tt.b); got != tt.want {
+			t.Errorf("strength %d: Compare(%q, %q) = %d, want %d", tt.strength, tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestCollatorLocale(t *testing.T) {
+	tests := []struct {
+		locale string
+		a, b   string
+		want   int
+	}{
+		{"", "ö", "p", -1},
+		{"de", "ö", "p", -1},
+		{"de_DE", "Müller", "Muller", 0},
+		{"de-u-co-phonebk", "Müller", "Mueller", 0},
+		{"de-DE-u-co-phonebk", "Müller", "Muf", -1},
+		{"sv", "ö", "z", 1},
+		{"sv-SE", "å", "ä", -1},
+		{"sv", "ä", "ö", -1},
+		{"sv", "va", "wb", -1},
+		{"sv", "wa", "vb", -1},
+		{"es", "ñ", "o", -1},
+		{"es", "ñ", "nz", 1},
+		{"es", "ñ", "ñ", 0},
+		{"es", "chico", "cuna", -1},
+		{"es-u-co-trad", "chico", "cuna", 1},
+		{"es-u-co-trad", "llama", "luz", 1},
+	}
+	for _, tt := range tests {
+		c := NewCollator(tt.locale, Primary)
+		if got := c.Compare(tt.a, tt.b); got != tt.want {
+			t.Errorf("%s: Compare(%q, %q) = %d, want %d", tt.locale, tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestCollatorGerman(t *testing.T) {
+	if _, ok := collationTailorings[collationLocale("de-AT")]; !ok {
+		t.Error(`collationTailorings["de"] is missing`)
+	}
+	de, phonebook := NewCollator("de", Secondary), NewCollator("de-u-co-phonebk", Secondary)
+	tests := []struct {
+		a, b          string
+		de, phonebook int
+	}{
+		{"a", "ä", -1, -1},
+		{"ä", "b", -1, -1},
+		{"Mueller", "Müller", -1, -1},
+		{"Müller", "Muller", 1, -1},
+		{"Müller", "Mulch", 1, -1},
+		{"Öl", "Ofen", 1, -1},
+		{"Straße", "Strasse", 1, 1},
+		{"Straße", "Strasser", -1, -1},
+	}
+	for _, tt := range tests {
+		if got := de.Compare(tt.a, tt.b); got != tt.de {
+			t.Errorf("de: Compare(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.de)
+		}
+		if got := phonebook.Compare(tt.a, tt.b); got != tt.phonebook {
+			t.Errorf("de-u-co-phonebk: Compare(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.phonebook)
+		}
+	}
+	words := []string{"Würze", "Wurst", "Wüste", "wund", "Wucht"}
+	sort.Slice(words, func(i, j int) bool { return de.Compare(words[i], words[j]) < 0 })
+	if want := "Wucht wund Wurst Würze Wüste"; strings.Join(words, " ") != want {
+		t.Errorf("sorted = %v, want %s", words, want)
+	}
+}
+
+func TestCollatorKey(t *testing.T) {
+	words := []string{"zebra", "Äpfel", "apple", "Apfel", "Zürich", "école", "ecole", "Ecole", "öl"}
+	c := NewCollator("de", Tertiary)
+	keys := make(map[string][]byte, len(words))
+	for _, w := range words {
+		keys[w] = c.Key(w)
+	}
+	for _, a := range words {
+		for _, b := range words {
+			if got, want := bytes.Compare(keys[a], keys[b]), c.Compare(a, b); got != want {
+				t.Errorf("bytes.Compare(Key(%q), Key(%q)) = %d, want %d", a, b, got, want)
+			}
+		}
+	}
+	sort.Slice(words, func(i, j int) bool { return bytes.Compare(keys[words[i]], keys[words[j]]) < 0 })
+	want := "Apfel Äpfel apple ecole Ecole école öl zebra Zürich"
+	if got := strings.Join(words, " "); got != want {
+		t.Errorf("sorted = %s, want %s", got, want)
+	}
+}
+
+func TestCollatorStringComparator(t *testing.T) {
+	type product struct {
+		Name string
+	}
+	c := NewCollator("sv", Primary)
+	if got := CompareWith(product{"Öl"}, product{"Zon"}, StringComparator(c.Compare)); got != 1 {
+		t.Errorf("CompareWith = %d, want 1", got)
+	}
+}
@@ -0,0 +1,134 @@
+package comparator
+
+import (
+	"reflect"
+)
+
+/**
+ *
+ * @Author AiTao
+ * @Date 2026-10-17 17:30
+ * @Url
+ **/
+
+// By 返回一个比较器, 该比较器使用 cmp 比较 key 从两个值中提取的键, 当 cmp 为 nil 时使用 Compare 函数比较.
+//
+// Example:
+// By(func(v any) any { return v.(User).Age }, Int) 返回一个按照年龄比较 User 的比较器
+func By(key func(any) any, cmp Type) Type {
+	if cmp == nil {
+		cmp = Compare
+	}
+	return func(a, b any) int { return cmp(key(a), key(b)) }
+}
+
+// Mapping 返回一个比较器, 该比较器先使用 transform 转换两个值, 然后使用 cmp 比较转换后的值.
+// 与 By 不同的是, Mapping 通常用于比较之前对值进行规范化, 例如忽略字符串的大小写.
+//
+// Example:
+// Mapping(func(v any) any { return strings.ToLower(v.(string)) }, String) 返回一个忽略大小写的字符串比较器
+func Mapping(transform func(any) any, cmp Type) Type {
+	return By(transform, cmp)
+}
+
+// Then 返回一个组合比较器, 该比较器依次使用 cmps 比较两个值, 并返回第一个不为0的比较结果.
+//
+// Example:
+// Then(By(age, Int), By(name, String)) 返回一个先按照年龄、再按照姓名比较的比较器
+func Then(cmps ...Type) Type {
+	return func(a, b any) int {
+		for _, cmp := range cmps {
+			if r := cmp(a, b); r != 0 {
+				return r
+			}
+		}
+		return 0
+	}
+}
+
+// NullsFirst 返回一个比较器, 该比较器认为 nil 值(包括值为 nil 的指针、切片、map 等)小于任何非 nil 值,
+// 两个 nil 值相等, 两个非 nil 值使用 cmp 进行比较.
+func NullsFirst(cmp Type) Type {
+	return nulls(cmp, -1)
+}
+
+// NullsLast 返回一个比较器, 该比较器认为 nil 值(包括值为 nil 的指针、切片、map 等)大于任何非 nil 值,
+// 两个 nil 值相等, 两个非 nil 值使用 cmp 进行比较.
+func NullsLast(cmp Type) Type {
+	return nulls(cmp, 1)
+}
+
+func nulls(cmp Type, order int) Type {
+	return func(a, b any) int {
+		switch x, y := isNil(a), isNil(b); {
+		case x && y:
+			return 0
+		case x:
+			return order
+		case y:
+			return -order
+		default:
+			return cmp(a, b)
+		}
+	}
+}
+
+// isNil 函数用于判断 v 是否为 nil 值.
+func isNil(v any) bool {
+	if v == nil {
+		return true
+	}
+	switch rv := reflect.ValueOf(v); rv.Kind() {
+	case reflect.Pointer, reflect.Slice, reflect.Map, reflect.Interface, reflect.Func, reflect.Chan:
+		return rv.IsNil()
+	default:
+		return false
+	}
+}
+
+// Lexicographic 返回一个按照字典序比较切片(或数组)的比较器, 切片中的元素使用 cmp 进行比较,
+// 当其中一个切片是另一个切片的前缀时, 较短的切片较小.
+//
+// Example:
+// Lexicographic(Int)([]int{1, 2}, []int{1, 2, 0}) 返回 -1
+func Lexicographic(cmp Type) Type {
+	return func(a, b any) int {
+		va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+		x, y := va.Len(), vb.Len()
+		for i := 0; i < x && i < y; i++ {
+			if r := cmp(va.Index(i).Interface(), vb.Index(i).Interface()); r != 0 {
+				return r
+			}
+		}
+		return Int(x, y)
+	}
+}
+
+// Tuple 返回一个比较元组的比较器, 元组为切片或数组, 元组中下标为 i 的元素使用 cmps[i] 进行比较,
+// 超出 cmps 长度的元素不参与比较; 当元组长度不足时, 较短的元组较小.
+//
+// Example:
+// Tuple(String, Int)([]any{"go", 1}, []any{"go", 2}) 返回 -1
+func Tuple(cmps ...Type) Type {
+	return func(a, b any) int {
+		va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+		x, y := va.Len(), vb.Len()
+		for i, cmp := range cmps {
+			if i >= x || i >= y {
+				return Int(x, y)
+			}
+			if r := cmp(va.Index(i).Interface(), vb.Index(i).Interface()); r != 0 {
+				return r
+			}
+		}
+		return 0
+	}
+}
+
+// Pair 返回一个比较二元组的比较器, 二元组中的第一个元素使用 first 比较, 第二个元素使用 second 比较.
+//
+// Example:
+// Pair(String, Int)([2]any{"go", 1}, [2]any{"go", 2}) 返回 -1
+func Pair(first, second Type) Type {
+	return Tuple(first, second)
+}
@@ -0,0 +1,107 @@
+package comparator
+
+import (
+	"sort"
+	"strings"
+	"testing"
+)
+
+/**
+ *
+ * @Author AiTao
+ * @Date 2026-10-17 17:30
+ * @Url
+ **/
+
+type member struct {
+	Name  string
+	Age   int
+	Group *string
+}
+
+func strPtr(s string) *string { return &s }
+
+var members = []member{
+	{"bob", 30, strPtr("b")},
+	{"Alice", 30, nil},
+	{"carol", 25, strPtr("a")},
+	{"alice", 25, strPtr("b")},
+	{"Bob", 30, strPtr("a")},
+	{"dave", 25, nil},
+}
+
+var (
+	byAge   = By(func(v any) any { return v.(member).Age }, Int)
+	byName  = By(func(v any) any { return v.(member).Name }, nil)
+	byFold  = By(func(v any) any { return v.(member).Name }, Mapping(func(v any) any { return strings.ToLower(v.(string)) }, String))
+	byGroup = By(func(v any) any { return v.(member).Group }, NullsLast(func(a, b any) int { return String(*a.(*string), *b.(*string)) }))
+)
+
+func TestCombinatorAssociativity(t *testing.T) {
+	cmps := []Type{byAge, byFold, byGroup, Reverse(byName)}
+	for _, x := range members {
+		for _, y := range members {
+			for i := range cmps {
+				for j := range cmps {
+					for k := range cmps {
+						c1, c2, c3 := cmps[i], cmps[j], cmps[k]
+						if l, r := Then(Then(c1, c2), c3)(x, y), Then(c1, Then(c2, c3))(x, y); l != r {
+							t.Fatalf("Then is not associative for %v, %v: %d != %d", x, y, l, r)
+						}
+					}
+				}
+			}
+			if l, r := Then(byAge, byName)(x, y), -Then(byAge, byName)(y, x); l != r {
+				t.Fatalf("Then is not antisymmetric for %v, %v", x, y)
+			}
+		}
+	}
+}
+
+func TestCombinatorSort(t *testing.T) {
+	s := append([]member(nil), members...)
+	cmp := Then(byGroup, Reverse(byAge), byFold, byName)
+	sort.SliceStable(s, func(i, j int) bool { return cmp(s[i], s[j]) < 0 })
+	var names []string
+	for _, m := range s {
+		names = append(names, m.Name)
+	}
+	if got, want := strings.Join(names, ","), "Bob,carol,bob,alice,Alice,dave"; got != want {
+		t.Errorf("sorted = %s, want %s", got, want)
+	}
+}
+
+func TestNulls(t *testing.T) {
+	var p *int
+	x := 1
+	if r := NullsFirst(Compare)(p, &x); r != -1 {
+		t.Errorf("NullsFirst(nil, x) = %d", r)
+	}
+	if r := NullsLast(Compare)(p, &x); r != 1 {
+		t.Errorf("NullsLast(nil, x) = %d", r)
+	}
+	if r := NullsLast(Compare)(nil, p); r != 0 {
+		t.Errorf("NullsLast(nil, nil) = %d", r)
+	}
+}
+
+func TestLexicographicTuple(t *testing.T) {
+	tests := []struct {
+		cmp  Type
+		a, b any
+		want int
+	}{
+		{Lexicographic(Int), []int{1, 2}, []int{1, 2, 0}, -1},
+		{Lexicographic(Int), []int{1, 3}, []int{1, 2, 0}, 1},
+		{Lexicographic(Reverse(String)), [2]string{"a", "b"}, [2]string{"a", "c"}, 1},
+		{Tuple(String, Int), []any{"go", 1}, []any{"go", 2}, -1},
+		{Tuple(String, Int), []any{"go", 1, "x"}, []any{"go", 1, "y"}, 0},
+		{Tuple(String, Int), []any{"go"}, []any{"go", 2}, -1},
+		{Pair(String, Reverse(Int)), [2]any{"go", 1}, [2]any{"go", 2}, 1},
+	}
+	for i, tt := range tests {
+		if got := tt.cmp(tt.a, tt.b); got != tt.want {
+			t.Errorf("case %d: got %d, want %d", i, got, tt.want)
+		}
+	}
+}
@@ -3,6 +3,8 @@ package comparator
 import (
 	"fmt"
 	"time"
+
+	"github.com/lmlat/go-comparator/typed"
 )
 
 /**
@@ -67,10 +69,11 @@ func Reverse(compare func(interface{}, interface{}) int) func(interface{}, inter
 }
 
 // Comparable 函数用于对 Comparable 类型数据进行类型断言, 并实现基础比较功能.
+// 除了 Iface 接口之外, 同样支持实现了 Comparer 接口的类型.
 func Comparable(x, y interface{}) int {
 	a, o1 := x.(Iface)
 	if !o1 {
-		panic(fmt.Sprintf("illegal argument: x is not an implementation type of the comparator.Iface: %T", x))
+		return compareComparer(x, y)
 	}
 	b, o2 := y.(Iface)
 	if !o2 {
@@ -81,171 +84,67 @@ func Comparable(x, y interface{}) int {
 
 // Int 函数用于对 int 类型数据进行类型断言, 并实现基础比较功能.
 func Int(x, y interface{}) int {
-	a, b := x.(int), y.(int)
-	switch {
-	case a > b:
-		return 1
-	case a < b:
-		return -1
-	default:
-		return 0
-	}
+	return typed.Compare(x.(int), y.(int))
 }
 
 // Int8 函数用于对 int8 类型数据进行类型断言, 并实现基础比较功能.
 func Int8(x, y interface{}) int {
-	a, b := x.(int8), y.(int8)
-	switch {
-	case a > b:
-		return 1
-	case a < b:
-		return -1
-	default:
-		return 0
-	}
+	return typed.Compare(x.(int8), y.(int8))
 }
 
 // Int16 函数用于对 int16 类型数据进行类型断言, 并实现基础比较功能.
 func Int16(x, y interface{}) int {
-	a, b := x.(int16), y.(int16)
-	switch {
-	case a > b:
-		return 1
-	case a < b:
-		return -1
-	default:
-		return 0
-	}
+	return typed.Compare(x.(int16), y.(int16))
 }
 
 // Int32 函数用于对 int32 类型数据进行类型断言, 并实现基础比较功能.
 func Int32(x, y interface{}) int {
-	a, b := x.(int32), y.(int32)
-	switch {
-	case a > b:
-		return 1
-	case a < b:
-		return -1
-	default:
-		return 0
-	}
+	return typed.Compare(x.(int32), y.(int32))
 }
 
 // Int64 函数用于对 int64 类型数据进行类型断言, 并实现基础比较功能.
 func Int64(x, y interface{}) int {
-	a, b := x.(int64), y.(int64)
-	switch {
-	case a > b:
-		return 1
-	case a < b:
-		return -1
-	default:
-		return 0
-	}
+	return typed.Compare(x.(int64), y.(int64))
 }
 
 // Uint 函数用于对 uint 类型数据进行类型断言, 并实现基础比较功能.
 func Uint(x, y interface{}) int {
-	a, b := x.(uint), y.(uint)
-	switch {
-	case a > b:
-		return 1
-	case a < b:
-		return -1
-	default:
-		return 0
-	}
+	return typed.Compare(x.(uint), y.(uint))
 }
 
 // Uint8 函数用于对 uint8 类型数据进行类型断言, 并实现基础比较功能.
 func Uint8(x, y interface{}) int {
-	a, b := x.(uint8), y.(uint8)
-	switch {
-	case a > b:
-		return 1
-	case a < b:
-		return -1
-	default:
-		return 0
-	}
+	return typed.Compare(x.(uint8), y.(uint8))
 }
 
 // Uint16 函数用于对 uint16 类型数据进行类型断言, 并实现基础比较功能.
 func Uint16(x, y interface{}) int {
-	a, b := x.(uint16), y.(uint16)
-	switch {
-	case a > b:
-		return 1
-	case a < b:
-		return -1
-	default:
-		return 0
-	}
+	return typed.Compare(x.(uint16), y.(uint16))
 }
 
 // Uint32 函数用于对 uint32 类型数据进行类型断言, 并实现基础比较功能.
 func Uint32(x, y interface{}) int {
-	a, b := x.(uint32), y.(uint32)
-	switch {
-	case a > b:
-		return 1
-	case a < b:
-		return -1
-	default:
-		return 0
-	}
+	return typed.Compare(x.(uint32), y.(uint32))
 }
 
 // Uint64 函数用于对 uint64 类型数据进行类型断言, 并实现基础比较功能.
 func Uint64(x, y interface{}) int {
-	a, b := x.(uint64), y.(uint64)
-	switch {
-	case a > b:
-		return 1
-	case a < b:
-		return -1
-	default:
-		return 0
-	}
+	return typed.Compare(x.(uint64), y.(uint64))
 }
 
 // Float32 函数用于对 float32 类型数据进行类型断言, 并实现基础比较功能.
 func Float32(x, y interface{}) int {
-	a, b := x.(float32), y.(float32)
-	switch {
-	case a > b:
-		return 1
-	case a < b:
-		return -1
-	default:
-		return 0
-	}
+	return typed.Compare(x.(float32), y.(float32))
 }
 
 // Float64 函数用于对 float64 类型数据进行类型断言, 并实现基础比较功能.
 func Float64(x, y interface{}) int {
-	a, b := x.(float64), y.(float64)
-	switch {
-	case a > b:
-		return 1
-	case a < b:
-		return -1
-	default:
-		return 0
-	}
+	return typed.Compare(x.(float64), y.(float64))
 }
 
 // String 函数用于对 string 类型数据进行类型断言, 并实现基础比较功能.
 func String(x, y interface{}) int {
-	a, b := x.(string), y.(string)
-	switch {
-	case a > b:
-		return 1
-	case a < b:
-		return -1
-	default:
-		return 0
-	}
+	return typed.Compare(x.(string), y.(string))
 }
 
 // Time 函数用于对 time.Time 类型数据进行类型断言, 并实现基础比较功能.
@@ -302,39 +201,15 @@ func Complex128(a, b interface{}) int {
 
 // Byte 函数用于对 byte 类型数据进行类型断言, 并实现基础比较功能.
 func Byte(x, y interface{}) int {
-	a, b := x.(byte), y.(byte)
-	switch {
-	case a > b:
-		return 1
-	case a < b:
-		return -1
-	default:
-		return 0
-	}
+	return typed.Compare(x.(byte), y.(byte))
 }
 
 // Rune 函数用于对 rune 类型数据进行类型断言, 并实现基础比较功能.
 func Rune(x, y interface{}) int {
-	a, b := x.(rune), y.(rune)
-	switch {
-	case a > b:
-		return 1
-	case a < b:
-		return -1
-	default:
-		return 0
-	}
+	return typed.Compare(x.(rune), y.(rune))
 }
 
 // Error 函数用于对 error 类型数据进行类型断言, 并实现基础比较功能.
 func Error(x, y interface{}) int {
-	a, b := x.(error).Error(), y.(error).Error()
-	switch {
-	case a > b:
-		return 1
-	case a < b:
-		return -1
-	default:
-		return 0
-	}
+	return typed.Compare(x.(error).Error(), y.(error).Error())
 }
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"sort"
 	"testing"
 	"unsafe"
 )
@@ -38,19 +39,24 @@ var (
 
 type Str string
 
-func show(r int, names ...string) {
+func show(r Ordering, names ...string) {
 	switch r {
-	case equal:
+	case EqualTo:
 		fmt.Printf("%s is【equal】 %s\n", names[0], names[1])
-	case less:
+	case LessThan:
 		fmt.Printf("%s is【less】than %s\n", names[0], names[1])
-	case greater:
+	case GreaterThan:
 		fmt.Printf("%s is【greater】than %s\n", names[0], names[1])
 	default:
 		fmt.Println("invalid")
 	}
 }
 
+func orderOf(a, b interface{}) Ordering {
+	r, _ := CompareE(a, b)
+	return r
+}
+
 func TestEquals(t *testing.T) {
 	m1 := map[string]string{"sex": "man", "name": "aitao"}
 	m2 := map[string]string{"name": "aitao", "sex": "man"}
@@ -73,39 +79,114 @@ func TestEquals(t *testing.T) {
 
 func TestCompare(t *testing.T) {
 	fmt.Println("=======================Array=================================")
-	show(Compare(arr1, arr2), "arr1", "arr2") // equal
-	show(Compare(arr1, arr3), "arr1", "arr3") // less
-	show(Compare(arr1, arr4), "arr1", "arr4") // invalid
-	show(Compare(arr3, arr2), "arr3", "arr2") // greater
+	show(orderOf(arr1, arr2), "arr1", "arr2") // equal
+	show(orderOf(arr1, arr3), "arr1", "arr3") // less
+	show(orderOf(arr1, arr4), "arr1", "arr4") // invalid
+	show(orderOf(arr3, arr2), "arr3", "arr2") // greater
 
 	fmt.Println("=======================Slice=================================")
-	show(Compare(slice1, slice2), "slice1", "slice2") // equal
-	show(Compare(slice1, slice3), "slice1", "slice3") // less
-	show(Compare(slice1, slice4), "slice1", "slice4") // greater
-	show(Compare(slice3, slice2), "slice3", "slice2") // greater
+	show(orderOf(slice1, slice2), "slice1", "slice2") // equal
+	show(orderOf(slice1, slice3), "slice1", "slice3") // less
+	show(orderOf(slice1, slice4), "slice1", "slice4") // greater
+	show(orderOf(slice3, slice2), "slice3", "slice2") // greater
 
 	fmt.Println("=======================Map=================================")
-	show(Compare(map1, map2), "map1", "map2") // equal
-	show(Compare(map1, map3), "map1", "map3") // less
-	show(Compare(map1, map4), "map1", "map4") // less
-	show(Compare(map3, map2), "map3", "map2") // greater
-	show(Compare(map5, map6), "map5", "map6") // equal
-	show(Compare(map5, map7), "map5", "map7") // invalid
+	show(orderOf(map1, map2), "map1", "map2") // equal
+	show(orderOf(map1, map3), "map1", "map3") // less
+	show(orderOf(map1, map4), "map1", "map4") // less
+	show(orderOf(map3, map2), "map3", "map2") // greater
+	show(orderOf(map5, map6), "map5", "map6") // equal
+	show(orderOf(map5, map7), "map5", "map7") // invalid
 
 	fmt.Println("=======================Pointer=================================")
-	show(Compare(&slice1, &slice2), "p1", "p2") // equal
-	show(Compare(&slice1, &slice3), "p1", "p3") // less
+	show(orderOf(&slice1, &slice2), "p1", "p2") // equal
+	show(orderOf(&slice1, &slice3), "p1", "p3") // less
 	var a, b ***int
-	show(Compare(a, b), "pa", "pb") // equal
+	show(orderOf(a, b), "pa", "pb") // equal
 	//
 	fmt.Println("=======================error=================================")
-	show(Compare(errors.New("我爱你"), errors.New("我爱你")), "err1", "err2")             // equal
-	show(Compare(fmt.Errorf("我爱%s", "你"), fmt.Errorf("我爱%s", "你")), "err3", "err4") // equal
+	show(orderOf(errors.New("我爱你"), errors.New("我爱你")), "err1", "err2")             // equal
+	show(orderOf(fmt.Errorf("我爱%s", "你"), fmt.Errorf("我爱%s", "你")), "err3", "err4") // equal
 	//
 	fmt.Println("=======================包装基础类型=================================")
-	show(Compare(Str("aitao"), Str("aitao")), "aitao", "aitao")
-	show(Compare(Str("aitao"), Str("aitap")), "aitao", "aitap")
-	show(Compare(Str("aitax"), Str("aitao")), "aitax", "aitao")
+	show(orderOf(Str("aitao"), Str("aitao")), "aitao", "aitao")
+	show(orderOf(Str("aitao"), Str("aitap")), "aitao", "aitap")
+	show(orderOf(Str("aitax"), Str("aitao")), "aitax", "aitao")
+}
+
+func TestCompareE(t *testing.T) {
+	tests := []struct {
+		a, b    interface{}
+		want    Ordering
+		wantErr error
+	}{
+		{arr1, arr2, EqualTo, nil},
+		{arr1, arr3, LessThan, nil},
+		{arr3, arr2, GreaterThan, nil},
+		{arr1, arr4, Incomparable, ErrTypeMismatch},
+		{1, "1", Incomparable, ErrTypeMismatch},
+		{nil, 1, LessThan, ErrNil},
+		{map5, map7, LessThan, ErrKeyMissing},
+		{map7, map5, GreaterThan, ErrKeyMissing},
+	}
+	for i, tt := range tests {
+		got, err := CompareE(tt.a, tt.b)
+		if got != tt.want || !errors.Is(err, tt.wantErr) {
+			t.Errorf("case %d: CompareE() = (%v, %v), want (%v, %v)", i, got, err, tt.want, tt.wantErr)
+		}
+	}
+	if r := Compare(slice1, slice3); r != -1 {
+		t.Errorf("Compare(slice1, slice3) = %d, want -1", r)
+	}
+	if r := Compare(slice3, slice1); r != 1 {
+		t.Errorf("Compare(slice3, slice1) = %d, want 1", r)
+	}
+	if r := Compare(arr1, arr4); r != 0 {
+		t.Errorf("Compare(arr1, arr4) = %d, want 0", r)
+	}
+	if s := Incomparable.String(); s != "Incomparable" {
+		t.Errorf("Incomparable.String() = %q", s)
+	}
+}
+
+type item struct {
+	Price interface{}
+}
+
+type order struct {
+	Items map[string]item
+}
+
+type customer struct {
+	Name   string
+	Orders []order
+}
+
+func TestCompareErrorPath(t *testing.T) {
+	c1 := customer{Name: "aitao", Orders: []order{{}, {Items: map[string]item{"sku": {Price: 10}}}}}
+	c2 := customer{Name: "aitao", Orders: []order{{}, {Items: map[string]item{"sku": {Price: "10"}}}}}
+	r, err := CompareE(c1, c2)
+	if r != Incomparable {
+		t.Fatalf("CompareE() = %v, want Incomparable", r)
+	}
+	var ce *CompareError
+	if !errors.As(err, &ce) {
+		t.Fatalf("CompareE() error = %v, want *CompareError", err)
+	}
+	if want := `.Orders[1].Items["sku"].Price`; ce.Path != want {
+		t.Errorf("path = %s, want %s", ce.Path, want)
+	}
+	if ce.Kind != KindTypeMismatch || !errors.Is(err, ErrTypeMismatch) {
+		t.Errorf("kind = %v, err = %v", ce.Kind, err)
+	}
+	if ce.A != 10 || ce.B != "10" || ce.TypeA != reflect.TypeOf(0) || ce.TypeB != reflect.TypeOf("") {
+		t.Errorf("values = %v(%v), %v(%v)", ce.A, ce.TypeA, ce.B, ce.TypeB)
+	}
+
+	_, err = CompareE(map5, map7)
+	if !errors.As(err, &ce) || ce.Kind != KindKeyMissing {
+		t.Errorf("CompareE(map5, map7) error = %v, want key missing", err)
+	}
 }
 
 func TestCompareAnySlice(t *testing.T) {
@@ -117,32 +198,32 @@ func TestCompareAnySlice(t *testing.T) {
 func TestReflectComparePrimitiveValue(t *testing.T) {
 	v1 := reflect.ValueOf(99)
 	v2 := reflect.ValueOf(99)
-	r, err := reflectComparePrimitiveValue(v1, v2)
+	r, err := reflectComparePrimitiveValue(v1, v2, newOptions(nil))
 	if err != nil {
 		fmt.Println(err)
 	}
-	show(r, "v1", "v2")
+	show(toOrdering(r), "v1", "v2")
 
 	v3 := reflect.ValueOf("aitao")
-	r, err = reflectComparePrimitiveValue(v1, v3)
+	r, err = reflectComparePrimitiveValue(v1, v3, newOptions(nil))
 	if err != nil {
 		fmt.Println(err)
 	}
-	show(r, "v1", "v2")
+	show(toOrdering(r), "v1", "v2")
 }
 
 func TestComparePrimitiveValue(t *testing.T) {
-	r, err := comparePrimitiveValue(1, 1)
+	r, err := comparePrimitiveValue(1, 1, newOptions(nil))
 	if err != nil {
 		fmt.Println(err)
 	}
-	show(r, "v1", "v2")
+	show(toOrdering(r), "v1", "v2")
 
-	r, err = comparePrimitiveValue(1, "1")
+	r, err = comparePrimitiveValue(1, "1", newOptions(nil))
 	if err != nil {
 		fmt.Println(err)
 	}
-	show(r, "v1", "v2")
+	show(toOrdering(r), "v1", "v2")
 }
 
 func BenchmarkCompare(b *testing.B) {
@@ -158,3 +239,110 @@ func BenchmarkCompare(b *testing.B) {
 	}
 	b.ReportAllocs()
 }
+
+func TestCompareMapTotalOrder(t *testing.T) {
+	m1 := map[string]int{"a": 1, "b": 9, "c": 1, "d": 9}
+	m2 := map[string]int{"a": 2, "b": 1, "c": 2, "d": 1}
+	m3 := map[any]any{1: "x", "k": []int{1}, 2.5: true}
+	m4 := map[any]any{1: "x", "k": []int{2}, 2.5: false}
+	for i := 0; i < 100; i++ {
+		// 第一个不相等的键为 "a"
+		if r := Compare(m1, m2); r != -1 {
+			t.Fatalf("Compare(m1, m2) = %d, want -1", r)
+		}
+		if r := Compare(m2, m1); r != 1 {
+			t.Fatalf("Compare(m2, m1) = %d, want 1", r)
+		}
+		// 键按照类型名称排序: float64、int、string
+		if r := Compare(m3, m4); r != 1 {
+			t.Fatalf("Compare(m3, m4) = %d, want 1", r)
+		}
+	}
+
+	maps := []map[string]int{
+		{"b": 1},
+		{"a": 2, "b": 1},
+		{"a": 1},
+		{"a": 1, "c": 0},
+		{"a": 1, "b": 5},
+	}
+	sort.Slice(maps, func(i, j int) bool { return Compare(maps[i], maps[j]) < 0 })
+	want := []map[string]int{
+		{"a": 1},
+		{"b": 1},
+		{"a": 1, "b": 5},
+		{"a": 1, "c": 0},
+		{"a": 2, "b": 1},
+	}
+	if !reflect.DeepEqual(maps, want) {
+		t.Errorf("sorted maps = %v, want %v", maps, want)
+	}
+}
+
+func BenchmarkEqualsIntSlice(b *testing.B) {
+	s1, s2 := make([]int, 1000), make([]int, 1000)
+	for i := range s1 {
+		s1[i], s2[i] = i, i
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if !Equals(s1, s2) {
+			b.Fatal("Equals() = false, want true")
+		}
+	}
+}
+
+func BenchmarkEqualsStringMap(b *testing.B) {
+	m1, m2 := make(map[string]int, 100), make(map[string]int, 100)
+	for i := 0; i < 100; i++ {
+		m1[fmt.Sprint("key", i)], m2[fmt.Sprint("key", i)] = i, i
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if !Equals(m1, m2) {
+			b.Fatal("Equals() = false, want true")
+		}
+	}
+}
+
+func BenchmarkEqualsStructSlice(b *testing.B) {
+	type item struct {
+		ID    int
+		Name  string
+		Price float64
+	}
+	s1, s2 := make([]item, 100), make([]item, 100)
+	for i := range s1 {
+		s1[i] = item{i, fmt.Sprint("item", i), float64(i) / 2}
+		s2[i] = s1[i]
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if !Equals(s1, s2) {
+			b.Fatal("Equals() = false, want true")
+		}
+	}
+}
+
+func TestCompareMapEqual(t *testing.T) {
+	type point struct{ X, Y int }
+	m1 := map[point][]string{{1, 2}: {"a"}, {3, 4}: {"b"}}
+	m2 := map[point][]string{{3, 4}: {"b"}, {1, 2}: {"a"}}
+	m3 := map[point][]string{{1, 2}: {"a"}, {5, 6}: {"b"}}
+	if r, err := CompareE(m1, m2); r != EqualTo || err != nil {
+		t.Errorf("CompareE(m1, m2) = (%v, %v), want (EqualTo, nil)", r, err)
+	}
+	if r, err := CompareE(m1, m3); r != LessThan || !errors.Is(err, ErrKeyMissing) {
+		t.Errorf("CompareE(m1, m3) = (%v, %v), want (LessThan, ErrKeyMissing)", r, err)
+	}
+	// 键相同但 m5 中对应的键值对被忽略时, 两个 map 不相等
+	ignore := IgnoreMapEntries(func(k, v interface{}) bool { return v.([]string)[0] == "_" })
+	m4 := map[point][]string{{1, 2}: {"a"}, {3, 4}: {"_"}}
+	m5 := map[point][]string{{1, 2}: {"_"}, {3, 4}: {"_"}, {5, 6}: {"c"}}
+	if EqualsWith(m1, m4, ignore) || EqualsWith(m4, m5, ignore) {
+		t.Error("EqualsWith(IgnoreMapEntries) should not match entries ignored on one side only")
+	}
+}
@@ -0,0 +1,117 @@
+package comparator
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+)
+
+/**
+ *
+ * @Author AiTao
+ * @Date 2026-10-17 16:40
+ * @Url
+ **/
+
+// Sort 函数使用元素的 CompareTo 方法对切片进行升序排序, 排序是稳定的.
+func Sort[T Comparer[T]](s []T) {
+	sort.SliceStable(s, func(i, j int) bool { return s[i].CompareTo(s[j]) < 0 })
+}
+
+// IsSorted 函数用于判断切片是否已经按照元素的 CompareTo 方法升序排序.
+func IsSorted[T Comparer[T]](s []T) bool {
+	for i := 1; i < len(s); i++ {
+		if s[i].CompareTo(s[i-1]) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Search 函数用于在升序排序的切片中二分查找 target, 返回 target 所在的下标(存在多个时返回第一个)以及 target 是否存在,
+// 当 target 不存在时返回的下标为 target 应当插入的位置.
+//
+// Example:
+// Search([]Version{v1, v2, v3}, v2) 返回 1, true
+func Search[T Comparer[T]](s []T, target T) (int, bool) {
+	i := sort.Search(len(s), func(i int) bool { return s[i].CompareTo(target) >= 0 })
+	return i, i < len(s) && s[i].CompareTo(target) == 0
+}
+
+// Min 函数用于获取切片中的最小元素, 存在多个最小元素时返回第一个. 当切片为空时会发生 panic.
+func Min[T Comparer[T]](s []T) T {
+	if len(s) == 0 {
+		panic("comparator: Min called with empty slice")
+	}
+	m := s[0]
+	for _, v := range s[1:] {
+		if v.CompareTo(m) < 0 {
+			m = v
+		}
+	}
+	return m
+}
+
+// Max 函数用于获取切片中的最大元素, 存在多个最大元素时返回第一个. 当切片为空时会发生 panic.
+func Max[T Comparer[T]](s []T) T {
+	if len(s) == 0 {
+		panic("comparator: Max called with empty slice")
+	}
+	m := s[0]
+	for _, v := range s[1:] {
+		if v.CompareTo(m) > 0 {
+			m = v
+		}
+	}
+	return m
+}
+
+// comparerMethods 缓存了类型的 CompareTo 方法, 值为 nil 时表示该类型未实现 Comparer 接口.
+var comparerMethods sync.Map
+
+var (
+	intType   = reflect.TypeOf(0)
+	ifaceType = reflect.TypeOf((*Iface)(nil)).Elem()
+)
+
+// comparerMethod 函数用于获取实现了 Comparer 接口的类型 t 的 CompareTo 方法.
+func comparerMethod(t reflect.Type) (reflect.Value, bool) {
+	if m, ok := comparerMethods.Load(t); ok {
+		return m.(reflect.Value), m.(reflect.Value).IsValid()
+	}
+	var fn reflect.Value
+	if m, ok := t.MethodByName("CompareTo"); ok {
+		if mt := m.Type; mt.NumIn() == 2 && mt.In(1) == t && mt.NumOut() == 1 && mt.Out(0) == intType {
+			fn = m.Func
+		}
+	}
+	comparerMethods.Store(t, fn)
+	return fn, fn.IsValid()
+}
+
+// callComparer 函数用于在 va、vb 实现了 Comparer 接口时, 使用 CompareTo 方法比较两个值.
+// 无法访问的值(如不可导出字段)以及值为 nil 的指针不会调用 CompareTo 方法.
+func callComparer(va, vb reflect.Value) (int, bool) {
+	fn, ok := comparerMethod(va.Type())
+	if !ok || !va.CanInterface() || !vb.CanInterface() {
+		return invalid, false
+	}
+	if va.Kind() == reflect.Pointer && (va.IsNil() || vb.IsNil()) {
+		return invalid, false
+	}
+	return fromResult(int(fn.Call([]reflect.Value{va, vb})[0].Int())), true
+}
+
+// compareComparer 函数用于比较两个实现了 Comparer 接口的值, 当 x、y 的类型不一致或者未实现 Comparer 接口时会发生 panic.
+func compareComparer(x, y interface{}) int {
+	va, vb := reflect.ValueOf(x), reflect.ValueOf(y)
+	if !va.IsValid() || !vb.IsValid() || va.Type() != vb.Type() {
+		panic(fmt.Sprintf("illegal argument: mismatched types %T and %T", x, y))
+	}
+	r, ok := callComparer(va, vb)
+	if !ok {
+		panic(fmt.Sprintf("illegal argument: %T is not an implementation type of the comparator.Iface or comparator.Comparer", x))
+	}
+	return int(toOrdering(r))
+}
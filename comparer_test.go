@@ -0,0 +1,100 @@
+package comparator
+
+import (
+	"testing"
+
+	"github.com/lmlat/go-comparator/typed"
+)
+
+/**
+ *
+ * @Author AiTao
+ * @Date 2026-10-17 16:40
+ * @Url
+ **/
+
+type semver struct {
+	Major, Minor, Patch int
+	Label               string // 不参与比较
+}
+
+func (v semver) CompareTo(o semver) int {
+	if r := typed.Compare(v.Major, o.Major); r != 0 {
+		return r
+	}
+	if r := typed.Compare(v.Minor, o.Minor); r != 0 {
+		return r
+	}
+	return typed.Compare(v.Patch, o.Patch)
+}
+
+type priority string
+
+func (p priority) CompareTo(o priority) int {
+	rank := map[priority]int{"low": 0, "medium": 1, "high": 2}
+	return typed.Compare(rank[p], rank[o])
+}
+
+type release struct {
+	Version  semver
+	Priority priority
+}
+
+func TestComparer(t *testing.T) {
+	v1, v2 := semver{1, 2, 3, "a"}, semver{1, 2, 3, "b"}
+	if r := Compare(v1, v2); r != 0 {
+		t.Errorf("Compare(v1, v2) = %d, want 0", r)
+	}
+	if r := Compare(priority("high"), priority("low")); r != 1 {
+		t.Errorf("Compare(high, low) = %d, want 1", r)
+	}
+	r1 := release{semver{1, 0, 0, ""}, "high"}
+	r2 := release{semver{1, 0, 0, "rc"}, "medium"}
+	if r := Compare(&r1, &r2); r != 1 {
+		t.Errorf("Compare(r1, r2) = %d, want 1", r)
+	}
+	if !Equals([]semver{v1}, []semver{v2}) {
+		t.Errorf("Equals([]semver) = false, want true")
+	}
+	if r := Comparable(semver{2, 0, 0, ""}, v1); r != 1 {
+		t.Errorf("Comparable() = %d, want 1", r)
+	}
+	if ds := Diff(r1, r2); len(ds) != 1 || ds[0].Path != ".Priority" {
+		t.Errorf("Diff() = %v", ds)
+	}
+}
+
+func TestComparablePanic(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Comparable(int, int) did not panic")
+		}
+	}()
+	Comparable(1, 2)
+}
+
+func TestComparerHelpers(t *testing.T) {
+	vs := []semver{{1, 10, 0, "a"}, {1, 2, 0, ""}, {0, 9, 9, ""}, {1, 10, 0, "b"}}
+	Sort(vs)
+	want := []semver{{0, 9, 9, ""}, {1, 2, 0, ""}, {1, 10, 0, "a"}, {1, 10, 0, "b"}}
+	for i := range want {
+		if vs[i] != want[i] {
+			t.Fatalf("Sort() = %v, want %v", vs, want)
+		}
+	}
+	if !IsSorted(vs) {
+		t.Errorf("IsSorted() = false")
+	}
+	if i, ok := Search(vs, semver{1, 10, 0, ""}); i != 2 || !ok {
+		t.Errorf("Search() = %d, %v", i, ok)
+	}
+	if i, ok := Search(vs, semver{1, 5, 0, ""}); i != 2 || ok {
+		t.Errorf("Search() = %d, %v", i, ok)
+	}
+	if m := Min(vs); m != want[0] {
+		t.Errorf("Min() = %v", m)
+	}
+	if m := Max(vs); m != want[2] {
+		t.Errorf("Max() = %v", m)
+	}
+}
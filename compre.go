@@ -2,9 +2,10 @@ package comparator
 
 import (
 	"bytes"
-	"errors"
+	"fmt"
 	"reflect"
-	"time"
+	"sort"
+	"strings"
 )
 
 /**
@@ -21,31 +22,78 @@ const (
 	greater
 )
 
-var (
-	nilValueError      = errors.New("comparator: the parameter has a nil value")
-	typeNotMathError   = errors.New("comparator: type mismatch")
-	valueNotMatchError = errors.New("comparator: value mismatch")
-	invalidError       = errors.New("comparator: unable to establish a comparative relationship")
-)
-
+// Compare 函数用于对任意两个值进行深度比较, 如果 a < b, 返回-1; 如果 a = b, 返回0; 如果 a > b, 返回1.
+// 当两个值之间无法建立比较关系时同样返回0, 如需区分该情况请使用 CompareE 函数.
 func Compare(a, b interface{}) int {
-	r, _ := compareValue(a, b, false)
-	return r
+	if r, _ := CompareE(a, b); r != Incomparable {
+		return int(r)
+	}
+	return 0
 }
 
-func compareValue(a, b interface{}, mark bool) (r int, e error) {
+// CompareE 函数用于对任意两个值进行深度比较, 返回比较结果以及比较过程中产生的错误.
+// 当比较结果为 Incomparable 时, 返回的错误描述了无法比较的原因; 当其中一个值为 nil 时,
+// 同样会返回确定的比较结果(nil 值较小), 并附带 ErrNil 错误; 当 map 中缺少对应的键时,
+// 返回按照键值对字典序比较的结果, 并附带 ErrKeyMissing 错误.
+//
+// 返回的错误均为 *CompareError 类型, 其中记录了出错位置的访问路径, 可以通过 errors.Is 判断具体的错误原因.
+func CompareE(a, b interface{}) (Ordering, error) {
+	return compareWith(a, b, newOptions(nil))
+}
+
+// CompareWith 函数与 Compare 函数相同, 通过 opts 可以配置比较的行为.
+//
+// Example:
+// CompareWith(u1, u2, IgnoreFields("User.UpdatedAt"), IgnoreUnexported())
+func CompareWith(a, b interface{}, opts ...Option) int {
+	if r, _ := compareWith(a, b, newOptions(opts)); r != Incomparable {
+		return int(r)
+	}
+	return 0
+}
+
+func compareWith(a, b interface{}, o *options) (Ordering, error) {
+	r, e := compareValue(a, b, false, o)
+	return toOrdering(r), wrapError(e, "", reflect.ValueOf(a), reflect.ValueOf(b))
+}
+
+// toOrdering 函数用于将内部使用的比较标志位转换为 Ordering 类型.
+func toOrdering(r int) Ordering {
+	switch r {
+	case equal:
+		return EqualTo
+	case less:
+		return LessThan
+	case greater:
+		return GreaterThan
+	default:
+		return Incomparable
+	}
+}
+
+func compareValue(a, b interface{}, mark bool, o *options) (r int, e error) {
 	if a == nil || b == nil {
 		if a == b {
 			return equal, nil
 		} else if a == nil {
-			return less, nilValueError
+			return less, ErrNil
 		} else {
-			return greater, nilValueError
+			return greater, ErrNil
 		}
 	}
+	if r, ok := o.promoteNumbers(reflect.ValueOf(a), reflect.ValueOf(b)); ok {
+		return r, nil
+	}
 	ta, tb := reflect.TypeOf(a), reflect.TypeOf(b)
 	if ta != tb {
-		return invalid, typeNotMathError // 类型不一致
+		return invalid, ErrTypeMismatch // 类型不一致
+	}
+	// 优先使用注册的比较器
+	if cmp, ok := o.lookup(ta); ok {
+		return fromResult(cmp(a, b)), nil
+	}
+	if r, ok := callComparer(reflect.ValueOf(a), reflect.ValueOf(b)); ok {
+		return r, nil
 	}
 	switch ta.Kind() {
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
@@ -54,30 +102,52 @@ func compareValue(a, b interface{}, mark bool) (r int, e error) {
 		reflect.Complex64, reflect.Complex128,
 		reflect.Bool,
 		reflect.String:
-		return comparePrimitiveValue(a, b)
+		return comparePrimitiveValue(a, b, o)
 	case reflect.Pointer, reflect.Struct, reflect.Array, reflect.Slice, reflect.Map:
-		return reflectCompareValue(a, b, reflect.ValueOf(a), reflect.ValueOf(b), mark)
+		return reflectCompareValue(a, b, reflect.ValueOf(a), reflect.ValueOf(b), mark, o)
 	default:
 		if reflect.DeepEqual(a, b) {
 			return equal, nil
 		}
 	}
-	return invalid, invalidError
+	return invalid, ErrIncomparable
 }
 
-func reflectCompareValue(a, b interface{}, va, vb reflect.Value, rmark bool) (r int, e error) {
+func reflectCompareValue(a, b interface{}, va, vb reflect.Value, rmark bool, o *options) (r int, e error) {
 	if !va.IsValid() || !vb.IsValid() {
 		if o1, o2 := va.IsValid(), vb.IsValid(); o1 == o2 {
 			return equal, nil
 		} else if o1 {
-			return less, nilValueError
+			return less, ErrNil
 		} else {
-			return greater, nilValueError
+			return greater, ErrNil
 		}
 	}
+	if r, ok := o.promoteNumbers(va, vb); ok {
+		return r, nil
+	}
 	ta, tb := va.Type(), vb.Type()
 	if ta != tb {
-		return invalid, typeNotMathError // 类型不一致
+		return invalid, ErrTypeMismatch // 类型不一致
+	}
+	// 优先使用注册的比较器, 无法访问的值(如不可导出字段)除外
+	if cmp, ok := o.lookup(ta); ok && va.CanInterface() {
+		return fromResult(cmp(va.Interface(), vb.Interface())), nil
+	}
+	// 没有方法的基础类型(如 int、string)不会实现 Comparer 接口, 直接比较其值
+	if isPrimitive(ta.Kind()) && ta.NumMethod() == 0 {
+		return reflectComparePrimitiveValue(va, vb, o)
+	}
+	// 使用实现了 Comparer 接口的类型的 CompareTo 方法
+	if r, ok := callComparer(va, vb); ok {
+		return r, nil
+	}
+	// math/big 中的数值类型使用 Cmp 方法比较, 而不是比较其内部字段
+	if r, ok := compareBig(va, vb); ok {
+		return r, nil
+	}
+	if r, ok := compareNet(va, vb); ok {
+		return r, nil
 	}
 	switch ta.Kind() {
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
@@ -86,29 +156,68 @@ func reflectCompareValue(a, b interface{}, va, vb reflect.Value, rmark bool) (r
 		reflect.Complex64, reflect.Complex128,
 		reflect.Bool,
 		reflect.String:
-		return reflectComparePrimitiveValue(va, vb)
+		return reflectComparePrimitiveValue(va, vb, o)
 	case reflect.Pointer:
-		return comparePointer(a, b, va, vb)
+		return comparePointer(a, b, va, vb, o)
+	case reflect.Interface:
+		// 比较接口中保存的动态值
+		return reflectCompareValue(a, b, va.Elem(), vb.Elem(), true, o)
 	case reflect.Struct:
-		return compareStruct(a, b, va, vb, rmark)
+		if !o.enter() {
+			return equal, nil
+		}
+		defer o.leave()
+		return compareStruct(a, b, va, vb, rmark, o)
 	case reflect.Array:
-		return reflectCompareSliceValue(a, b, reflect.ValueOf(a), reflect.ValueOf(b))
+		if !o.enter() {
+			return equal, nil
+		}
+		defer o.leave()
+		return reflectCompareSliceValue(a, b, va, vb, o)
 	case reflect.Slice:
-		if va.UnsafePointer() == vb.UnsafePointer() {
+		if r, ok := compareEmpty(va, vb, o); ok {
+			return r, nil
+		}
+		if va.Len() == vb.Len() && va.UnsafePointer() == vb.UnsafePointer() {
 			return equal, nil
 		}
-		if elemtyp := ta.Elem(); isPrimitive(elemtyp.Kind()) || elemtyp.String() == "interface {}" {
-			return compareSliceValue(a, b, va, vb, rmark)
+		// 元素为基础类型的切片不会产生循环引用
+		if va.Len() == vb.Len() && !isPrimitive(ta.Elem().Kind()) {
+			k, ok := o.visit(va, vb)
+			if !ok {
+				return equal, nil
+			}
+			defer o.unvisit(k)
 		}
-		return reflectCompareSliceValue(a, b, va, vb)
+		if !o.enter() {
+			return equal, nil
+		}
+		defer o.leave()
+		// 无法访问的值(如不可导出字段)只能通过反射逐一比较
+		if elemtyp := ta.Elem(); va.CanInterface() && (isPrimitive(elemtyp.Kind()) || elemtyp.String() == "interface {}") {
+			return compareSliceValue(a, b, va, vb, rmark, o)
+		}
+		return reflectCompareSliceValue(a, b, va, vb, o)
 	case reflect.Map:
+		if r, ok := compareEmpty(va, vb, o); ok {
+			return r, nil
+		}
 		if va.UnsafePointer() == vb.UnsafePointer() {
 			return equal, nil
 		}
-		if keytyp := ta.Key(); isPrimitive(keytyp.Kind()) || keytyp.String() == "interface {}" {
-			return compareMapValue(a, b, va, vb, rmark)
+		k, ok := o.visit(va, vb)
+		if !ok {
+			return equal, nil
+		}
+		defer o.unvisit(k)
+		if !o.enter() {
+			return equal, nil
+		}
+		defer o.leave()
+		if keytyp := ta.Key(); va.CanInterface() && (isPrimitive(keytyp.Kind()) || keytyp.String() == "interface {}") {
+			return compareMapValue(a, b, va, vb, rmark, o)
 		}
-		return compareMap(a, b, va, vb)
+		return compareMap(a, b, va, vb, o)
 	default:
 		var x, y interface{}
 		if !rmark {
@@ -120,16 +229,34 @@ func reflectCompareValue(a, b interface{}, va, vb reflect.Value, rmark bool) (r
 			return equal, nil
 		}
 	}
-	return invalid, invalidError
+	return invalid, ErrIncomparable
+}
+
+// compareEmpty 函数用于比较两个长度为0的切片(map), 值为 nil 的切片(map)小于长度为0的切片(map),
+// 除非指定了 EquateEmpty 选项. 当其中一个切片(map)的长度不为0时返回 false.
+func compareEmpty(va, vb reflect.Value, o *options) (int, bool) {
+	if va.Len() != 0 || vb.Len() != 0 {
+		return invalid, false
+	}
+	if x, y := va.IsNil(), vb.IsNil(); x == y || o.equateEmpty {
+		return equal, true
+	} else if x {
+		return less, true
+	}
+	return greater, true
 }
 
-func comparePrimitiveValue(a, b interface{}) (r int, e error) {
+// comparePrimitiveValue 函数用于比较两个基础类型的值, 不会查找注册的比较器, 也不会处理 NumericPromotion 选项,
+// 这些工作由调用方按照容器的元素类型完成, 避免逐个元素查找比较器以及 a、b 逃逸到堆上.
+func comparePrimitiveValue(a, b interface{}, o *options) (r int, e error) {
 	switch v1 := a.(type) {
 	case string:
 		if v2, ok := b.(string); !ok {
-			return invalid, typeNotMathError
+			return invalid, ErrTypeMismatch
 		} else if v1 == v2 {
 			return equal, nil
+		} else if o.compareString != nil {
+			return fromResult(o.compareString(v1, v2)), nil
 		} else if v1 < v2 {
 			return less, nil
 		} else {
@@ -137,7 +264,7 @@ func comparePrimitiveValue(a, b interface{}) (r int, e error) {
 		}
 	case bool:
 		if v2, ok := b.(bool); !ok {
-			return invalid, typeNotMathError
+			return invalid, ErrTypeMismatch
 		} else if v1 == v2 {
 			return equal, nil
 		} else if v2 {
@@ -147,7 +274,7 @@ func comparePrimitiveValue(a, b interface{}) (r int, e error) {
 		}
 	case int:
 		if v2, ok := b.(int); !ok {
-			return invalid, typeNotMathError
+			return invalid, ErrTypeMismatch
 		} else if v1 == v2 {
 			return equal, nil
 		} else if v1 < v2 {
@@ -157,7 +284,7 @@ func comparePrimitiveValue(a, b interface{}) (r int, e error) {
 		}
 	case int8:
 		if v2, ok := b.(int8); !ok {
-			return invalid, typeNotMathError
+			return invalid, ErrTypeMismatch
 		} else if v1 == v2 {
 			return equal, nil
 		} else if v1 < v2 {
@@ -167,7 +294,7 @@ func comparePrimitiveValue(a, b interface{}) (r int, e error) {
 		}
 	case int16:
 		if v2, ok := b.(int16); !ok {
-			return invalid, typeNotMathError
+			return invalid, ErrTypeMismatch
 		} else if v1 == v2 {
 			return equal, nil
 		} else if v1 < v2 {
@@ -177,7 +304,7 @@ func comparePrimitiveValue(a, b interface{}) (r int, e error) {
 		}
 	case int32:
 		if v2, ok := b.(int32); !ok {
-			return invalid, typeNotMathError
+			return invalid, ErrTypeMismatch
 		} else if v1 == v2 {
 			return equal, nil
 		} else if v1 < v2 {
@@ -187,7 +314,7 @@ func comparePrimitiveValue(a, b interface{}) (r int, e error) {
 		}
 	case int64:
 		if v2, ok := b.(int64); !ok {
-			return invalid, typeNotMathError
+			return invalid, ErrTypeMismatch
 		} else if v1 == v2 {
 			return equal, nil
 		} else if v1 < v2 {
@@ -197,7 +324,7 @@ func comparePrimitiveValue(a, b interface{}) (r int, e error) {
 		}
 	case uint:
 		if v2, ok := b.(uint); !ok {
-			return invalid, typeNotMathError
+			return invalid, ErrTypeMismatch
 		} else if v1 == v2 {
 			return equal, nil
 		} else if v1 < v2 {
@@ -207,7 +334,7 @@ func comparePrimitiveValue(a, b interface{}) (r int, e error) {
 		}
 	case uint8:
 		if v2, ok := b.(uint8); !ok {
-			return invalid, typeNotMathError
+			return invalid, ErrTypeMismatch
 		} else if v1 == v2 {
 			return equal, nil
 		} else if v1 < v2 {
@@ -217,7 +344,7 @@ func comparePrimitiveValue(a, b interface{}) (r int, e error) {
 		}
 	case uint16:
 		if v2, ok := b.(uint16); !ok {
-			return invalid, typeNotMathError
+			return invalid, ErrTypeMismatch
 		} else if v1 == v2 {
 			return equal, nil
 		} else if v1 < v2 {
@@ -227,7 +354,7 @@ func comparePrimitiveValue(a, b interface{}) (r int, e error) {
 		}
 	case uint32:
 		if v2, ok := b.(uint32); !ok {
-			return invalid, typeNotMathError
+			return invalid, ErrTypeMismatch
 		} else if v1 == v2 {
 			return equal, nil
 		} else if v1 < v2 {
@@ -237,7 +364,7 @@ func comparePrimitiveValue(a, b interface{}) (r int, e error) {
 		}
 	case uint64:
 		if v2, ok := b.(uint64); !ok {
-			return invalid, typeNotMathError
+			return invalid, ErrTypeMismatch
 		} else if v1 == v2 {
 			return equal, nil
 		} else if v1 < v2 {
@@ -247,30 +374,22 @@ func comparePrimitiveValue(a, b interface{}) (r int, e error) {
 		}
 	case float32:
 		if v2, ok := b.(float32); !ok {
-			return invalid, typeNotMathError
-		} else if v1 == v2 {
-			return equal, nil
-		} else if v1 < v2 {
-			return less, nil
+			return invalid, ErrTypeMismatch
 		} else {
-			return greater, nil
+			return o.compareFloats(float64(v1), float64(v2), true), nil
 		}
 	case float64:
 		if v2, ok := b.(float64); !ok {
-			return invalid, typeNotMathError
-		} else if v1 == v2 {
-			return equal, nil
-		} else if v1 < v2 {
-			return less, nil
+			return invalid, ErrTypeMismatch
 		} else {
-			return greater, nil
+			return o.compareFloats(v1, v2, false), nil
 		}
 	case complex64:
 		v2, ok := b.(complex64)
 		if !ok {
-			return invalid, typeNotMathError
+			return invalid, ErrTypeMismatch
 		}
-		if v1r, v1i, v2r, v2i := real(v1), imag(v1), real(v2), imag(v2); v1 == v2 {
+		if v1r, v1i, v2r, v2i := real(v1), imag(v1), real(v2), imag(v2); v1 == v2 || o.equalComplexNaNs(complex128(v1), complex128(v2)) {
 			return equal, nil
 		} else if v1r < v2r || (v1r == v2r && v1i < v2i) {
 			return less, nil
@@ -280,9 +399,9 @@ func comparePrimitiveValue(a, b interface{}) (r int, e error) {
 	case complex128:
 		v2, ok := b.(complex128)
 		if !ok {
-			return invalid, typeNotMathError
+			return invalid, ErrTypeMismatch
 		}
-		if v1r, v1i, v2r, v2i := real(v1), imag(v1), real(v2), imag(v2); v1 == v2 {
+		if v1r, v1i, v2r, v2i := real(v1), imag(v1), real(v2), imag(v2); v1 == v2 || o.equalComplexNaNs(v1, v2) {
 			return equal, nil
 		} else if v1r < v2r || (v1r == v2r && v1i < v2i) {
 			return less, nil
@@ -291,18 +410,19 @@ func comparePrimitiveValue(a, b interface{}) (r int, e error) {
 		}
 	default:
 		// 处理由 type 关键字创建的底层类型是基础类型的新类型
-		if r, e = reflectComparePrimitiveValue(reflect.ValueOf(a), reflect.ValueOf(b)); r != invalid && e != nil {
+		if r, e = reflectComparePrimitiveValue(reflect.ValueOf(a), reflect.ValueOf(b), o); r != invalid && e != nil {
 			return r, e
 		}
 		return
 	}
 }
 
-func reflectComparePrimitiveValue(va, vb reflect.Value) (int, error) {
+// reflectComparePrimitiveValue 函数用于比较两个底层类型是基础类型的值, NumericPromotion 选项由调用方处理.
+func reflectComparePrimitiveValue(va, vb reflect.Value, o *options) (int, error) {
 	switch va.Kind() {
 	case reflect.Bool:
 		if vb.Kind() != reflect.Bool {
-			return invalid, typeNotMathError
+			return invalid, ErrTypeMismatch
 		} else if x, y := va.Bool(), vb.Bool(); x == y {
 			return equal, nil
 		} else if !x && y {
@@ -312,7 +432,7 @@ func reflectComparePrimitiveValue(va, vb reflect.Value) (int, error) {
 		}
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
 		if k := vb.Kind(); k != reflect.Int && k != reflect.Int8 && k != reflect.Int16 && k != reflect.Int32 && k != reflect.Int64 {
-			return invalid, typeNotMathError
+			return invalid, ErrTypeMismatch
 		} else if x, y := va.Int(), vb.Int(); x == y {
 			return equal, nil
 		} else if x < y {
@@ -321,8 +441,8 @@ func reflectComparePrimitiveValue(va, vb reflect.Value) (int, error) {
 			return greater, nil
 		}
 	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
-		if k := vb.Kind(); k != reflect.Uint8 && k != reflect.Uint16 && k != reflect.Uint32 && k != reflect.Uint64 && k != reflect.Uintptr {
-			return invalid, typeNotMathError
+		if k := vb.Kind(); k != reflect.Uint && k != reflect.Uint8 && k != reflect.Uint16 && k != reflect.Uint32 && k != reflect.Uint64 && k != reflect.Uintptr {
+			return invalid, ErrTypeMismatch
 		} else if x, y := va.Uint(), vb.Uint(); x == y {
 			return equal, nil
 		} else if x < y {
@@ -332,18 +452,16 @@ func reflectComparePrimitiveValue(va, vb reflect.Value) (int, error) {
 		}
 	case reflect.Float32, reflect.Float64:
 		if k := vb.Kind(); k != reflect.Float32 && k != reflect.Float64 {
-			return invalid, typeNotMathError
-		} else if x, y := va.Float(), vb.Float(); x == y {
-			return equal, nil
-		} else if x < y {
-			return less, nil
+			return invalid, ErrTypeMismatch
+		} else if x, y := va.Float(), vb.Float(); va.Kind() == reflect.Float32 && k == reflect.Float32 {
+			return o.compareFloats(x, y, true), nil
 		} else {
-			return greater, nil
+			return o.compareFloats(x, y, false), nil
 		}
 	case reflect.Complex64, reflect.Complex128:
 		if k := vb.Kind(); k != reflect.Complex64 && k != reflect.Complex128 {
-			return invalid, typeNotMathError
-		} else if x, y := va.Complex(), vb.Complex(); x == y {
+			return invalid, ErrTypeMismatch
+		} else if x, y := va.Complex(), vb.Complex(); x == y || o.equalComplexNaNs(x, y) {
 			return equal, nil
 		} else if real(x) < real(y) || (real(x) == real(y) && imag(x) < imag(y)) { // 先比较实部，再比较虚部
 			return less, nil
@@ -352,52 +470,62 @@ func reflectComparePrimitiveValue(va, vb reflect.Value) (int, error) {
 		}
 	case reflect.String:
 		if vb.Kind() != reflect.String {
-			return invalid, typeNotMathError
+			return invalid, ErrTypeMismatch
 		} else if x, y := va.String(), vb.String(); x == y {
 			return equal, nil
+		} else if o.compareString != nil {
+			return fromResult(o.compareString(x, y)), nil
 		} else if x < y {
 			return less, nil
 		} else {
 			return greater, nil
 		}
 	}
-	return invalid, invalidError
+	return invalid, ErrIncomparable
 }
 
-func comparePointer(a, b interface{}, va, vb reflect.Value) (int, error) {
-	// 解析多级指针
-	for x, y := va.Elem(), vb.Elem(); va.Kind() == reflect.Pointer; va, vb = x, y {
-	}
-	if !va.IsValid() || !vb.IsValid() {
-		if o1, o2 := va.IsValid(), vb.IsValid(); o1 == o2 {
+func comparePointer(a, b interface{}, va, vb reflect.Value, o *options) (int, error) {
+	// 多级指针通过 reflectCompareValue 逐级解析
+	x, y := va.Elem(), vb.Elem()
+	if !x.IsValid() || !y.IsValid() {
+		if o1, o2 := x.IsValid(), y.IsValid(); o1 == o2 {
 			return equal, nil
 		} else if o1 {
-			return less, nilValueError
+			return less, ErrNil
 		} else {
-			return greater, nilValueError
+			return greater, ErrNil
 		}
 	}
-	return reflectCompareValue(a, b, va, vb, true)
+	if va.UnsafePointer() == vb.UnsafePointer() {
+		return equal, nil
+	}
+	// 指针对已经处于比较过程中, 说明存在循环引用, 视为相等
+	k, ok := o.visit(va, vb)
+	if !ok {
+		return equal, nil
+	}
+	defer o.unvisit(k)
+	return reflectCompareValue(a, b, x, y, true, o)
 }
 
-func compareStruct(a, b interface{}, va, vb reflect.Value, mark bool) (r int, e error) {
+func compareStruct(a, b interface{}, va, vb reflect.Value, mark bool, o *options) (r int, e error) {
 	var v1, v2 interface{}
 	if !mark {
 		v1, v2 = a, b
 	} else {
-		v1, v2 = va.Interface(), vb.Interface()
+		// 不可导出字段中的结构体无法访问, 此时直接按照字段逐一比较
+		v1, v2 = interfaceOf(va), interfaceOf(vb)
 	}
-	if t1, o1 := v1.(time.Time); o1 {
-		if t2, o2 := v2.(time.Time); o2 {
-			if x, y := t1.UnixNano(), t2.UnixNano(); x == y {
-				return equal, nil
-			} else if x < y {
-				return less, nil
-			} else {
-				return greater, nil
-			}
+	if va.Type() == timeType {
+		if vb.Type() != timeType {
+			return invalid, ErrTypeMismatch // 类型不一致
+		}
+		t1, o1 := timeOf(va)
+		t2, o2 := timeOf(vb)
+		if !o1 || !o2 {
+			return invalid, ErrIncomparable
 		}
-		return invalid, typeNotMathError // 类型不一致
+		return o.compareTimes(t1, t2), nil
 	}
 	if c1, o1 := v1.(Iface); o1 {
 		if c2, o2 := v2.(Iface); o2 {
@@ -409,48 +537,112 @@ func compareStruct(a, b interface{}, va, vb reflect.Value, mark bool) (r int, e
 				return greater, nil
 			}
 		}
-		return invalid, typeNotMathError // 类型不一致
+		return invalid, ErrTypeMismatch // 类型不一致
 	}
-	// 按字段声明的顺序比较字段值的大小
-	if x, y := va.NumField(), vb.NumField(); x == y {
-		for i := 0; i < x; i++ {
-			if r, e = reflectCompareValue(a, b, va.Field(i), vb.Field(i), true); r != equal {
-				return r, e
-			}
+	// 按照 compare 标签指定的优先级比较字段值的大小, 未指定优先级的字段按照声明的顺序比较
+	t := va.Type()
+	spec := structSpecOf(t)
+	if spec.err != nil {
+		return invalid, spec.err
+	}
+	for i := range spec.fields {
+		f := &spec.fields[i]
+		if (o.ignoreUnexported || len(o.ignoreFields) > 0) && o.ignoreField(t, t.Field(f.index)) {
+			continue
 		}
-		return equal, nil
-	} else if x < y {
+		f1, f2 := va.Field(f.index), vb.Field(f.index)
+		if r, e = compareField(a, b, f1, f2, f, o); r != equal {
+			return r, wrapError(e, "."+f.name, f1, f2)
+		}
+	}
+	return equal, nil
+}
+
+// compareMap 函数按照键的大小分别对两个 map 中的键值对排序, 然后按照字典序逐一比较键值对, 保证比较结果不受 map 遍历顺序的影响.
+// 长度不同的 map 按照长度比较; 当其中一个 map 中缺少对应的键时, 同样会返回确定的比较结果, 并附带 ErrKeyMissing 错误.
+func compareMap(a, b interface{}, va, vb reflect.Value, o *options) (r int, e error) {
+	keys1, keys2 := va.MapKeys(), vb.MapKeys()
+	if len(o.ignoreMapEntries) > 0 {
+		keys1, keys2 = filterMapKeys(va, keys1, o), filterMapKeys(vb, keys2, o)
+	}
+	if x, y := len(keys1), len(keys2); x < y {
 		return less, nil
-	} else {
+	} else if x > y {
 		return greater, nil
 	}
+	if mapEqual(a, b, va, vb, keys1, o) {
+		return equal, nil
+	}
+	// 存在缺少的键或者不相等的值时, 才需要排序后按照字典序比较
+	sortMapKeys(keys1, o)
+	sortMapKeys(keys2, o)
+	for i, k := range keys1 {
+		if r = compareMapKey(k, keys2[i], o); r == less {
+			return less, wrapError(ErrKeyMissing, keySegment(k), va.MapIndex(k), reflect.Value{})
+		} else if r == greater {
+			return greater, wrapError(ErrKeyMissing, keySegment(keys2[i]), reflect.Value{}, vb.MapIndex(keys2[i]))
+		}
+		v1, v2 := va.MapIndex(k), vb.MapIndex(keys2[i])
+		if r, e = reflectCompareValue(a, b, v1, v2, true, o); r != equal {
+			return r, wrapError(e, keySegment(k), v1, v2)
+		}
+	}
+	return equal, nil
 }
 
-func compareMap(a, b interface{}, va, vb reflect.Value) (r int, e error) {
-	if x, y := va.Len(), vb.Len(); x == y {
-		for _, k := range va.MapKeys() {
-			v1 := va.MapIndex(k)
-			v2 := vb.MapIndex(k)
-			if !v1.IsValid() || !v2.IsValid() {
-				return invalid, valueNotMatchError
-			}
-			if r, e = reflectCompareValue(a, b, v1, v2, true); r != equal {
-				return r, e
-			}
+// mapEqual 函数用于在不排序的情况下判断两个长度相同的 map 是否相等, keys 为 va 中参与比较的键.
+// 当 keys 中的键在 vb 中不存在、被 IgnoreMapEntries 选项忽略或者对应的值不相等时返回 false.
+func mapEqual(a, b interface{}, va, vb reflect.Value, keys []reflect.Value, o *options) bool {
+	for _, k := range keys {
+		v2 := vb.MapIndex(k)
+		if !v2.IsValid() || len(o.ignoreMapEntries) > 0 && o.ignoreEntry(interfaceOf(k), interfaceOf(v2)) {
+			return false
+		}
+		if r, _ := reflectCompareValue(a, b, va.MapIndex(k), v2, true, o); r != equal {
+			return false
+		}
+	}
+	return true
+}
+
+// lookupT 函数用于查找元素类型 T 注册的比较器, 每个容器只需要查找一次.
+func lookupT[T any](o *options) Type {
+	cmp, _ := o.lookup(reflect.TypeOf((*T)(nil)).Elem())
+	return cmp
+}
+
+// comparePrimitiveT 函数用于比较两个基础类型的值, cmp 为元素类型注册的比较器, 为 nil 时使用默认的规则比较.
+func comparePrimitiveT[T any](x, y T, cmp Type, o *options) (int, error) {
+	if cmp != nil {
+		return fromResult(cmp(x, y)), nil
+	}
+	return comparePrimitiveValue(x, y, o)
+}
+
+// compareAnyPrimitive 函数用于比较两个保存在接口中的基础类型的值, 由于动态类型可能不同, 需要逐个值查找比较器并处理 NumericPromotion 选项.
+func compareAnyPrimitive(a, b interface{}, o *options) (int, error) {
+	if o.numericPromotion {
+		if r, ok := o.promoteNumbers(reflect.ValueOf(a), reflect.ValueOf(b)); ok {
+			return r, nil
 		}
-		return equal, nil
-	} else if x < y {
-		return less, nil
-	} else {
-		return greater, nil
 	}
+	if t := reflect.TypeOf(a); t == reflect.TypeOf(b) {
+		if cmp, ok := o.lookup(t); ok {
+			return fromResult(cmp(a, b)), nil
+		}
+	}
+	return comparePrimitiveValue(a, b, o)
 }
 
-func sliceCompareT[T comparable](s1, s2 []T) (r int, e error) {
+func sliceCompareT[T comparable](s1, s2 []T, o *options) (r int, e error) {
+	cmp := lookupT[T](o)
+	if o.ignoreSliceOrder {
+		s1, s2 = sortSliceT(s1, cmp, o), sortSliceT(s2, cmp, o)
+	}
 	if x, y := len(s1), len(s2); x == y {
 		for i := 0; i < x; i++ {
-			if r, e = comparePrimitiveValue(s1[i], s2[i]); r != equal {
-				return r, e
+			if r, e = comparePrimitiveT(s1[i], s2[i], cmp, o); r != equal {
+				return r, wrapError(e, indexSegment(i), reflect.ValueOf(s1[i]), reflect.ValueOf(s2[i]))
 			}
 		}
 		return equal, e
@@ -461,17 +653,20 @@ func sliceCompareT[T comparable](s1, s2 []T) (r int, e error) {
 	}
 }
 
-func sliceCompareAny(s1, s2 []interface{}) (r int, e error) {
+func sliceCompareAny(s1, s2 []interface{}, o *options) (r int, e error) {
+	if o.ignoreSliceOrder {
+		s1, s2 = sortSliceAny(s1, o), sortSliceAny(s2, o)
+	}
 	if x, y := len(s1), len(s2); x == y {
 		for i := 0; i < x; i++ {
+			v1, v2 := reflect.ValueOf(s1[i]), reflect.ValueOf(s2[i])
 			if asPrimitive(s1[i]) {
-				if r, e = comparePrimitiveValue(s1[i], s2[i]); r != equal {
-					return r, e
+				if r, e = compareAnyPrimitive(s1[i], s2[i], o); r != equal {
+					return r, wrapError(e, indexSegment(i), v1, v2)
 				}
 			} else {
-				v1, v2 := reflect.ValueOf(s1[i]), reflect.ValueOf(s2[i])
-				if r, e = reflectCompareValue(s1[i], s2[i], v1, v2, false); r != equal {
-					return r, e
+				if r, e = reflectCompareValue(s1[i], s2[i], v1, v2, false, o); r != equal {
+					return r, wrapError(e, indexSegment(i), v1, v2)
 				}
 			}
 		}
@@ -483,32 +678,65 @@ func sliceCompareAny(s1, s2 []interface{}) (r int, e error) {
 	}
 }
 
-func mapCompareT[K comparable, V interface{}](m1, m2 map[K]V) (r int, e error) {
-	if x, y := len(m1), len(m2); x == y {
-		for k, v1 := range m1 {
-			if v2, exists := m2[k]; exists {
-				if asPrimitive(v1) && asPrimitive(v2) {
-					if r, e = comparePrimitiveValue(v1, v2); r != equal {
-						return r, e
-					}
-				} else {
-					if r, e = compareValue(v1, v2, false); r != equal {
-						return r, e
-					}
-				}
-			} else {
-				return invalid, valueNotMatchError
-			}
-		}
-		return equal, nil
-	} else if x < y {
+// compareElemT 函数用于比较容器中的两个元素, cmp 为元素类型注册的比较器, dynamic 表示元素类型是否为接口类型.
+func compareElemT[V any](x, y V, cmp Type, dynamic bool, o *options) (int, error) {
+	switch {
+	case cmp != nil:
+		return fromResult(cmp(x, y)), nil
+	case !asPrimitive(x) || !asPrimitive(y):
+		return compareValue(x, y, false, o)
+	case dynamic:
+		return compareAnyPrimitive(x, y, o)
+	default:
+		return comparePrimitiveValue(x, y, o)
+	}
+}
+
+func mapCompareT[K comparable, V interface{}](m1, m2 map[K]V, o *options) (r int, e error) {
+	if len(o.ignoreMapEntries) > 0 {
+		m1, m2 = filterMapT(m1, o), filterMapT(m2, o)
+	}
+	if x, y := len(m1), len(m2); x < y {
 		return less, nil
-	} else {
+	} else if x > y {
 		return greater, nil
 	}
+	kcmp, vcmp := lookupT[K](o), lookupT[V](o)
+	dynamic := reflect.TypeOf((*V)(nil)).Elem().Kind() == reflect.Interface
+	if mapEqualT(m1, m2, vcmp, dynamic, o) {
+		return equal, nil
+	}
+	// 存在缺少的键或者不相等的值时, 才需要排序后按照字典序比较
+	keys1, keys2 := sortedKeysT(m1, kcmp, o), sortedKeysT(m2, kcmp, o)
+	for i, k := range keys1 {
+		if r = compareKeyT(k, keys2[i], kcmp, o); r == less {
+			return less, wrapError(ErrKeyMissing, keySegment(k), reflect.ValueOf(m1[k]), reflect.Value{})
+		} else if r == greater {
+			return greater, wrapError(ErrKeyMissing, keySegment(keys2[i]), reflect.Value{}, reflect.ValueOf(m2[keys2[i]]))
+		}
+		v1, v2 := m1[k], m2[keys2[i]]
+		if r, e = compareElemT(v1, v2, vcmp, dynamic, o); r != equal {
+			return r, wrapError(e, keySegment(k), reflect.ValueOf(v1), reflect.ValueOf(v2))
+		}
+	}
+	return equal, nil
 }
 
-func compareSliceValue(a, b interface{}, va, vb reflect.Value, mark bool) (r int, e error) {
+// mapEqualT 函数用于在不排序的情况下判断两个长度相同的 map 是否相等, m1 中的键在 m2 中不存在或者对应的值不相等时返回 false.
+func mapEqualT[K comparable, V interface{}](m1, m2 map[K]V, cmp Type, dynamic bool, o *options) bool {
+	for k, v1 := range m1 {
+		v2, ok := m2[k]
+		if !ok {
+			return false
+		}
+		if r, _ := compareElemT(v1, v2, cmp, dynamic, o); r != equal {
+			return false
+		}
+	}
+	return true
+}
+
+func compareSliceValue(a, b interface{}, va, vb reflect.Value, mark bool, o *options) (r int, e error) {
 	var x, y interface{}
 	if !mark {
 		x, y = a, b
@@ -518,7 +746,9 @@ func compareSliceValue(a, b interface{}, va, vb reflect.Value, mark bool) (r int
 	switch v1 := x.(type) {
 	case []byte:
 		if v2, ok := y.([]byte); !ok {
-			return invalid, valueNotMatchError
+			return invalid, ErrTypeMismatch
+		} else if o.ignoreSliceOrder || lookupT[byte](o) != nil {
+			return sliceCompareT(v1, v2, o)
 		} else if r := bytes.Compare(v1, v2); r == 0 {
 			return equal, nil
 		} else if r < 0 {
@@ -529,108 +759,117 @@ func compareSliceValue(a, b interface{}, va, vb reflect.Value, mark bool) (r int
 	case []string:
 		v2, ok := y.([]string)
 		if !ok {
-			return invalid, valueNotMatchError
+			return invalid, ErrTypeMismatch
 		}
-		return sliceCompareT(v1, v2)
+		return sliceCompareT(v1, v2, o)
 	case []bool:
 		v2, ok := y.([]bool)
 		if !ok {
-			return invalid, valueNotMatchError
+			return invalid, ErrTypeMismatch
 		}
-		return sliceCompareT(v1, v2)
+		return sliceCompareT(v1, v2, o)
 	case []int:
 		v2, ok := y.([]int)
 		if !ok {
-			return invalid, valueNotMatchError
+			return invalid, ErrTypeMismatch
 		}
-		return sliceCompareT(v1, v2)
+		return sliceCompareT(v1, v2, o)
 	case []int8:
 		v2, ok := y.([]int8)
 		if !ok {
-			return invalid, valueNotMatchError
+			return invalid, ErrTypeMismatch
 		}
-		return sliceCompareT(v1, v2)
+		return sliceCompareT(v1, v2, o)
 	case []int16:
 		v2, ok := y.([]int16)
 		if !ok {
-			return invalid, valueNotMatchError
+			return invalid, ErrTypeMismatch
 		}
-		return sliceCompareT(v1, v2)
+		return sliceCompareT(v1, v2, o)
 	case []int32:
 		v2, ok := y.([]int32)
 		if !ok {
-			return invalid, valueNotMatchError
+			return invalid, ErrTypeMismatch
 		}
-		return sliceCompareT(v1, v2)
+		return sliceCompareT(v1, v2, o)
 	case []int64:
 		v2, ok := y.([]int64)
 		if !ok {
-			return invalid, valueNotMatchError
+			return invalid, ErrTypeMismatch
 		}
-		return sliceCompareT(v1, v2)
+		return sliceCompareT(v1, v2, o)
 	case []uint:
 		v2, ok := y.([]uint)
 		if !ok {
-			return invalid, valueNotMatchError
+			return invalid, ErrTypeMismatch
 		}
-		return sliceCompareT(v1, v2)
+		return sliceCompareT(v1, v2, o)
 	case []uint16:
 		v2, ok := y.([]uint16)
 		if !ok {
-			return invalid, valueNotMatchError
+			return invalid, ErrTypeMismatch
 		}
-		return sliceCompareT(v1, v2)
+		return sliceCompareT(v1, v2, o)
 	case []uint32:
 		v2, ok := y.([]uint32)
 		if !ok {
-			return invalid, valueNotMatchError
+			return invalid, ErrTypeMismatch
 		}
-		return sliceCompareT(v1, v2)
+		return sliceCompareT(v1, v2, o)
 	case []uint64:
 		v2, ok := y.([]uint64)
 		if !ok {
-			return invalid, valueNotMatchError
+			return invalid, ErrTypeMismatch
 		}
-		return sliceCompareT(v1, v2)
+		return sliceCompareT(v1, v2, o)
 	case []float32:
 		v2, ok := y.([]float32)
 		if !ok {
-			return invalid, valueNotMatchError
+			return invalid, ErrTypeMismatch
 		}
-		return sliceCompareT(v1, v2)
+		return sliceCompareT(v1, v2, o)
 	case []float64:
 		v2, ok := y.([]float64)
 		if !ok {
-			return invalid, valueNotMatchError
+			return invalid, ErrTypeMismatch
 		}
-		return sliceCompareT(v1, v2)
+		return sliceCompareT(v1, v2, o)
 	case []complex64:
 		v2, ok := y.([]complex64)
 		if !ok {
-			return invalid, valueNotMatchError
+			return invalid, ErrTypeMismatch
 		}
-		return sliceCompareT(v1, v2)
+		return sliceCompareT(v1, v2, o)
 	case []complex128:
 		v2, ok := y.([]complex128)
 		if !ok {
-			return invalid, valueNotMatchError
+			return invalid, ErrTypeMismatch
 		}
-		return sliceCompareT(v1, v2)
+		return sliceCompareT(v1, v2, o)
 	case []interface{}:
 		v2, ok := y.([]interface{})
 		if !ok {
-			return invalid, valueNotMatchError
+			return invalid, ErrTypeMismatch
 		}
-		return sliceCompareAny(v1, v2)
+		return sliceCompareAny(v1, v2, o)
 	}
-	return invalid, nil
+	// 元素类型为基础类型的新类型, 按照元素逐一比较
+	return reflectCompareSliceValue(a, b, va, vb, o)
 }
 
-func reflectCompareSliceValue(a, b interface{}, va, vb reflect.Value) (r int, e error) {
+func reflectCompareSliceValue(a, b interface{}, va, vb reflect.Value, o *options) (r int, e error) {
 	if x, y := va.Len(), vb.Len(); x == y {
+		var ia, ib []int
+		if o.ignoreSliceOrder && va.Kind() == reflect.Slice {
+			ia, ib = sortSliceValue(va, o), sortSliceValue(vb, o)
+		}
 		for i := 0; i < x; i++ {
-			if r, e = reflectCompareValue(a, b, va.Index(i), vb.Index(i), true); r != equal {
-				return r, e
+			e1, e2 := va.Index(i), vb.Index(i)
+			if ia != nil {
+				e1, e2 = va.Index(ia[i]), vb.Index(ib[i])
+			}
+			if r, e = reflectCompareValue(a, b, e1, e2, true, o); r != equal {
+				return r, wrapError(e, indexSegment(i), e1, e2)
 			}
 		}
 		return equal, e
@@ -641,6 +880,109 @@ func reflectCompareSliceValue(a, b interface{}, va, vb reflect.Value) (r int, e
 	}
 }
 
+// sortSliceT 函数用于获取切片排序后的副本, 用于忽略切片中元素顺序的比较.
+func sortSliceT[T comparable](s []T, cmp Type, o *options) []T {
+	ret := append([]T(nil), s...)
+	sort.SliceStable(ret, func(i, j int) bool {
+		r, _ := comparePrimitiveT(ret[i], ret[j], cmp, o)
+		return r == less
+	})
+	return ret
+}
+
+// sortSliceAny 函数用于获取切片排序后的副本, 无法比较的元素按照类型名称排序.
+func sortSliceAny(s []interface{}, o *options) []interface{} {
+	ret := append([]interface{}(nil), s...)
+	sort.SliceStable(ret, func(i, j int) bool {
+		if r, _ := compareValue(ret[i], ret[j], false, o); r != invalid {
+			return r == less
+		}
+		return typeName(reflect.ValueOf(ret[i])) < typeName(reflect.ValueOf(ret[j]))
+	})
+	return ret
+}
+
+// sortSliceValue 函数用于获取切片中元素排序后的下标序列.
+func sortSliceValue(v reflect.Value, o *options) []int {
+	return sortedIndices(v.Len(), func(i, j int) bool {
+		x, y := v.Index(i), v.Index(j)
+		if r, _ := reflectCompareValue(nil, nil, x, y, true, o); r != invalid {
+			return r == less
+		}
+		return typeName(x) < typeName(y)
+	})
+}
+
+// filterMapT 函数用于获取 map 中未被 IgnoreMapEntries 选项忽略的键值对.
+func filterMapT[K comparable, V interface{}](m map[K]V, o *options) map[K]V {
+	ret := make(map[K]V, len(m))
+	for k, v := range m {
+		if !o.ignoreEntry(k, v) {
+			ret[k] = v
+		}
+	}
+	return ret
+}
+
+// sortedKeysT 函数用于获取 map 中按照键的大小排序后的键.
+func sortedKeysT[K comparable, V interface{}](m map[K]V, cmp Type, o *options) []K {
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return compareKeyT(keys[i], keys[j], cmp, o) == less })
+	return keys
+}
+
+// compareKeyT 函数用于比较两个 map 键的大小, cmp 为键的类型注册的比较器.
+func compareKeyT[K comparable](x, y K, cmp Type, o *options) int {
+	if cmp != nil {
+		return fromResult(cmp(x, y))
+	}
+	if asPrimitive(x) && asPrimitive(y) {
+		if r, _ := comparePrimitiveValue(x, y, o); r != invalid {
+			return r
+		}
+	}
+	return compareMapKey(reflect.ValueOf(x), reflect.ValueOf(y), o)
+}
+
+// sortMapKeys 函数用于按照键的大小对 map 的键进行排序.
+func sortMapKeys(keys []reflect.Value, o *options) {
+	sort.Slice(keys, func(i, j int) bool { return compareMapKey(keys[i], keys[j], o) == less })
+}
+
+// compareMapKey 函数用于比较两个 map 键的大小, 保证任意两个键之间均存在确定的大小关系.
+// 无法比较的键(例如 interface{} 类型的键中保存了不同类型的值)依次按照类型名称、字符串表示形式比较.
+func compareMapKey(x, y reflect.Value, o *options) int {
+	if x.Kind() == reflect.Interface {
+		x = x.Elem()
+	}
+	if y.Kind() == reflect.Interface {
+		y = y.Elem()
+	}
+	if x.IsValid() && y.IsValid() {
+		if r, _ := reflectCompareValue(nil, nil, x, y, true, o); r != invalid {
+			return r
+		}
+	}
+	if s, t := typeName(x), typeName(y); s != t {
+		return fromResult(strings.Compare(s, t))
+	}
+	return fromResult(strings.Compare(fmt.Sprint(x), fmt.Sprint(y)))
+}
+
+// filterMapKeys 函数用于获取 map 中未被 IgnoreMapEntries 选项忽略的键.
+func filterMapKeys(v reflect.Value, keys []reflect.Value, o *options) []reflect.Value {
+	ret := keys[:0]
+	for _, k := range keys {
+		if !o.ignoreEntry(interfaceOf(k), interfaceOf(v.MapIndex(k))) {
+			ret = append(ret, k)
+		}
+	}
+	return ret
+}
+
 func isPrimitive(k reflect.Kind) bool {
 	switch k {
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
@@ -668,7 +1010,7 @@ func asPrimitive(v interface{}) bool {
 	}
 }
 
-func compareMapValue(a, b interface{}, va, vb reflect.Value, mark bool) (int, error) {
+func compareMapValue(a, b interface{}, va, vb reflect.Value, mark bool, o *options) (int, error) {
 	var x, y interface{}
 	if !mark {
 		x, y = a, b
@@ -677,584 +1019,585 @@ func compareMapValue(a, b interface{}, va, vb reflect.Value, mark bool) (int, er
 	}
 	switch v := x.(type) {
 	case map[string]string:
-		return mapCompareT(v, y.(map[string]string))
+		return mapCompareT(v, y.(map[string]string), o)
 	case map[string]bool:
-		return mapCompareT(v, y.(map[string]bool))
+		return mapCompareT(v, y.(map[string]bool), o)
 	case map[string]int:
-		return mapCompareT(v, y.(map[string]int))
+		return mapCompareT(v, y.(map[string]int), o)
 	case map[string]int8:
-		return mapCompareT(v, y.(map[string]int8))
+		return mapCompareT(v, y.(map[string]int8), o)
 	case map[string]int16:
-		return mapCompareT(v, y.(map[string]int16))
+		return mapCompareT(v, y.(map[string]int16), o)
 	case map[string]int32:
-		return mapCompareT(v, y.(map[string]int32))
+		return mapCompareT(v, y.(map[string]int32), o)
 	case map[string]int64:
-		return mapCompareT(v, y.(map[string]int64))
+		return mapCompareT(v, y.(map[string]int64), o)
 	case map[string]uint:
-		return mapCompareT(v, y.(map[string]uint))
+		return mapCompareT(v, y.(map[string]uint), o)
 	case map[string]uint8:
-		return mapCompareT(v, y.(map[string]uint8))
+		return mapCompareT(v, y.(map[string]uint8), o)
 	case map[string]uint16:
-		return mapCompareT(v, y.(map[string]uint16))
+		return mapCompareT(v, y.(map[string]uint16), o)
 	case map[string]uint32:
-		return mapCompareT(v, y.(map[string]uint32))
+		return mapCompareT(v, y.(map[string]uint32), o)
 	case map[string]uint64:
-		return mapCompareT(v, y.(map[string]uint64))
+		return mapCompareT(v, y.(map[string]uint64), o)
 	case map[string]float32:
-		return mapCompareT(v, y.(map[string]float32))
+		return mapCompareT(v, y.(map[string]float32), o)
 	case map[string]float64:
-		return mapCompareT(v, y.(map[string]float64))
+		return mapCompareT(v, y.(map[string]float64), o)
 	case map[string]complex64:
-		return mapCompareT(v, y.(map[string]complex64))
+		return mapCompareT(v, y.(map[string]complex64), o)
 	case map[string]complex128:
-		return mapCompareT(v, y.(map[string]complex128))
+		return mapCompareT(v, y.(map[string]complex128), o)
 	case map[string]interface{}:
-		return mapCompareT(v, y.(map[string]interface{}))
+		return mapCompareT(v, y.(map[string]interface{}), o)
 	case map[bool]string:
-		return mapCompareT(v, y.(map[bool]string))
+		return mapCompareT(v, y.(map[bool]string), o)
 	case map[bool]bool:
-		return mapCompareT(v, y.(map[bool]bool))
+		return mapCompareT(v, y.(map[bool]bool), o)
 	case map[bool]int:
-		return mapCompareT(v, y.(map[bool]int))
+		return mapCompareT(v, y.(map[bool]int), o)
 	case map[bool]int8:
-		return mapCompareT(v, y.(map[bool]int8))
+		return mapCompareT(v, y.(map[bool]int8), o)
 	case map[bool]int16:
-		return mapCompareT(v, y.(map[bool]int16))
+		return mapCompareT(v, y.(map[bool]int16), o)
 	case map[bool]int32:
-		return mapCompareT(v, y.(map[bool]int32))
+		return mapCompareT(v, y.(map[bool]int32), o)
 	case map[bool]int64:
-		return mapCompareT(v, y.(map[bool]int64))
+		return mapCompareT(v, y.(map[bool]int64), o)
 	case map[bool]uint:
-		return mapCompareT(v, y.(map[bool]uint))
+		return mapCompareT(v, y.(map[bool]uint), o)
 	case map[bool]uint8:
-		return mapCompareT(v, y.(map[bool]uint8))
+		return mapCompareT(v, y.(map[bool]uint8), o)
 	case map[bool]uint16:
-		return mapCompareT(v, y.(map[bool]uint16))
+		return mapCompareT(v, y.(map[bool]uint16), o)
 	case map[bool]uint32:
-		return mapCompareT(v, y.(map[bool]uint32))
+		return mapCompareT(v, y.(map[bool]uint32), o)
 	case map[bool]uint64:
-		return mapCompareT(v, y.(map[bool]uint64))
+		return mapCompareT(v, y.(map[bool]uint64), o)
 	case map[bool]float32:
-		return mapCompareT(v, y.(map[bool]float32))
+		return mapCompareT(v, y.(map[bool]float32), o)
 	case map[bool]float64:
-		return mapCompareT(v, y.(map[bool]float64))
+		return mapCompareT(v, y.(map[bool]float64), o)
 	case map[bool]complex64:
-		return mapCompareT(v, y.(map[bool]complex64))
+		return mapCompareT(v, y.(map[bool]complex64), o)
 	case map[bool]complex128:
-		return mapCompareT(v, y.(map[bool]complex128))
+		return mapCompareT(v, y.(map[bool]complex128), o)
 	case map[bool]interface{}:
-		return mapCompareT(v, y.(map[bool]interface{}))
+		return mapCompareT(v, y.(map[bool]interface{}), o)
 	case map[int]string:
-		return mapCompareT(v, y.(map[int]string))
+		return mapCompareT(v, y.(map[int]string), o)
 	case map[int]bool:
-		return mapCompareT(v, y.(map[int]bool))
+		return mapCompareT(v, y.(map[int]bool), o)
 	case map[int]int:
-		return mapCompareT(v, y.(map[int]int))
+		return mapCompareT(v, y.(map[int]int), o)
 	case map[int]int8:
-		return mapCompareT(v, y.(map[int]int8))
+		return mapCompareT(v, y.(map[int]int8), o)
 	case map[int]int16:
-		return mapCompareT(v, y.(map[int]int16))
+		return mapCompareT(v, y.(map[int]int16), o)
 	case map[int]int32:
-		return mapCompareT(v, y.(map[int]int32))
+		return mapCompareT(v, y.(map[int]int32), o)
 	case map[int]int64:
-		return mapCompareT(v, y.(map[int]int64))
+		return mapCompareT(v, y.(map[int]int64), o)
 	case map[int]uint:
-		return mapCompareT(v, y.(map[int]uint))
+		return mapCompareT(v, y.(map[int]uint), o)
 	case map[int]uint8:
-		return mapCompareT(v, y.(map[int]uint8))
+		return mapCompareT(v, y.(map[int]uint8), o)
 	case map[int]uint16:
-		return mapCompareT(v, y.(map[int]uint16))
+		return mapCompareT(v, y.(map[int]uint16), o)
 	case map[int]uint32:
-		return mapCompareT(v, y.(map[int]uint32))
+		return mapCompareT(v, y.(map[int]uint32), o)
 	case map[int]uint64:
-		return mapCompareT(v, y.(map[int]uint64))
+		return mapCompareT(v, y.(map[int]uint64), o)
 	case map[int]float32:
-		return mapCompareT(v, y.(map[int]float32))
+		return mapCompareT(v, y.(map[int]float32), o)
 	case map[int]float64:
-		return mapCompareT(v, y.(map[int]float64))
+		return mapCompareT(v, y.(map[int]float64), o)
 	case map[int]complex64:
-		return mapCompareT(v, y.(map[int]complex64))
+		return mapCompareT(v, y.(map[int]complex64), o)
 	case map[int]complex128:
-		return mapCompareT(v, y.(map[int]complex128))
+		return mapCompareT(v, y.(map[int]complex128), o)
 	case map[int]interface{}:
-		return mapCompareT(v, y.(map[int]interface{}))
+		return mapCompareT(v, y.(map[int]interface{}), o)
 	case map[int8]string:
-		return mapCompareT(v, y.(map[int8]string))
+		return mapCompareT(v, y.(map[int8]string), o)
 	case map[int8]bool:
-		return mapCompareT(v, y.(map[int8]bool))
+		return mapCompareT(v, y.(map[int8]bool), o)
 	case map[int8]int:
-		return mapCompareT(v, y.(map[int8]int))
+		return mapCompareT(v, y.(map[int8]int), o)
 	case map[int8]int8:
-		return mapCompareT(v, y.(map[int8]int8))
+		return mapCompareT(v, y.(map[int8]int8), o)
 	case map[int8]int16:
-		return mapCompareT(v, y.(map[int8]int16))
+		return mapCompareT(v, y.(map[int8]int16), o)
 	case map[int8]int32:
-		return mapCompareT(v, y.(map[int8]int32))
+		return mapCompareT(v, y.(map[int8]int32), o)
 	case map[int8]int64:
-		return mapCompareT(v, y.(map[int8]int64))
+		return mapCompareT(v, y.(map[int8]int64), o)
 	case map[int8]uint:
-		return mapCompareT(v, y.(map[int8]uint))
+		return mapCompareT(v, y.(map[int8]uint), o)
 	case map[int8]uint8:
-		return mapCompareT(v, y.(map[int8]uint8))
+		return mapCompareT(v, y.(map[int8]uint8), o)
 	case map[int8]uint16:
-		return mapCompareT(v, y.(map[int8]uint16))
+		return mapCompareT(v, y.(map[int8]uint16), o)
 	case map[int8]uint32:
-		return mapCompareT(v, y.(map[int8]uint32))
+		return mapCompareT(v, y.(map[int8]uint32), o)
 	case map[int8]uint64:
-		return mapCompareT(v, y.(map[int8]uint64))
+		return mapCompareT(v, y.(map[int8]uint64), o)
 	case map[int8]float32:
-		return mapCompareT(v, y.(map[int8]float32))
+		return mapCompareT(v, y.(map[int8]float32), o)
 	case map[int8]float64:
-		return mapCompareT(v, y.(map[int8]float64))
+		return mapCompareT(v, y.(map[int8]float64), o)
 	case map[int8]complex64:
-		return mapCompareT(v, y.(map[int8]complex64))
+		return mapCompareT(v, y.(map[int8]complex64), o)
 	case map[int8]complex128:
-		return mapCompareT(v, y.(map[int8]complex128))
+		return mapCompareT(v, y.(map[int8]complex128), o)
 	case map[int8]interface{}:
-		return mapCompareT(v, y.(map[int8]interface{}))
+		return mapCompareT(v, y.(map[int8]interface{}), o)
 	case map[int16]string:
-		return mapCompareT(v, y.(map[int16]string))
+		return mapCompareT(v, y.(map[int16]string), o)
 	case map[int16]bool:
-		return mapCompareT(v, y.(map[int16]bool))
+		return mapCompareT(v, y.(map[int16]bool), o)
 	case map[int16]int:
-		return mapCompareT(v, y.(map[int16]int))
+		return mapCompareT(v, y.(map[int16]int), o)
 	case map[int16]int8:
-		return mapCompareT(v, y.(map[int16]int8))
+		return mapCompareT(v, y.(map[int16]int8), o)
 	case map[int16]int16:
-		return mapCompareT(v, y.(map[int16]int16))
+		return mapCompareT(v, y.(map[int16]int16), o)
 	case map[int16]int32:
-		return mapCompareT(v, y.(map[int16]int32))
+		return mapCompareT(v, y.(map[int16]int32), o)
 	case map[int16]int64:
-		return mapCompareT(v, y.(map[int16]int64))
+		return mapCompareT(v, y.(map[int16]int64), o)
 	case map[int16]uint:
-		return mapCompareT(v, y.(map[int16]uint))
+		return mapCompareT(v, y.(map[int16]uint), o)
 	case map[int16]uint8:
-		return mapCompareT(v, y.(map[int16]uint8))
+		return mapCompareT(v, y.(map[int16]uint8), o)
 	case map[int16]uint16:
-		return mapCompareT(v, y.(map[int16]uint16))
+		return mapCompareT(v, y.(map[int16]uint16), o)
 	case map[int16]uint32:
-		return mapCompareT(v, y.(map[int16]uint32))
+		return mapCompareT(v, y.(map[int16]uint32), o)
 	case map[int16]uint64:
-		return mapCompareT(v, y.(map[int16]uint64))
+		return mapCompareT(v, y.(map[int16]uint64), o)
 	case map[int16]float32:
-		return mapCompareT(v, y.(map[int16]float32))
+		return mapCompareT(v, y.(map[int16]float32), o)
 	case map[int16]float64:
-		return mapCompareT(v, y.(map[int16]float64))
+		return mapCompareT(v, y.(map[int16]float64), o)
 	case map[int16]complex64:
-		return mapCompareT(v, y.(map[int16]complex64))
+		return mapCompareT(v, y.(map[int16]complex64), o)
 	case map[int16]complex128:
-		return mapCompareT(v, y.(map[int16]complex128))
+		return mapCompareT(v, y.(map[int16]complex128), o)
 	case map[int16]interface{}:
-		return mapCompareT(v, y.(map[int16]interface{}))
+		return mapCompareT(v, y.(map[int16]interface{}), o)
 	case map[int32]string:
-		return mapCompareT(v, y.(map[int32]string))
+		return mapCompareT(v, y.(map[int32]string), o)
 	case map[int32]bool:
-		return mapCompareT(v, y.(map[int32]bool))
+		return mapCompareT(v, y.(map[int32]bool), o)
 	case map[int32]int:
-		return mapCompareT(v, y.(map[int32]int))
+		return mapCompareT(v, y.(map[int32]int), o)
 	case map[int32]int8:
-		return mapCompareT(v, y.(map[int32]int8))
+		return mapCompareT(v, y.(map[int32]int8), o)
 	case map[int32]int16:
-		return mapCompareT(v, y.(map[int32]int16))
+		return mapCompareT(v, y.(map[int32]int16), o)
 	case map[int32]int32:
-		return mapCompareT(v, y.(map[int32]int32))
+		return mapCompareT(v, y.(map[int32]int32), o)
 	case map[int32]int64:
-		return mapCompareT(v, y.(map[int32]int64))
+		return mapCompareT(v, y.(map[int32]int64), o)
 	case map[int32]uint:
-		return mapCompareT(v, y.(map[int32]uint))
+		return mapCompareT(v, y.(map[int32]uint), o)
 	case map[int32]uint8:
-		return mapCompareT(v, y.(map[int32]uint8))
+		return mapCompareT(v, y.(map[int32]uint8), o)
 	case map[int32]uint16:
-		return mapCompareT(v, y.(map[int32]uint16))
+		return mapCompareT(v, y.(map[int32]uint16), o)
 	case map[int32]uint32:
-		return mapCompareT(v, y.(map[int32]uint32))
+		return mapCompareT(v, y.(map[int32]uint32), o)
 	case map[int32]uint64:
-		return mapCompareT(v, y.(map[int32]uint64))
+		return mapCompareT(v, y.(map[int32]uint64), o)
 	case map[int32]float32:
-		return mapCompareT(v, y.(map[int32]float32))
+		return mapCompareT(v, y.(map[int32]float32), o)
 	case map[int32]float64:
-		return mapCompareT(v, y.(map[int32]float64))
+		return mapCompareT(v, y.(map[int32]float64), o)
 	case map[int32]complex64:
-		return mapCompareT(v, y.(map[int32]complex64))
+		return mapCompareT(v, y.(map[int32]complex64), o)
 	case map[int32]complex128:
-		return mapCompareT(v, y.(map[int32]complex128))
+		return mapCompareT(v, y.(map[int32]complex128), o)
 	case map[int32]interface{}:
-		return mapCompareT(v, y.(map[int32]interface{}))
+		return mapCompareT(v, y.(map[int32]interface{}), o)
 	case map[int64]string:
-		return mapCompareT(v, y.(map[int64]string))
+		return mapCompareT(v, y.(map[int64]string), o)
 	case map[int64]bool:
-		return mapCompareT(v, y.(map[int64]bool))
+		return mapCompareT(v, y.(map[int64]bool), o)
 	case map[int64]int:
-		return mapCompareT(v, y.(map[int64]int))
+		return mapCompareT(v, y.(map[int64]int), o)
 	case map[int64]int8:
-		return mapCompareT(v, y.(map[int64]int8))
+		return mapCompareT(v, y.(map[int64]int8), o)
 	case map[int64]int16:
-		return mapCompareT(v, y.(map[int64]int16))
+		return mapCompareT(v, y.(map[int64]int16), o)
 	case map[int64]int32:
-		return mapCompareT(v, y.(map[int64]int32))
+		return mapCompareT(v, y.(map[int64]int32), o)
 	case map[int64]int64:
-		return mapCompareT(v, y.(map[int64]int64))
+		return mapCompareT(v, y.(map[int64]int64), o)
 	case map[int64]uint:
-		return mapCompareT(v, y.(map[int64]uint))
+		return mapCompareT(v, y.(map[int64]uint), o)
 	case map[int64]uint8:
-		return mapCompareT(v, y.(map[int64]uint8))
+		return mapCompareT(v, y.(map[int64]uint8), o)
 	case map[int64]uint16:
-		return mapCompareT(v, y.(map[int64]uint16))
+		return mapCompareT(v, y.(map[int64]uint16), o)
 	case map[int64]uint32:
-		return mapCompareT(v, y.(map[int64]uint32))
+		return mapCompareT(v, y.(map[int64]uint32), o)
 	case map[int64]uint64:
-		return mapCompareT(v, y.(map[int64]uint64))
+		return mapCompareT(v, y.(map[int64]uint64), o)
 	case map[int64]float32:
-		return mapCompareT(v, y.(map[int64]float32))
+		return mapCompareT(v, y.(map[int64]float32), o)
 	case map[int64]float64:
-		return mapCompareT(v, y.(map[int64]float64))
+		return mapCompareT(v, y.(map[int64]float64), o)
 	case map[int64]complex64:
-		return mapCompareT(v, y.(map[int64]complex64))
+		return mapCompareT(v, y.(map[int64]complex64), o)
 	case map[int64]complex128:
-		return mapCompareT(v, y.(map[int64]complex128))
+		return mapCompareT(v, y.(map[int64]complex128), o)
 	case map[int64]interface{}:
-		return mapCompareT(v, y.(map[int64]interface{}))
+		return mapCompareT(v, y.(map[int64]interface{}), o)
 	case map[uint]string:
-		return mapCompareT(v, y.(map[uint]string))
+		return mapCompareT(v, y.(map[uint]string), o)
 	case map[uint]bool:
-		return mapCompareT(v, y.(map[uint]bool))
+		return mapCompareT(v, y.(map[uint]bool), o)
 	case map[uint]int:
-		return mapCompareT(v, y.(map[uint]int))
+		return mapCompareT(v, y.(map[uint]int), o)
 	case map[uint]int8:
-		return mapCompareT(v, y.(map[uint]int8))
+		return mapCompareT(v, y.(map[uint]int8), o)
 	case map[uint]int16:
-		return mapCompareT(v, y.(map[uint]int16))
+		return mapCompareT(v, y.(map[uint]int16), o)
 	case map[uint]int32:
-		return mapCompareT(v, y.(map[uint]int32))
+		return mapCompareT(v, y.(map[uint]int32), o)
 	case map[uint]int64:
-		return mapCompareT(v, y.(map[uint]int64))
+		return mapCompareT(v, y.(map[uint]int64), o)
 	case map[uint]uint:
-		return mapCompareT(v, y.(map[uint]uint))
+		return mapCompareT(v, y.(map[uint]uint), o)
 	case map[uint]uint8:
-		return mapCompareT(v, y.(map[uint]uint8))
+		return mapCompareT(v, y.(map[uint]uint8), o)
 	case map[uint]uint16:
-		return mapCompareT(v, y.(map[uint]uint16))
+		return mapCompareT(v, y.(map[uint]uint16), o)
 	case map[uint]uint32:
-		return mapCompareT(v, y.(map[uint]uint32))
+		return mapCompareT(v, y.(map[uint]uint32), o)
 	case map[uint]uint64:
-		return mapCompareT(v, y.(map[uint]uint64))
+		return mapCompareT(v, y.(map[uint]uint64), o)
 	case map[uint]float32:
-		return mapCompareT(v, y.(map[uint]float32))
+		return mapCompareT(v, y.(map[uint]float32), o)
 	case map[uint]float64:
-		return mapCompareT(v, y.(map[uint]float64))
+		return mapCompareT(v, y.(map[uint]float64), o)
 	case map[uint]complex64:
-		return mapCompareT(v, y.(map[uint]complex64))
+		return mapCompareT(v, y.(map[uint]complex64), o)
 	case map[uint]complex128:
-		return mapCompareT(v, y.(map[uint]complex128))
+		return mapCompareT(v, y.(map[uint]complex128), o)
 	case map[uint]interface{}:
-		return mapCompareT(v, y.(map[uint]interface{}))
+		return mapCompareT(v, y.(map[uint]interface{}), o)
 	case map[uint8]string:
-		return mapCompareT(v, y.(map[uint8]string))
+		return mapCompareT(v, y.(map[uint8]string), o)
 	case map[uint8]bool:
-		return mapCompareT(v, y.(map[uint8]bool))
+		return mapCompareT(v, y.(map[uint8]bool), o)
 	case map[uint8]int:
-		return mapCompareT(v, y.(map[uint8]int))
+		return mapCompareT(v, y.(map[uint8]int), o)
 	case map[uint8]int8:
-		return mapCompareT(v, y.(map[uint8]int8))
+		return mapCompareT(v, y.(map[uint8]int8), o)
 	case map[uint8]int16:
-		return mapCompareT(v, y.(map[uint8]int16))
+		return mapCompareT(v, y.(map[uint8]int16), o)
 	case map[uint8]int32:
-		return mapCompareT(v, y.(map[uint8]int32))
+		return mapCompareT(v, y.(map[uint8]int32), o)
 	case map[uint8]int64:
-		return mapCompareT(v, y.(map[uint8]int64))
+		return mapCompareT(v, y.(map[uint8]int64), o)
 	case map[uint8]uint:
-		return mapCompareT(v, y.(map[uint8]uint))
+		return mapCompareT(v, y.(map[uint8]uint), o)
 	case map[uint8]uint8:
-		return mapCompareT(v, y.(map[uint8]uint8))
+		return mapCompareT(v, y.(map[uint8]uint8), o)
 	case map[uint8]uint16:
-		return mapCompareT(v, y.(map[uint8]uint16))
+		return mapCompareT(v, y.(map[uint8]uint16), o)
 	case map[uint8]uint32:
-		return mapCompareT(v, y.(map[uint8]uint32))
+		return mapCompareT(v, y.(map[uint8]uint32), o)
 	case map[uint8]uint64:
-		return mapCompareT(v, y.(map[uint8]uint64))
+		return mapCompareT(v, y.(map[uint8]uint64), o)
 	case map[uint8]float32:
-		return mapCompareT(v, y.(map[uint8]float32))
+		return mapCompareT(v, y.(map[uint8]float32), o)
 	case map[uint8]float64:
-		return mapCompareT(v, y.(map[uint8]float64))
+		return mapCompareT(v, y.(map[uint8]float64), o)
 	case map[uint8]complex64:
-		return mapCompareT(v, y.(map[uint8]complex64))
+		return mapCompareT(v, y.(map[uint8]complex64), o)
 	case map[uint8]complex128:
-		return mapCompareT(v, y.(map[uint8]complex128))
+		return mapCompareT(v, y.(map[uint8]complex128), o)
 	case map[uint8]interface{}:
-		return mapCompareT(v, y.(map[uint8]interface{}))
+		return mapCompareT(v, y.(map[uint8]interface{}), o)
 	case map[uint16]string:
-		return mapCompareT(v, y.(map[uint16]string))
+		return mapCompareT(v, y.(map[uint16]string), o)
 	case map[uint16]bool:
-		return mapCompareT(v, y.(map[uint16]bool))
+		return mapCompareT(v, y.(map[uint16]bool), o)
 	case map[uint16]int:
-		return mapCompareT(v, y.(map[uint16]int))
+		return mapCompareT(v, y.(map[uint16]int), o)
 	case map[uint16]int8:
-		return mapCompareT(v, y.(map[uint16]int8))
+		return mapCompareT(v, y.(map[uint16]int8), o)
 	case map[uint16]int16:
-		return mapCompareT(v, y.(map[uint16]int16))
+		return mapCompareT(v, y.(map[uint16]int16), o)
 	case map[uint16]int32:
-		return mapCompareT(v, y.(map[uint16]int32))
+		return mapCompareT(v, y.(map[uint16]int32), o)
 	case map[uint16]int64:
-		return mapCompareT(v, y.(map[uint16]int64))
+		return mapCompareT(v, y.(map[uint16]int64), o)
 	case map[uint16]uint:
-		return mapCompareT(v, y.(map[uint16]uint))
+		return mapCompareT(v, y.(map[uint16]uint), o)
 	case map[uint16]uint8:
-		return mapCompareT(v, y.(map[uint16]uint8))
+		return mapCompareT(v, y.(map[uint16]uint8), o)
 	case map[uint16]uint16:
-		return mapCompareT(v, y.(map[uint16]uint16))
+		return mapCompareT(v, y.(map[uint16]uint16), o)
 	case map[uint16]uint32:
-		return mapCompareT(v, y.(map[uint16]uint32))
+		return mapCompareT(v, y.(map[uint16]uint32), o)
 	case map[uint16]uint64:
-		return mapCompareT(v, y.(map[uint16]uint64))
+		return mapCompareT(v, y.(map[uint16]uint64), o)
 	case map[uint16]float32:
-		return mapCompareT(v, y.(map[uint16]float32))
+		return mapCompareT(v, y.(map[uint16]float32), o)
 	case map[uint16]float64:
-		return mapCompareT(v, y.(map[uint16]float64))
+		return mapCompareT(v, y.(map[uint16]float64), o)
 	case map[uint16]complex64:
-		return mapCompareT(v, y.(map[uint16]complex64))
+		return mapCompareT(v, y.(map[uint16]complex64), o)
 	case map[uint16]complex128:
-		return mapCompareT(v, y.(map[uint16]complex128))
+		return mapCompareT(v, y.(map[uint16]complex128), o)
 	case map[uint16]interface{}:
-		return mapCompareT(v, y.(map[uint16]interface{}))
+		return mapCompareT(v, y.(map[uint16]interface{}), o)
 	case map[uint32]string:
-		return mapCompareT(v, y.(map[uint32]string))
+		return mapCompareT(v, y.(map[uint32]string), o)
 	case map[uint32]bool:
-		return mapCompareT(v, y.(map[uint32]bool))
+		return mapCompareT(v, y.(map[uint32]bool), o)
 	case map[uint32]int:
-		return mapCompareT(v, y.(map[uint32]int))
+		return mapCompareT(v, y.(map[uint32]int), o)
 	case map[uint32]int8:
-		return mapCompareT(v, y.(map[uint32]int8))
+		return mapCompareT(v, y.(map[uint32]int8), o)
 	case map[uint32]int16:
-		return mapCompareT(v, y.(map[uint32]int16))
+		return mapCompareT(v, y.(map[uint32]int16), o)
 	case map[uint32]int32:
-		return mapCompareT(v, y.(map[uint32]int32))
+		return mapCompareT(v, y.(map[uint32]int32), o)
 	case map[uint32]int64:
-		return mapCompareT(v, y.(map[uint32]int64))
+		return mapCompareT(v, y.(map[uint32]int64), o)
 	case map[uint32]uint:
-		return mapCompareT(v, y.(map[uint32]uint))
+		return mapCompareT(v, y.(map[uint32]uint), o)
 	case map[uint32]uint8:
-		return mapCompareT(v, y.(map[uint32]uint8))
+		return mapCompareT(v, y.(map[uint32]uint8), o)
 	case map[uint32]uint16:
-		return mapCompareT(v, y.(map[uint32]uint16))
+		return mapCompareT(v, y.(map[uint32]uint16), o)
 	case map[uint32]uint32:
-		return mapCompareT(v, y.(map[uint32]uint32))
+		return mapCompareT(v, y.(map[uint32]uint32), o)
 	case map[uint32]uint64:
-		return mapCompareT(v, y.(map[uint32]uint64))
+		return mapCompareT(v, y.(map[uint32]uint64), o)
 	case map[uint32]float32:
-		return mapCompareT(v, y.(map[uint32]float32))
+		return mapCompareT(v, y.(map[uint32]float32), o)
 	case map[uint32]float64:
-		return mapCompareT(v, y.(map[uint32]float64))
+		return mapCompareT(v, y.(map[uint32]float64), o)
 	case map[uint32]complex64:
-		return mapCompareT(v, y.(map[uint32]complex64))
+		return mapCompareT(v, y.(map[uint32]complex64), o)
 	case map[uint32]complex128:
-		return mapCompareT(v, y.(map[uint32]complex128))
+		return mapCompareT(v, y.(map[uint32]complex128), o)
 	case map[uint32]interface{}:
-		return mapCompareT(v, y.(map[uint32]interface{}))
+		return mapCompareT(v, y.(map[uint32]interface{}), o)
 	case map[uint64]string:
-		return mapCompareT(v, y.(map[uint64]string))
+		return mapCompareT(v, y.(map[uint64]string), o)
 	case map[uint64]bool:
-		return mapCompareT(v, y.(map[uint64]bool))
+		return mapCompareT(v, y.(map[uint64]bool), o)
 	case map[uint64]int:
-		return mapCompareT(v, y.(map[uint64]int))
+		return mapCompareT(v, y.(map[uint64]int), o)
 	case map[uint64]int8:
-		return mapCompareT(v, y.(map[uint64]int8))
+		return mapCompareT(v, y.(map[uint64]int8), o)
 	case map[uint64]int16:
-		return mapCompareT(v, y.(map[uint64]int16))
+		return mapCompareT(v, y.(map[uint64]int16), o)
 	case map[uint64]int32:
-		return mapCompareT(v, y.(map[uint64]int32))
+		return mapCompareT(v, y.(map[uint64]int32), o)
 	case map[uint64]int64:
-		return mapCompareT(v, y.(map[uint64]int64))
+		return mapCompareT(v, y.(map[uint64]int64), o)
 	case map[uint64]uint:
-		return mapCompareT(v, y.(map[uint64]uint))
+		return mapCompareT(v, y.(map[uint64]uint), o)
 	case map[uint64]uint8:
-		return mapCompareT(v, y.(map[uint64]uint8))
+		return mapCompareT(v, y.(map[uint64]uint8), o)
 	case map[uint64]uint16:
-		return mapCompareT(v, y.(map[uint64]uint16))
+		return mapCompareT(v, y.(map[uint64]uint16), o)
 	case map[uint64]uint32:
-		return mapCompareT(v, y.(map[uint64]uint32))
+		return mapCompareT(v, y.(map[uint64]uint32), o)
 	case map[uint64]uint64:
-		return mapCompareT(v, y.(map[uint64]uint64))
+		return mapCompareT(v, y.(map[uint64]uint64), o)
 	case map[uint64]float32:
-		return mapCompareT(v, y.(map[uint64]float32))
+		return mapCompareT(v, y.(map[uint64]float32), o)
 	case map[uint64]float64:
-		return mapCompareT(v, y.(map[uint64]float64))
+		return mapCompareT(v, y.(map[uint64]float64), o)
 	case map[uint64]complex64:
-		return mapCompareT(v, y.(map[uint64]complex64))
+		return mapCompareT(v, y.(map[uint64]complex64), o)
 	case map[uint64]complex128:
-		return mapCompareT(v, y.(map[uint64]complex128))
+		return mapCompareT(v, y.(map[uint64]complex128), o)
 	case map[uint64]interface{}:
-		return mapCompareT(v, y.(map[uint64]interface{}))
+		return mapCompareT(v, y.(map[uint64]interface{}), o)
 	case map[float32]string:
-		return mapCompareT(v, y.(map[float32]string))
+		return mapCompareT(v, y.(map[float32]string), o)
 	case map[float32]bool:
-		return mapCompareT(v, y.(map[float32]bool))
+		return mapCompareT(v, y.(map[float32]bool), o)
 	case map[float32]int:
-		return mapCompareT(v, y.(map[float32]int))
+		return mapCompareT(v, y.(map[float32]int), o)
 	case map[float32]int8:
-		return mapCompareT(v, y.(map[float32]int8))
+		return mapCompareT(v, y.(map[float32]int8), o)
 	case map[float32]int16:
-		return mapCompareT(v, y.(map[float32]int16))
+		return mapCompareT(v, y.(map[float32]int16), o)
 	case map[float32]int32:
-		return mapCompareT(v, y.(map[float32]int32))
+		return mapCompareT(v, y.(map[float32]int32), o)
 	case map[float32]int64:
-		return mapCompareT(v, y.(map[float32]int64))
+		return mapCompareT(v, y.(map[float32]int64), o)
 	case map[float32]uint:
-		return mapCompareT(v, y.(map[float32]uint))
+		return mapCompareT(v, y.(map[float32]uint), o)
 	case map[float32]uint8:
-		return mapCompareT(v, y.(map[float32]uint8))
+		return mapCompareT(v, y.(map[float32]uint8), o)
 	case map[float32]uint16:
-		return mapCompareT(v, y.(map[float32]uint16))
+		return mapCompareT(v, y.(map[float32]uint16), o)
 	case map[float32]uint32:
-		return mapCompareT(v, y.(map[float32]uint32))
+		return mapCompareT(v, y.(map[float32]uint32), o)
 	case map[float32]uint64:
-		return mapCompareT(v, y.(map[float32]uint64))
+		return mapCompareT(v, y.(map[float32]uint64), o)
 	case map[float32]float32:
-		return mapCompareT(v, y.(map[float32]float32))
+		return mapCompareT(v, y.(map[float32]float32), o)
 	case map[float32]float64:
-		return mapCompareT(v, y.(map[float32]float64))
+		return mapCompareT(v, y.(map[float32]float64), o)
 	case map[float32]complex64:
-		return mapCompareT(v, y.(map[float32]complex64))
+		return mapCompareT(v, y.(map[float32]complex64), o)
 	case map[float32]complex128:
-		return mapCompareT(v, y.(map[float32]complex128))
+		return mapCompareT(v, y.(map[float32]complex128), o)
 	case map[float32]interface{}:
-		return mapCompareT(v, y.(map[float32]interface{}))
+		return mapCompareT(v, y.(map[float32]interface{}), o)
 	case map[float64]string:
-		return mapCompareT(v, y.(map[float64]string))
+		return mapCompareT(v, y.(map[float64]string), o)
 	case map[float64]bool:
-		return mapCompareT(v, y.(map[float64]bool))
+		return mapCompareT(v, y.(map[float64]bool), o)
 	case map[float64]int:
-		return mapCompareT(v, y.(map[float64]int))
+		return mapCompareT(v, y.(map[float64]int), o)
 	case map[float64]int8:
-		return mapCompareT(v, y.(map[float64]int8))
+		return mapCompareT(v, y.(map[float64]int8), o)
 	case map[float64]int16:
-		return mapCompareT(v, y.(map[float64]int16))
+		return mapCompareT(v, y.(map[float64]int16), o)
 	case map[float64]int32:
-		return mapCompareT(v, y.(map[float64]int32))
+		return mapCompareT(v, y.(map[float64]int32), o)
 	case map[float64]int64:
-		return mapCompareT(v, y.(map[float64]int64))
+		return mapCompareT(v, y.(map[float64]int64), o)
 	case map[float64]uint:
-		return mapCompareT(v, y.(map[float64]uint))
+		return mapCompareT(v, y.(map[float64]uint), o)
 	case map[float64]uint8:
-		return mapCompareT(v, y.(map[float64]uint8))
+		return mapCompareT(v, y.(map[float64]uint8), o)
 	case map[float64]uint16:
-		return mapCompareT(v, y.(map[float64]uint16))
+		return mapCompareT(v, y.(map[float64]uint16), o)
 	case map[float64]uint32:
-		return mapCompareT(v, y.(map[float64]uint32))
+		return mapCompareT(v, y.(map[float64]uint32), o)
 	case map[float64]uint64:
-		return mapCompareT(v, y.(map[float64]uint64))
+		return mapCompareT(v, y.(map[float64]uint64), o)
 	case map[float64]float32:
-		return mapCompareT(v, y.(map[float64]float32))
+		return mapCompareT(v, y.(map[float64]float32), o)
 	case map[float64]float64:
-		return mapCompareT(v, y.(map[float64]float64))
+		return mapCompareT(v, y.(map[float64]float64), o)
 	case map[float64]complex64:
-		return mapCompareT(v, y.(map[float64]complex64))
+		return mapCompareT(v, y.(map[float64]complex64), o)
 	case map[float64]complex128:
-		return mapCompareT(v, y.(map[float64]complex128))
+		return mapCompareT(v, y.(map[float64]complex128), o)
 	case map[float64]interface{}:
-		return mapCompareT(v, y.(map[float64]interface{}))
+		return mapCompareT(v, y.(map[float64]interface{}), o)
 	case map[complex64]string:
-		return mapCompareT(v, y.(map[complex64]string))
+		return mapCompareT(v, y.(map[complex64]string), o)
 	case map[complex64]bool:
-		return mapCompareT(v, y.(map[complex64]bool))
+		return mapCompareT(v, y.(map[complex64]bool), o)
 	case map[complex64]int:
-		return mapCompareT(v, y.(map[complex64]int))
+		return mapCompareT(v, y.(map[complex64]int), o)
 	case map[complex64]int8:
-		return mapCompareT(v, y.(map[complex64]int8))
+		return mapCompareT(v, y.(map[complex64]int8), o)
 	case map[complex64]int16:
-		return mapCompareT(v, y.(map[complex64]int16))
+		return mapCompareT(v, y.(map[complex64]int16), o)
 	case map[complex64]int32:
-		return mapCompareT(v, y.(map[complex64]int32))
+		return mapCompareT(v, y.(map[complex64]int32), o)
 	case map[complex64]int64:
-		return mapCompareT(v, y.(map[complex64]int64))
+		return mapCompareT(v, y.(map[complex64]int64), o)
 	case map[complex64]uint:
-		return mapCompareT(v, y.(map[complex64]uint))
+		return mapCompareT(v, y.(map[complex64]uint), o)
 	case map[complex64]uint8:
-		return mapCompareT(v, y.(map[complex64]uint8))
+		return mapCompareT(v, y.(map[complex64]uint8), o)
 	case map[complex64]uint16:
-		return mapCompareT(v, y.(map[complex64]uint16))
+		return mapCompareT(v, y.(map[complex64]uint16), o)
 	case map[complex64]uint32:
-		return mapCompareT(v, y.(map[complex64]uint32))
+		return mapCompareT(v, y.(map[complex64]uint32), o)
 	case map[complex64]uint64:
-		return mapCompareT(v, y.(map[complex64]uint64))
+		return mapCompareT(v, y.(map[complex64]uint64), o)
 	case map[complex64]float32:
-		return mapCompareT(v, y.(map[complex64]float32))
+		return mapCompareT(v, y.(map[complex64]float32), o)
 	case map[complex64]float64:
-		return mapCompareT(v, y.(map[complex64]float64))
+		return mapCompareT(v, y.(map[complex64]float64), o)
 	case map[complex64]complex64:
-		return mapCompareT(v, y.(map[complex64]complex64))
+		return mapCompareT(v, y.(map[complex64]complex64), o)
 	case map[complex64]complex128:
-		return mapCompareT(v, y.(map[complex64]complex128))
+		return mapCompareT(v, y.(map[complex64]complex128), o)
 	case map[complex64]interface{}:
-		return mapCompareT(v, y.(map[complex64]interface{}))
+		return mapCompareT(v, y.(map[complex64]interface{}), o)
 	case map[complex128]string:
-		return mapCompareT(v, y.(map[complex128]string))
+		return mapCompareT(v, y.(map[complex128]string), o)
 	case map[complex128]bool:
-		return mapCompareT(v, y.(map[complex128]bool))
+		return mapCompareT(v, y.(map[complex128]bool), o)
 	case map[complex128]int:
-		return mapCompareT(v, y.(map[complex128]int))
+		return mapCompareT(v, y.(map[complex128]int), o)
 	case map[complex128]int8:
-		return mapCompareT(v, y.(map[complex128]int8))
+		return mapCompareT(v, y.(map[complex128]int8), o)
 	case map[complex128]int16:
-		return mapCompareT(v, y.(map[complex128]int16))
+		return mapCompareT(v, y.(map[complex128]int16), o)
 	case map[complex128]int32:
-		return mapCompareT(v, y.(map[complex128]int32))
+		return mapCompareT(v, y.(map[complex128]int32), o)
 	case map[complex128]int64:
-		return mapCompareT(v, y.(map[complex128]int64))
+		return mapCompareT(v, y.(map[complex128]int64), o)
 	case map[complex128]uint:
-		return mapCompareT(v, y.(map[complex128]uint))
+		return mapCompareT(v, y.(map[complex128]uint), o)
 	case map[complex128]uint8:
-		return mapCompareT(v, y.(map[complex128]uint8))
+		return mapCompareT(v, y.(map[complex128]uint8), o)
 	case map[complex128]uint16:
-		return mapCompareT(v, y.(map[complex128]uint16))
+		return mapCompareT(v, y.(map[complex128]uint16), o)
 	case map[complex128]uint32:
-		return mapCompareT(v, y.(map[complex128]uint32))
+		return mapCompareT(v, y.(map[complex128]uint32), o)
 	case map[complex128]uint64:
-		return mapCompareT(v, y.(map[complex128]uint64))
+		return mapCompareT(v, y.(map[complex128]uint64), o)
 	case map[complex128]float32:
-		return mapCompareT(v, y.(map[complex128]float32))
+		return mapCompareT(v, y.(map[complex128]float32), o)
 	case map[complex128]float64:
-		return mapCompareT(v, y.(map[complex128]float64))
+		return mapCompareT(v, y.(map[complex128]float64), o)
 	case map[complex128]complex64:
-		return mapCompareT(v, y.(map[complex128]complex64))
+		return mapCompareT(v, y.(map[complex128]complex64), o)
 	case map[complex128]complex128:
-		return mapCompareT(v, y.(map[complex128]complex128))
+		return mapCompareT(v, y.(map[complex128]complex128), o)
 	case map[complex128]interface{}:
-		return mapCompareT(v, y.(map[complex128]interface{}))
+		return mapCompareT(v, y.(map[complex128]interface{}), o)
 	case map[interface{}]string:
-		return mapCompareT(v, y.(map[interface{}]string))
+		return mapCompareT(v, y.(map[interface{}]string), o)
 	case map[interface{}]bool:
-		return mapCompareT(v, y.(map[interface{}]bool))
+		return mapCompareT(v, y.(map[interface{}]bool), o)
 	case map[interface{}]int:
-		return mapCompareT(v, y.(map[interface{}]int))
+		return mapCompareT(v, y.(map[interface{}]int), o)
 	case map[interface{}]int8:
-		return mapCompareT(v, y.(map[interface{}]int8))
+		return mapCompareT(v, y.(map[interface{}]int8), o)
 	case map[interface{}]int16:
-		return mapCompareT(v, y.(map[interface{}]int16))
+		return mapCompareT(v, y.(map[interface{}]int16), o)
 	case map[interface{}]int32:
-		return mapCompareT(v, y.(map[interface{}]int32))
+		return mapCompareT(v, y.(map[interface{}]int32), o)
 	case map[interface{}]int64:
-		return mapCompareT(v, y.(map[interface{}]int64))
+		return mapCompareT(v, y.(map[interface{}]int64), o)
 	case map[interface{}]uint:
-		return mapCompareT(v, y.(map[interface{}]uint))
+		return mapCompareT(v, y.(map[interface{}]uint), o)
 	case map[interface{}]uint8:
-		return mapCompareT(v, y.(map[interface{}]uint8))
+		return mapCompareT(v, y.(map[interface{}]uint8), o)
 	case map[interface{}]uint16:
-		return mapCompareT(v, y.(map[interface{}]uint16))
+		return mapCompareT(v, y.(map[interface{}]uint16), o)
 	case map[interface{}]uint32:
-		return mapCompareT(v, y.(map[interface{}]uint32))
+		return mapCompareT(v, y.(map[interface{}]uint32), o)
 	case map[interface{}]uint64:
-		return mapCompareT(v, y.(map[interface{}]uint64))
+		return mapCompareT(v, y.(map[interface{}]uint64), o)
 	case map[interface{}]float32:
-		return mapCompareT(v, y.(map[interface{}]float32))
+		return mapCompareT(v, y.(map[interface{}]float32), o)
 	case map[interface{}]float64:
-		return mapCompareT(v, y.(map[interface{}]float64))
+		return mapCompareT(v, y.(map[interface{}]float64), o)
 	case map[interface{}]complex64:
-		return mapCompareT(v, y.(map[interface{}]complex64))
+		return mapCompareT(v, y.(map[interface{}]complex64), o)
 	case map[interface{}]complex128:
-		return mapCompareT(v, y.(map[interface{}]complex128))
+		return mapCompareT(v, y.(map[interface{}]complex128), o)
 	case map[interface{}]interface{}:
-		return mapCompareT(v, y.(map[interface{}]interface{}))
+		return mapCompareT(v, y.(map[interface{}]interface{}), o)
 
 	}
-	return invalid, nil
+	// 值类型为自定义类型, 按照键值逐一比较
+	return compareMap(a, b, va, vb, o)
 }
@@ -0,0 +1,117 @@
+package comparator
+
+import (
+	"testing"
+	"time"
+)
+
+/**
+ *
+ * @Author AiTao
+ * @Date 2026-10-17 14:10
+ * @Url
+ **/
+
+type listNode struct {
+	Value      int
+	Prev, Next *listNode
+}
+
+// newList 函数用于创建一个双向循环链表.
+func newList(values ...int) *listNode {
+	head := &listNode{Value: values[0]}
+	head.Prev, head.Next = head, head
+	for _, v := range values[1:] {
+		n := &listNode{Value: v, Prev: head.Prev, Next: head}
+		head.Prev.Next = n
+		head.Prev = n
+	}
+	return head
+}
+
+type treeNode struct {
+	Name     string
+	Parent   *treeNode
+	Children []*treeNode
+}
+
+func newTree(names ...string) *treeNode {
+	root := &treeNode{Name: "root"}
+	for _, name := range names {
+		root.Children = append(root.Children, &treeNode{Name: name, Parent: root})
+	}
+	return root
+}
+
+type graphNode struct {
+	ID    int
+	Edges map[int]*graphNode
+}
+
+func newGraph(weight int) *graphNode {
+	a, b, c := &graphNode{ID: 1}, &graphNode{ID: 2}, &graphNode{ID: weight}
+	a.Edges = map[int]*graphNode{2: b, 3: c}
+	b.Edges = map[int]*graphNode{1: a, 3: c}
+	c.Edges = map[int]*graphNode{1: a}
+	return a
+}
+
+func runWithTimeout(t *testing.T, name string, fn func()) {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		fn()
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("%s did not terminate", name)
+	}
+}
+
+func TestCompareCycles(t *testing.T) {
+	selfSlice1, selfSlice2 := []interface{}{1, nil}, []interface{}{1, nil}
+	selfSlice1[1], selfSlice2[1] = selfSlice1, selfSlice2
+	selfMap1, selfMap2 := map[string]interface{}{"a": 1}, map[string]interface{}{"a": 1}
+	selfMap1["self"], selfMap2["self"] = selfMap1, selfMap2
+
+	tests := []struct {
+		name string
+		a, b interface{}
+		want int
+	}{
+		{"list/equal", newList(1, 2, 3), newList(1, 2, 3), 0},
+		{"list/less", newList(1, 2, 3), newList(1, 2, 4), -1},
+		{"list/greater", newList(1, 5, 3), newList(1, 2, 3), 1},
+		{"tree/equal", newTree("a", "b"), newTree("a", "b"), 0},
+		{"tree/less", newTree("a", "b"), newTree("a", "c"), -1},
+		{"graph/equal", newGraph(3), newGraph(3), 0},
+		{"graph/greater", newGraph(4), newGraph(3), 1},
+		{"slice/self", selfSlice1, selfSlice2, 0},
+		{"map/self", selfMap1, selfMap2, 0},
+	}
+	for _, tt := range tests {
+		runWithTimeout(t, tt.name, func() {
+			if got := Compare(tt.a, tt.b); got != tt.want {
+				t.Errorf("%s: Compare() = %d, want %d", tt.name, got, tt.want)
+			}
+			if got := Equals(tt.a, tt.b); got != (tt.want == 0) {
+				t.Errorf("%s: Equals() = %v", tt.name, got)
+			}
+			if got := Diff(tt.a, tt.b); (len(got) == 0) != (tt.want == 0) {
+				t.Errorf("%s: Diff() = %v", tt.name, got)
+			}
+		})
+	}
+}
+
+func TestCompareMultiLevelPointer(t *testing.T) {
+	x, y := 1, 2
+	px, py := &x, &y
+	ppx, ppy := &px, &py
+	runWithTimeout(t, "pointer", func() {
+		if got := Compare(&ppx, &ppy); got != -1 {
+			t.Errorf("Compare(***int) = %d, want -1", got)
+		}
+	})
+}
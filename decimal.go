@@ -0,0 +1,117 @@
+package comparator
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/lmlat/go-comparator/typed"
+)
+
+/**
+ *
+ * @Author AiTao
+ * @Date 2026-10-21 09:40
+ * @Url
+ **/
+
+// Decimal 函数用于按照数值比较两个十进制数字符串(或 json.Number), 支持正负号、小数以及科学计数法,
+// 比较过程不会转换为浮点数, 因此不受精度的限制. 当参数不是合法的十进制数时触发 panic.
+//
+// Example:
+// Decimal("1.10", "1.1") 返回 0
+// Decimal("1e3", "999.999999999999999999") 返回 1
+// Decimal("-0", "0.0") 返回 0
+func Decimal(x, y interface{}) int {
+	a, b := parseDecimal(decimalArg(x)), parseDecimal(decimalArg(y))
+	if a.sign != b.sign {
+		return typed.Compare(a.sign, b.sign)
+	}
+	r := a.compareAbs(b)
+	if a.sign < 0 {
+		return -r
+	}
+	return r
+}
+
+func decimalArg(x interface{}) string {
+	switch v := x.(type) {
+	case string:
+		return v
+	case json.Number:
+		return string(v)
+	default:
+		panic(fmt.Sprintf("illegal argument: %T is not a decimal string", x))
+	}
+}
+
+// decimal 表示一个十进制数 sign * 0.digits * 10^exp, 其中 digits 不包含前导零与末尾的零, 值为0时 sign 为0.
+type decimal struct {
+	sign   int
+	digits string
+	exp    *big.Int
+}
+
+// parseDecimal 函数用于解析十进制数字符串, 格式为 [+-]digits[.digits][(e|E)[+-]digits], 整数部分与小数部分不能同时为空.
+func parseDecimal(s string) decimal {
+	d := decimal{sign: 1}
+	rest := strings.TrimSpace(s)
+	if rest != "" && (rest[0] == '+' || rest[0] == '-') {
+		if rest[0] == '-' {
+			d.sign = -1
+		}
+		rest = rest[1:]
+	}
+	mantissa, exponent := rest, ""
+	if i := strings.IndexAny(rest, "eE"); i >= 0 {
+		mantissa, exponent = rest[:i], rest[i+1:]
+		if exponent == "" {
+			panic(fmt.Sprintf("illegal argument: %q is not a decimal number", s))
+		}
+	}
+	intPart, fracPart, _ := strings.Cut(mantissa, ".")
+	if intPart == "" && fracPart == "" || !allDigits(intPart) || !allDigits(fracPart) {
+		panic(fmt.Sprintf("illegal argument: %q is not a decimal number", s))
+	}
+	d.exp = new(big.Int)
+	if exponent != "" {
+		if exponent[0] == '+' {
+			exponent = exponent[1:]
+		}
+		if _, ok := d.exp.SetString(exponent, 10); !ok || exponent == "" || exponent[0] == '+' {
+			panic(fmt.Sprintf("illegal argument: %q is not a decimal number", s))
+		}
+	}
+	// 去掉前导零与末尾的零, 并将小数点移动到第一个有效数字之前
+	digits := strings.TrimLeft(intPart+fracPart, "0")
+	shift := len(intPart) - (len(intPart) + len(fracPart) - len(digits))
+	d.digits = strings.TrimRight(digits, "0")
+	if d.digits == "" {
+		d.sign = 0
+		return d
+	}
+	d.exp.Add(d.exp, big.NewInt(int64(shift)))
+	return d
+}
+
+// compareAbs 函数用于比较两个十进制数的绝对值.
+func (d decimal) compareAbs(o decimal) int {
+	if d.sign == 0 {
+		return 0
+	}
+	if r := d.exp.Cmp(o.exp); r != 0 {
+		return r
+	}
+	// 数位从高到低比较, 较短的一方相当于在末尾补零
+	return typed.Compare(d.digits, o.digits)
+}
+
+func allDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if !isDigit(s[i]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,75 @@
+package comparator
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+/**
+ *
+ * @Author AiTao
+ * @Date 2026-10-21 09:40
+ * @Url
+ **/
+
+func TestDecimal(t *testing.T) {
+	tests := []struct {
+		x, y interface{}
+		want int
+	}{
+		{"1.10", "1.1", 0},
+		{"1", "1.0", 0},
+		{"001.500", "1.5", 0},
+		{"-0", "0.0", 0},
+		{"0e10", "-0.000", 0},
+		{".5", "0.5", 0},
+		{"5.", "5", 0},
+		{"1e3", "1000", 0},
+		{"1.5E+2", "150", 0},
+		{"12345e-2", "123.45", 0},
+		{"1e3", "999.999999999999999999", 1},
+		{"0.1", "0.09999999999999999999999999", 1},
+		{"123456789012345678901234567890", "123456789012345678901234567891", -1},
+		{"10", "9", 1},
+		{"-10", "-9", -1},
+		{"-1", "0", -1},
+		{"0", "0.0000001", -1},
+		{"+2", "2", 0},
+		{"1e-100000000000000000000", "0", 1},
+		{"1e100000000000000000000", "9e99999999999999999999", 1},
+		{json.Number("2.50"), "2.5", 0},
+	}
+	for _, tt := range tests {
+		if got := Decimal(tt.x, tt.y); got != tt.want {
+			t.Errorf("Decimal(%v, %v) = %d, want %d", tt.x, tt.y, got, tt.want)
+		}
+		if got := Decimal(tt.y, tt.x); got != -tt.want {
+			t.Errorf("Decimal(%v, %v) = %d, want %d", tt.y, tt.x, got, -tt.want)
+		}
+	}
+}
+
+func TestDecimalInvalid(t *testing.T) {
+	for _, s := range []string{"", "-", ".", "1e", "1e+", "1e++2", "1.2.3", "abc", "1,5", "0x10", "1e2.5"} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Decimal(%q) did not panic", s)
+				}
+			}()
+			Decimal(s, "0")
+		}()
+	}
+}
+
+func TestDecimalTag(t *testing.T) {
+	type price struct {
+		Amount string `compare:"using=decimal"`
+	}
+	if got := Compare(price{"10.0"}, price{"9.99"}); got != 1 {
+		t.Errorf("Compare(price) = %d, want 1", got)
+	}
+	if !Equals(price{"1.50"}, price{"1.5"}) {
+		t.Error("Equals(price) = false, want true")
+	}
+}
@@ -0,0 +1,351 @@
+package comparator
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+)
+
+/**
+ *
+ * @Author AiTao
+ * @Date 2026-10-16 14:20
+ * @Url
+ **/
+
+// DiffKind 表示差异的类别.
+type DiffKind int
+
+const (
+	Added       DiffKind = iota + 1 // 新值中新增的元素
+	Removed                         // 新值中被移除的元素
+	Changed                         // 同一位置上的值发生了变化
+	TypeChanged                     // 同一位置上的值类型发生了变化
+	Moved                           // 序列中的元素移动到了其它位置
+)
+
+// String 返回差异类别的字符串表示形式.
+func (k DiffKind) String() string {
+	switch k {
+	case Added:
+		return "Added"
+	case Removed:
+		return "Removed"
+	case Changed:
+		return "Changed"
+	case TypeChanged:
+		return "TypeChanged"
+	case Moved:
+		return "Moved"
+	default:
+		return "Unknown"
+	}
+}
+
+// Difference 描述了两个值在某个位置上的差异, Path 为该位置的访问路径(例如 .Orders[3].Items["sku"].Price),
+// Old、New 分别为该位置上的旧值与新值, 对于 Added 类别 Old 为 nil, 对于 Removed 类别 New 为 nil.
+// 对于 Moved 类别, From 为元素在旧值中的路径, Path 为元素在新值中的路径.
+type Difference struct {
+	Kind     DiffKind
+	Path     string
+	From     string
+	Old, New interface{}
+}
+
+// String 返回差异的字符串表示形式.
+func (d Difference) String() string {
+	path := d.Path
+	if path == "" {
+		path = "<root>"
+	}
+	switch d.Kind {
+	case Added:
+		return fmt.Sprintf("%v %s: %#v", d.Kind, path, d.New)
+	case Removed:
+		return fmt.Sprintf("%v %s: %#v", d.Kind, path, d.Old)
+	case TypeChanged:
+		return fmt.Sprintf("%v %s: %T -> %T", d.Kind, path, d.Old, d.New)
+	case Moved:
+		return fmt.Sprintf("%v %s -> %s: %#v", d.Kind, d.From, path, d.New)
+	default:
+		return fmt.Sprintf("%v %s: %#v -> %#v", d.Kind, path, d.Old, d.New)
+	}
+}
+
+// Diff 函数用于找出 a 与 b 之间的全部差异, 与 Equals 不同的是, Diff 在遇到不相等的元素后会继续比较剩余的元素.
+// 结构体按照字段逐一比较, map 按照键逐一比较(缺失的键记为 Added 或 Removed), 切片与数组按照下标逐一比较, 指针比较其指向的值.
+// 与 Compare 相同, 带有 compare:"-" 标签的字段不参与比较. 当 a 与 b 相等时返回 nil.
+//
+// Example:
+// Diff(map[string]int{"a": 1, "b": 2}, map[string]int{"a": 1, "c": 3})
+// 返回 [Removed ["b"]: 2, Added ["c"]: 3]
+func Diff(a, b interface{}) []Difference {
+	return diff(a, b, newOptions(nil))
+}
+
+// DiffWith 函数与 Diff 函数相同, 通过 opts 可以配置比较的行为, 对于同样的选项, DiffWith 返回 nil 当且仅当 EqualsWith 返回 true.
+//
+// Example:
+// DiffWith(u1, u2, IgnoreFields("User.UpdatedAt"), EquateEmpty())
+func DiffWith(a, b interface{}, opts ...Option) []Difference {
+	return diff(a, b, newOptions(opts))
+}
+
+// DiffSequence 函数与 DiffWith 函数相同, 区别在于切片与数组使用 Myers 差异算法进行比较, 能够识别元素的插入、删除与移动,
+// 而不是按照下标逐一比较. 例如在切片头部插入一个元素时, 仅返回一个 Added 差异.
+// 其中 Removed 差异的路径为元素在旧值中的下标, Added、Changed 差异的路径为元素在新值中的下标.
+func DiffSequence(a, b interface{}, opts ...Option) []Difference {
+	o := newOptions(opts)
+	o.sequence = true
+	return diff(a, b, o)
+}
+
+func diff(a, b interface{}, o *options) []Difference {
+	var ds []Difference
+	diffValue(&ds, "", reflect.ValueOf(a), reflect.ValueOf(b), o)
+	return ds
+}
+
+func diffValue(ds *[]Difference, path string, va, vb reflect.Value, o *options) {
+	// 比较接口中保存的动态值
+	if va.Kind() == reflect.Interface {
+		va = va.Elem()
+	}
+	if vb.Kind() == reflect.Interface {
+		vb = vb.Elem()
+	}
+	if !va.IsValid() || !vb.IsValid() {
+		if o1, o2 := va.IsValid(), vb.IsValid(); o1 && !o2 {
+			*ds = append(*ds, Difference{Kind: Removed, Path: path, Old: interfaceOf(va)})
+		} else if !o1 && o2 {
+			*ds = append(*ds, Difference{Kind: Added, Path: path, New: interfaceOf(vb)})
+		}
+		return
+	}
+	if va.Type() != vb.Type() {
+		*ds = append(*ds, Difference{Kind: TypeChanged, Path: path, Old: interfaceOf(va), New: interfaceOf(vb)})
+		return
+	}
+	// 注册了比较器的类型以及网络地址类型作为一个整体进行比较
+	if _, ok := o.lookup(va.Type()); (ok || isNetType(va.Type())) && va.CanInterface() {
+		diffLeaf(ds, path, va, vb, o)
+		return
+	}
+	switch va.Kind() {
+	case reflect.Pointer:
+		if va.IsNil() || vb.IsNil() {
+			if va.IsNil() != vb.IsNil() {
+				diffChanged(ds, path, va, vb)
+			}
+			return
+		}
+		if va.UnsafePointer() == vb.UnsafePointer() {
+			return
+		}
+		// 循环引用的指针对不再继续比较
+		k, ok := o.visit(va, vb)
+		if !ok {
+			return
+		}
+		defer o.unvisit(k)
+		diffValue(ds, path, va.Elem(), vb.Elem(), o)
+	case reflect.Struct:
+		spec := structSpecOf(va.Type())
+		if isLeafStruct(va) || spec.err != nil {
+			diffLeaf(ds, path, va, vb, o)
+			return
+		}
+		if !o.enter() {
+			return
+		}
+		defer o.leave()
+		t := va.Type()
+		for i, n := 0, va.NumField(); i < n; i++ {
+			// 与 Compare 相同, 忽略带有 compare:"-" 标签以及通过选项忽略的字段
+			f := spec.field(i)
+			if f == nil || o.ignoreField(t, t.Field(i)) {
+				continue
+			}
+			if f.using != "" {
+				// 使用具名比较器的字段作为一个整体进行比较
+				if r, _ := compareField(nil, nil, va.Field(i), vb.Field(i), f, o); r != equal {
+					diffChanged(ds, path+"."+f.name, va.Field(i), vb.Field(i))
+				}
+				continue
+			}
+			diffValue(ds, path+"."+f.name, va.Field(i), vb.Field(i), o)
+		}
+	case reflect.Map:
+		if va.IsNil() != vb.IsNil() && !(o.equateEmpty && va.Len() == 0 && vb.Len() == 0) {
+			diffChanged(ds, path, va, vb)
+			return
+		}
+		k, ok := o.visit(va, vb)
+		if !ok {
+			return
+		}
+		defer o.unvisit(k)
+		if !o.enter() {
+			return
+		}
+		defer o.leave()
+		for _, k := range sortedMapKeys(va, vb, o) {
+			diffValue(ds, path+keySegment(k), mapEntry(va, k, o), mapEntry(vb, k, o), o)
+		}
+	case reflect.Slice:
+		if va.IsNil() != vb.IsNil() && !(o.equateEmpty && va.Len() == 0 && vb.Len() == 0) {
+			diffChanged(ds, path, va, vb)
+			return
+		}
+		k, ok := o.visit(va, vb)
+		if !ok {
+			return
+		}
+		defer o.unvisit(k)
+		diffArray(ds, path, va, vb, o)
+	case reflect.Array:
+		diffArray(ds, path, va, vb, o)
+	default:
+		diffLeaf(ds, path, va, vb, o)
+	}
+}
+
+// diffArray 函数用于比较切片或数组, 指定 IgnoreSliceOrder 选项时作为一个整体进行比较.
+func diffArray(ds *[]Difference, path string, va, vb reflect.Value, o *options) {
+	if o.ignoreSliceOrder {
+		diffLeaf(ds, path, va, vb, o)
+		return
+	}
+	if !o.enter() {
+		return
+	}
+	defer o.leave()
+	diffSequence(ds, path, va, vb, o)
+}
+
+// diffSequence 函数用于按照下标逐一比较切片或数组中的元素, 超出较短一方长度的元素记为 Added 或 Removed.
+// 当 o.sequence 为 true 时, 使用 Myers 差异算法进行比较.
+func diffSequence(ds *[]Difference, path string, va, vb reflect.Value, o *options) {
+	if o.sequence {
+		diffMyers(ds, path, va, vb, o)
+		return
+	}
+	x, y := va.Len(), vb.Len()
+	for i := 0; i < x || i < y; i++ {
+		switch {
+		case i >= y:
+			*ds = append(*ds, Difference{Kind: Removed, Path: path + indexSegment(i), Old: interfaceOf(va.Index(i))})
+		case i >= x:
+			*ds = append(*ds, Difference{Kind: Added, Path: path + indexSegment(i), New: interfaceOf(vb.Index(i))})
+		default:
+			diffValue(ds, path+indexSegment(i), va.Index(i), vb.Index(i), o)
+		}
+	}
+}
+
+// diffMyers 函数用于基于 Myers 差异算法比较切片或数组中的元素. 连续的删除操作与插入操作会按照位置两两配对,
+// 并继续比较配对元素内部的差异, 剩余的操作记为 Removed 或 Added.
+func diffMyers(ds *[]Difference, path string, va, vb reflect.Value, o *options) {
+	edits := diffIndices(va.Len(), vb.Len(), func(i, j int) bool {
+		r, _ := reflectCompareValue(nil, nil, va.Index(i), vb.Index(j), true, o)
+		return r == equal
+	})
+	for i := 0; i < len(edits); {
+		e := edits[i]
+		switch e.Kind {
+		case Move:
+			*ds = append(*ds, Difference{Kind: Moved, Path: path + indexSegment(e.NewIndex), From: path + indexSegment(e.OldIndex),
+				Old: interfaceOf(va.Index(e.OldIndex)), New: interfaceOf(vb.Index(e.NewIndex))})
+			i++
+		case Insert:
+			*ds = append(*ds, Difference{Kind: Added, Path: path + indexSegment(e.NewIndex), New: interfaceOf(vb.Index(e.NewIndex))})
+			i++
+		case Delete:
+			// 统计连续的删除操作以及紧随其后的插入操作
+			j := i
+			for j < len(edits) && edits[j].Kind == Delete {
+				j++
+			}
+			k := j
+			for k < len(edits) && edits[k].Kind == Insert {
+				k++
+			}
+			for n := 0; n < j-i; n++ {
+				old := edits[i+n].OldIndex
+				if n < k-j {
+					diffValue(ds, path+indexSegment(edits[j+n].NewIndex), va.Index(old), vb.Index(edits[j+n].NewIndex), o)
+				} else {
+					*ds = append(*ds, Difference{Kind: Removed, Path: path + indexSegment(old), Old: interfaceOf(va.Index(old))})
+				}
+			}
+			for n := j - i; n < k-j; n++ {
+				idx := edits[j+n].NewIndex
+				*ds = append(*ds, Difference{Kind: Added, Path: path + indexSegment(idx), New: interfaceOf(vb.Index(idx))})
+			}
+			i = k
+		}
+	}
+}
+
+// diffLeaf 函数用于比较无需继续展开的值, 不相等时记为 Changed.
+func diffLeaf(ds *[]Difference, path string, va, vb reflect.Value, o *options) {
+	var r int
+	switch va.Kind() {
+	case reflect.Chan, reflect.Func, reflect.UnsafePointer:
+		if va.Pointer() == vb.Pointer() {
+			r = equal
+		}
+	case reflect.Uintptr:
+		r, _ = reflectComparePrimitiveValue(va, vb, o)
+	default:
+		r, _ = reflectCompareValue(nil, nil, va, vb, true, o)
+	}
+	if r != equal {
+		diffChanged(ds, path, va, vb)
+	}
+}
+
+func diffChanged(ds *[]Difference, path string, va, vb reflect.Value) {
+	*ds = append(*ds, Difference{Kind: Changed, Path: path, Old: interfaceOf(va), New: interfaceOf(vb)})
+}
+
+// isLeafStruct 函数用于判断结构体是否需要作为一个整体进行比较, 例如 time.Time、big.Int 以及实现了 Iface、Comparer 接口的类型.
+func isLeafStruct(v reflect.Value) bool {
+	if isBigValue(v.Type()) || v.Type() == timeType {
+		return true
+	}
+	if !v.CanInterface() {
+		return false
+	}
+	if _, ok := comparerMethod(v.Type()); ok {
+		return true
+	}
+	switch v.Interface().(type) {
+	case time.Time, Iface:
+		return true
+	default:
+		return false
+	}
+}
+
+// sortedMapKeys 函数用于获取两个 map 中全部键的并集, 并按照键的大小进行排序, 保证输出结果的顺序稳定.
+// 通过 IgnoreMapEntries 选项忽略的键值对不包含在内.
+func sortedMapKeys(va, vb reflect.Value, o *options) []reflect.Value {
+	keys := filterMapKeys(va, va.MapKeys(), o)
+	for _, k := range filterMapKeys(vb, vb.MapKeys(), o) {
+		if !mapEntry(va, k, o).IsValid() {
+			keys = append(keys, k)
+		}
+	}
+	sortMapKeys(keys, o)
+	return keys
+}
+
+// mapEntry 函数用于获取 map 中键 k 对应的值, 键不存在或者键值对被 IgnoreMapEntries 选项忽略时返回无效的 reflect.Value.
+func mapEntry(v reflect.Value, k reflect.Value, o *options) reflect.Value {
+	x := v.MapIndex(k)
+	if x.IsValid() && len(o.ignoreMapEntries) > 0 && o.ignoreEntry(interfaceOf(k), interfaceOf(x)) {
+		return reflect.Value{}
+	}
+	return x
+}
@@ -0,0 +1,113 @@
+package comparator
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+/**
+ *
+ * @Author AiTao
+ * @Date 2026-10-16 14:52
+ * @Url
+ **/
+
+type address struct {
+	City string
+	Zip  *string
+}
+
+type person struct {
+	Name     string
+	Age      int
+	Tags     []string
+	Address  *address
+	Extra    map[string]interface{}
+	Birthday time.Time
+}
+
+func TestDiff(t *testing.T) {
+	zip := "100000"
+	day := time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC)
+	p1 := person{
+		Name:     "aitao",
+		Age:      18,
+		Tags:     []string{"go", "java"},
+		Address:  &address{City: "beijing", Zip: &zip},
+		Extra:    map[string]interface{}{"a": 1, "b": "x", "c": true},
+		Birthday: day,
+	}
+	p2 := person{
+		Name:     "aitao",
+		Age:      19,
+		Tags:     []string{"go", "rust", "c"},
+		Address:  &address{City: "shanghai", Zip: &zip},
+		Extra:    map[string]interface{}{"a": "1", "c": true, "d": 4},
+		Birthday: day.Add(time.Hour),
+	}
+	want := []Difference{
+		{Kind: Changed, Path: ".Age", Old: 18, New: 19},
+		{Kind: Changed, Path: ".Tags[1]", Old: "java", New: "rust"},
+		{Kind: Added, Path: ".Tags[2]", New: "c"},
+		{Kind: Changed, Path: ".Address.City", Old: "beijing", New: "shanghai"},
+		{Kind: TypeChanged, Path: `.Extra["a"]`, Old: 1, New: "1"},
+		{Kind: Removed, Path: `.Extra["b"]`, Old: "x"},
+		{Kind: Added, Path: `.Extra["d"]`, New: 4},
+		{Kind: Changed, Path: ".Birthday", Old: day, New: day.Add(time.Hour)},
+	}
+	if got := Diff(p1, p2); !reflect.DeepEqual(got, want) {
+		t.Errorf("Diff() = %v\nwant %v", got, want)
+	}
+	if got := Diff(p1, p1); got != nil {
+		t.Errorf("Diff(p1, p1) = %v, want nil", got)
+	}
+}
+
+func TestDiffNil(t *testing.T) {
+	if got := Diff(nil, 1); len(got) != 1 || got[0].Kind != Added {
+		t.Errorf("Diff(nil, 1) = %v", got)
+	}
+	if got := Diff([]int(nil), []int{}); len(got) != 1 || got[0].Kind != Changed {
+		t.Errorf("Diff(nil slice, empty slice) = %v", got)
+	}
+	if got := Diff([3]int{1, 2, 3}, [3]int{1, 5, 3}); len(got) != 1 || got[0].Path != "[1]" {
+		t.Errorf("Diff(array) = %v", got)
+	}
+}
+
+func TestDiffWith(t *testing.T) {
+	type record struct {
+		ID      int
+		Name    string `compare:"using=foldcase"`
+		Cache   string `compare:"-"`
+		Tags    []string
+		Labels  map[string]string
+		Updated time.Time
+	}
+	r1 := record{ID: 1, Name: "Alice", Cache: "x", Labels: map[string]string{"a": "1", "_tmp": "x"}, Updated: time.Unix(1, 0)}
+	r2 := record{ID: 1, Name: "ALICE", Cache: "y", Tags: []string{}, Labels: map[string]string{"a": "1"}, Updated: time.Unix(2, 0)}
+	opts := []Option{
+		IgnoreFields("record.Updated"),
+		EquateEmpty(),
+		IgnoreMapEntries(func(k, v interface{}) bool { return k.(string)[0] == '_' }),
+	}
+	if got := DiffWith(r1, r2, opts...); got != nil {
+		t.Errorf("DiffWith() = %v, want nil", got)
+	}
+	if !EqualsWith(r1, r2, opts...) {
+		t.Error("EqualsWith() = false, want true")
+	}
+	// 带有 compare:"-" 标签以及使用具名比较器的字段与 Compare 的结果保持一致
+	want := []Difference{
+		{Kind: Changed, Path: ".Tags", Old: []string(nil), New: []string{}},
+		{Kind: Removed, Path: `.Labels["_tmp"]`, Old: "x"},
+		{Kind: Changed, Path: ".Updated", Old: r1.Updated, New: r2.Updated},
+	}
+	if got := Diff(r1, r2); !reflect.DeepEqual(got, want) {
+		t.Errorf("Diff() = %v\nwant %v", got, want)
+	}
+	if got := DiffSequence([]record{r1}, []record{r2}, opts...); got != nil {
+		t.Errorf("DiffSequence() = %v, want nil", got)
+	}
+}
@@ -9,6 +9,15 @@ package comparator
  **/
 
 func Equals(a, b interface{}) bool {
-	r, _ := compareValue(a, b, false)
+	r, _ := compareValue(a, b, false, newOptions(nil))
+	return r == equal
+}
+
+// EqualsWith 函数用于判断 a 与 b 是否相等, 通过 opts 可以配置比较的行为.
+//
+// Example:
+// EqualsWith(u1, u2, IgnoreFields("User.UpdatedAt"), EquateEmpty())
+func EqualsWith(a, b interface{}, opts ...Option) bool {
+	r, _ := compareValue(a, b, false, newOptions(opts))
 	return r == equal
 }
@@ -0,0 +1,162 @@
+package comparator
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+/**
+ *
+ * @Author AiTao
+ * @Date 2026-10-16 10:12
+ * @Url
+ **/
+
+var (
+	// ErrNil 表示参与比较的其中一个值为 nil.
+	ErrNil = errors.New("comparator: the parameter has a nil value")
+	// ErrTypeMismatch 表示参与比较的两个值类型不一致.
+	ErrTypeMismatch = errors.New("comparator: type mismatch")
+	// ErrIncomparable 表示两个值之间无法建立比较关系.
+	ErrIncomparable = errors.New("comparator: unable to establish a comparative relationship")
+	// ErrKeyMissing 表示参与比较的 map 中缺少对应的键.
+	ErrKeyMissing = errors.New("comparator: value mismatch, the key is missing")
+	// ErrInvalidTag 表示结构体字段上的 compare 标签无效.
+	ErrInvalidTag = errors.New("comparator: invalid struct tag")
+	// ErrInvalidOrderBy 表示排序规则无效, 例如引用了不存在的字段.
+	ErrInvalidOrderBy = errors.New("comparator: invalid order by")
+)
+
+// ErrorKind 表示比较错误的类别.
+type ErrorKind int
+
+const (
+	KindUnknown ErrorKind = iota
+	KindNil
+	KindTypeMismatch
+	KindIncomparable
+	KindKeyMissing
+	KindInvalidTag
+)
+
+// String 返回错误类别的字符串表示形式.
+func (k ErrorKind) String() string {
+	switch k {
+	case KindNil:
+		return "nil"
+	case KindTypeMismatch:
+		return "type mismatch"
+	case KindIncomparable:
+		return "incomparable"
+	case KindKeyMissing:
+		return "key missing"
+	case KindInvalidTag:
+		return "invalid tag"
+	default:
+		return "unknown"
+	}
+}
+
+// CompareError 描述了深度比较过程中出现的错误, 记录了出错位置的访问路径(例如 .Orders[3].Items["sku"].Price)、
+// 出错位置的两个值及其类型. CompareError 包装了 ErrNil、ErrTypeMismatch 等哨兵错误, 可以通过 errors.Is 进行判断.
+//
+// 当值来自结构体的不可导出字段时, A、B 中保存的是对应的 reflect.Value.
+type CompareError struct {
+	Path         string
+	A, B         interface{}
+	TypeA, TypeB reflect.Type
+	Kind         ErrorKind
+	Err          error
+}
+
+func (e *CompareError) Error() string {
+	if e.Path == "" {
+		return fmt.Sprintf("%v: %v (%v) vs %v (%v)", e.Err, e.A, e.TypeA, e.B, e.TypeB)
+	}
+	return fmt.Sprintf("%v at %s: %v (%v) vs %v (%v)", e.Err, e.Path, e.A, e.TypeA, e.B, e.TypeB)
+}
+
+func (e *CompareError) Unwrap() error {
+	return e.Err
+}
+
+// errorKind 函数用于获取哨兵错误对应的错误类别.
+func errorKind(e error) ErrorKind {
+	switch {
+	case errors.Is(e, ErrNil):
+		return KindNil
+	case errors.Is(e, ErrTypeMismatch):
+		return KindTypeMismatch
+	case errors.Is(e, ErrIncomparable):
+		return KindIncomparable
+	case errors.Is(e, ErrKeyMissing):
+		return KindKeyMissing
+	case errors.Is(e, ErrInvalidTag):
+		return KindInvalidTag
+	default:
+		return KindUnknown
+	}
+}
+
+// wrapError 函数用于在递归比较返回时为错误追加路径片段. 如果 e 已经是 *CompareError, 则将 segment 添加到路径的最前面;
+// 否则基于出错位置的两个值 va、vb 创建一个新的 *CompareError.
+func wrapError(e error, segment string, va, vb reflect.Value) error {
+	if e == nil {
+		return nil
+	}
+	if ce, ok := e.(*CompareError); ok {
+		ce.Path = segment + ce.Path
+		return ce
+	}
+	// 记录接口中保存的动态值及其类型
+	if va.Kind() == reflect.Interface && !va.IsNil() {
+		va = va.Elem()
+	}
+	if vb.Kind() == reflect.Interface && !vb.IsNil() {
+		vb = vb.Elem()
+	}
+	ce := &CompareError{Path: segment, A: interfaceOf(va), B: interfaceOf(vb), Kind: errorKind(e), Err: e}
+	if va.IsValid() {
+		ce.TypeA = va.Type()
+	}
+	if vb.IsValid() {
+		ce.TypeB = vb.Type()
+	}
+	return ce
+}
+
+// interfaceOf 函数用于安全地获取 reflect.Value 中保存的值, 对于无法访问的值(如不可导出字段)直接返回 reflect.Value 本身.
+func interfaceOf(v reflect.Value) interface{} {
+	if !v.IsValid() {
+		return nil
+	}
+	if v.CanInterface() {
+		return v.Interface()
+	}
+	return v
+}
+
+// pointerOf 函数用于获取指向 v 的可访问的指针, 可寻址的值直接获取其地址, 其余的值复制一份后再获取地址.
+// 无法访问的值(如不可导出字段)中只有 time.Time 以及 math/big 中的数值类型可以通过 copyUnexported 复制, 其余的返回 false.
+func pointerOf(v reflect.Value) (reflect.Value, bool) {
+	if !v.CanInterface() {
+		return copyUnexported(v)
+	}
+	if v.CanAddr() {
+		return v.Addr(), true
+	}
+	p := reflect.New(v.Type())
+	p.Elem().Set(v)
+	return p, true
+}
+
+// indexSegment 函数用于生成切片或数组元素的路径片段.
+func indexSegment(i int) string {
+	return fmt.Sprintf("[%d]", i)
+}
+
+// keySegment 函数用于生成 map 元素的路径片段.
+func keySegment(k interface{}) string {
+	return fmt.Sprintf("[%#v]", k)
+}
@@ -0,0 +1,362 @@
+package comparator
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+/**
+ *
+ * @Author AiTao
+ * @Date 2026-10-17 20:10
+ * @Url
+ **/
+
+// pathSegment 表示字段路径中的一段.
+type pathSegment struct {
+	name  string // 字段名或 json 标签名
+	json  bool   // 是否按照 json 标签名查找字段
+	index int    // 切片、数组的下标或 map 的整数键
+	key   string // map 的字符串键
+	kind  int
+}
+
+const (
+	segmentField = iota
+	segmentIndex // [0]
+	segmentKey   // ["key"]
+)
+
+// fieldPath 描述了一个通过字段路径构建的比较器.
+type fieldPath struct {
+	path     string
+	segments []pathSegment
+	nulls    int
+	cmp      Type
+	checked  sync.Map // 已校验的类型, 值为校验结果(error)
+}
+
+// FieldOption 用于配置 Field 函数返回的比较器.
+type FieldOption func(*fieldPath)
+
+// FieldNullsFirst 返回一个将 nil 值排在最前面的选项(默认).
+func FieldNullsFirst() FieldOption {
+	return func(f *fieldPath) { f.nulls = nullsFirst }
+}
+
+// FieldNullsLast 返回一个将 nil 值排在最后面的选项.
+func FieldNullsLast() FieldOption {
+	return func(f *fieldPath) { f.nulls = nullsLast }
+}
+
+// FieldUsing 返回一个使用 cmp 比较字段值的选项, 默认使用 Compare 函数进行深度比较.
+func FieldUsing(cmp Type) FieldOption {
+	return func(f *fieldPath) { f.cmp = cmp }
+}
+
+// Field 函数用于获取一个按照字段路径比较两个值的比较器, 路径由以下几种片段组成:
+//
+//	Address.City     结构体字段, 依次按照 Go 字段名、json 标签名、忽略大小写的 Go 字段名查找
+//	json:"zip_code"  按照 json 标签名查找结构体字段
+//	Tags[0]          切片或数组中指定下标的元素
+//	Meta["region"]   map 中指定键的值
+//
+// 路径上的指针、接口会被自动解除引用. 当路径上存在值为 nil 的指针、map, 或者下标越界、键不存在时, 该值被视为 nil,
+// nil 值默认排在最前面(可以通过 FieldNullsLast 选项修改). 字段值默认使用 Compare 函数进行深度比较.
+//
+// 当路径的格式无效时会发生 panic. 第一次比较某个类型的值时会按照该类型校验整个字段路径(不受路径上 nil 值的影响),
+// 路径无效时同样会发生 panic, panic 的值为包装了 ErrInvalidOrderBy 的 error. 需要在构建时校验路径请使用 ParseField.
+//
+// Example:
+// Field("Address.City")
+// Field(`Orders[0].Items["sku"].Price`, FieldNullsLast())
+func Field(path string, opts ...FieldOption) Type {
+	f, err := newFieldPath(path, opts)
+	if err != nil {
+		panic(err)
+	}
+	return f.compare
+}
+
+// ParseField 函数与 Field 相同, 区别在于构建比较器时按照 sample 的类型校验整个字段路径,
+// 路径无效时返回 ErrInvalidOrderBy 错误, 而不是发生 panic.
+//
+// Example:
+// ParseField("Address.City", Contact{})
+func ParseField(path string, sample any, opts ...FieldOption) (Type, error) {
+	f, err := newFieldPath(path, opts)
+	if err != nil {
+		return nil, err
+	}
+	t := reflect.TypeOf(sample)
+	if t == nil {
+		return nil, fmt.Errorf("%w: sample must not be nil", ErrInvalidOrderBy)
+	}
+	if err = f.validate(t); err != nil {
+		return nil, err
+	}
+	return f.compare, nil
+}
+
+func newFieldPath(path string, opts []FieldOption) (*fieldPath, error) {
+	segments, err := parseFieldPath(path)
+	if err != nil {
+		return nil, err
+	}
+	f := &fieldPath{path: path, segments: segments, nulls: nullsFirst, cmp: Compare}
+	for _, opt := range opts {
+		opt(f)
+	}
+	return f, nil
+}
+
+func (f *fieldPath) compare(a, b any) int {
+	va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+	for _, v := range []reflect.Value{va, vb} {
+		if v.IsValid() {
+			if err := f.validate(v.Type()); err != nil {
+				panic(err)
+			}
+		}
+	}
+	x, y := f.resolve(va), f.resolve(vb)
+	if n1, n2 := !x.IsValid(), !y.IsValid(); n1 || n2 {
+		if n1 == n2 {
+			return 0
+		} else if n1 == (f.nulls == nullsFirst) {
+			return -1
+		}
+		return 1
+	}
+	if !x.CanInterface() || !y.CanInterface() {
+		panic(f.errorf("cannot access unexported value of %s", x.Type()))
+	}
+	return f.cmp(x.Interface(), y.Interface())
+}
+
+// validate 函数用于按照类型 t 校验整个字段路径, 校验结果按照类型缓存.
+func (f *fieldPath) validate(t reflect.Type) error {
+	if err, ok := f.checked.Load(t); ok {
+		err, _ := err.(error)
+		return err
+	}
+	err := f.check(t)
+	f.checked.Store(t, err)
+	return err
+}
+
+// check 函数用于按照类型 t 校验整个字段路径. 路径经过接口类型时, 之后的片段只能在比较时按照动态类型校验.
+func (f *fieldPath) check(t reflect.Type) error {
+	for i := range f.segments {
+		for t.Kind() == reflect.Pointer {
+			t = t.Elem()
+		}
+		s := &f.segments[i]
+		switch t.Kind() {
+		case reflect.Interface:
+			return nil
+		case reflect.Struct:
+			if s.kind != segmentField {
+				return f.errorf("cannot index %s", t)
+			}
+			index, ok := cachedField(t, s.name, s.json)
+			if !ok {
+				return f.errorf("unknown field %q in %s", s.name, t)
+			}
+			t = t.FieldByIndex(index).Type
+		case reflect.Slice, reflect.Array:
+			if s.kind != segmentIndex {
+				return f.errorf("%s has no field %q", t, s.name)
+			}
+			t = t.Elem()
+		case reflect.Map:
+			if s.kind == segmentField {
+				return f.errorf("%s has no field %q", t, s.name)
+			}
+			if _, err := f.mapKey(t.Key(), s); err != nil {
+				return err
+			}
+			t = t.Elem()
+		default:
+			return f.errorf("cannot access %s", t)
+		}
+	}
+	return nil
+}
+
+// errorf 函数用于生成包装了 ErrInvalidOrderBy 的路径错误.
+func (f *fieldPath) errorf(format string, args ...any) error {
+	return fmt.Errorf("%w: path %q: %s", ErrInvalidOrderBy, f.path, fmt.Sprintf(format, args...))
+}
+
+// resolve 函数用于获取 v 中按照字段路径访问的值, 当值不存在时返回无效的 reflect.Value.
+func (f *fieldPath) resolve(v reflect.Value) reflect.Value {
+	for i := range f.segments {
+		if v = indirectValue(v); !v.IsValid() {
+			return v
+		}
+		s := &f.segments[i]
+		switch v.Kind() {
+		case reflect.Struct:
+			if s.kind != segmentField {
+				panic(f.errorf("cannot index %s", v.Type()))
+			}
+			index, ok := cachedField(v.Type(), s.name, s.json)
+			if !ok {
+				panic(f.errorf("unknown field %q in %s", s.name, v.Type()))
+			}
+			// 嵌入的结构体指针可能为 nil
+			if v = fieldByIndex(v, index); !v.IsValid() {
+				return v
+			}
+		case reflect.Slice, reflect.Array:
+			if s.kind != segmentIndex {
+				panic(f.errorf("%s has no field %q", v.Type(), s.name))
+			}
+			if s.index >= v.Len() {
+				return reflect.Value{}
+			}
+			v = v.Index(s.index)
+		case reflect.Map:
+			if s.kind == segmentField {
+				panic(f.errorf("%s has no field %q", v.Type(), s.name))
+			}
+			k, err := f.mapKey(v.Type().Key(), s)
+			if err != nil {
+				panic(err)
+			}
+			v = v.MapIndex(k)
+		default:
+			panic(f.errorf("cannot access %s", v.Type()))
+		}
+	}
+	return indirectValue(v)
+}
+
+// mapKey 函数用于将路径片段转换为类型为 t 的 map 键.
+func (f *fieldPath) mapKey(t reflect.Type, s *pathSegment) (reflect.Value, error) {
+	var k reflect.Value
+	if s.kind == segmentKey {
+		k = reflect.ValueOf(s.key)
+	} else {
+		k = reflect.ValueOf(s.index)
+	}
+	if k.Type().ConvertibleTo(t) && (t.Kind() == reflect.Interface || (t.Kind() == reflect.String) == (s.kind == segmentKey)) {
+		return k.Convert(t), nil
+	}
+	return reflect.Value{}, f.errorf("invalid map key %v for %s", k, t)
+}
+
+// fieldByIndex 函数与 reflect.Value.FieldByIndex 相同, 区别在于嵌入的结构体指针为 nil 时返回无效的 reflect.Value.
+func fieldByIndex(v reflect.Value, index []int) reflect.Value {
+	for i, x := range index {
+		if i > 0 {
+			if v = indirectValue(v); !v.IsValid() {
+				return v
+			}
+		}
+		v = v.Field(x)
+	}
+	return v
+}
+
+type fieldKey struct {
+	t    reflect.Type
+	name string
+	json bool
+}
+
+// fieldIndexes 缓存了结构体类型中字段的查找结果.
+var fieldIndexes sync.Map
+
+// cachedField 函数用于在结构体类型 t 中查找字段, 当 json 为 true 时仅按照 json 标签名查找.
+func cachedField(t reflect.Type, name string, json bool) ([]int, bool) {
+	k := fieldKey{t, name, json}
+	if index, ok := fieldIndexes.Load(k); ok {
+		return index.([]int), index.([]int) != nil
+	}
+	var index []int
+	if json {
+		index, _ = findField(t, func(f reflect.StructField) bool { return jsonName(f) == name })
+	} else {
+		index, _ = lookupField(t, name)
+	}
+	fieldIndexes.Store(k, index)
+	return index, index != nil
+}
+
+// parseFieldPath 函数用于解析字段路径.
+func parseFieldPath(path string) ([]pathSegment, error) {
+	var segments []pathSegment
+	s := strings.TrimPrefix(path, ".")
+	for first := true; s != "" || first; first = false {
+		if !first && s[0] == '.' {
+			s = s[1:]
+		} else if !first && s[0] != '[' {
+			return nil, fmt.Errorf("%w: path %q: unexpected %q", ErrInvalidOrderBy, path, s)
+		}
+		switch {
+		case strings.HasPrefix(s, "["):
+			end := closingBracket(s)
+			if end < 0 {
+				return nil, fmt.Errorf("%w: path %q: missing ']'", ErrInvalidOrderBy, path)
+			}
+			seg, err := parseIndexSegment(s[1:end])
+			if err != nil {
+				return nil, fmt.Errorf("%w: path %q: %v", ErrInvalidOrderBy, path, err)
+			}
+			segments, s = append(segments, seg), s[end+1:]
+		case strings.HasPrefix(s, `json:"`):
+			end := strings.IndexByte(s[6:], '"')
+			if end <= 0 {
+				return nil, fmt.Errorf("%w: path %q: invalid json name", ErrInvalidOrderBy, path)
+			}
+			segments, s = append(segments, pathSegment{name: s[6 : 6+end], json: true}), s[7+end:]
+		default:
+			end := strings.IndexAny(s, ".[]\"")
+			if end < 0 {
+				end = len(s)
+			}
+			if end == 0 {
+				return nil, fmt.Errorf("%w: path %q: empty field name", ErrInvalidOrderBy, path)
+			}
+			segments, s = append(segments, pathSegment{name: s[:end]}), s[end:]
+		}
+	}
+	return segments, nil
+}
+
+// closingBracket 函数用于获取 s 中与开头的 '[' 匹配的 ']' 的下标, 忽略带引号的键中的 ']'.
+func closingBracket(s string) int {
+	quoted := false
+	for i := 1; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '\\' && quoted:
+			i++
+		case c == '"':
+			quoted = !quoted
+		case c == ']' && !quoted:
+			return i
+		}
+	}
+	return -1
+}
+
+// parseIndexSegment 函数用于解析方括号中的下标或 map 键.
+func parseIndexSegment(s string) (pathSegment, error) {
+	if strings.HasPrefix(s, `"`) {
+		key, err := strconv.Unquote(s)
+		if err != nil {
+			return pathSegment{}, fmt.Errorf("invalid key %s", s)
+		}
+		return pathSegment{kind: segmentKey, key: key}, nil
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil || n < 0 {
+		return pathSegment{}, fmt.Errorf("invalid index [%s]", s)
+	}
+	return pathSegment{kind: segmentIndex, index: n}, nil
+}
@@ -0,0 +1,150 @@
+package comparator
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"testing"
+)
+
+/**
+ *
+ * @Author AiTao
+ * @Date 2026-10-17 20:10
+ * @Url
+ **/
+
+type location struct {
+	City    string
+	ZipCode string `json:"zip_code"`
+}
+
+type contact struct {
+	Name    string
+	Address *location
+	Tags    []string
+	Meta    map[string]any
+	Scores  map[int]float64
+}
+
+func TestField(t *testing.T) {
+	cs := []*contact{
+		{Name: "a", Address: &location{"Paris", "75001"}, Tags: []string{"vip"}, Meta: map[string]any{"region": "eu"}},
+		{Name: "b", Tags: []string{}, Meta: map[string]any{"region": "us"}, Scores: map[int]float64{1: 2.5}},
+		{Name: "c", Address: &location{"Austin", "73301"}, Tags: []string{"new", "vip"}, Scores: map[int]float64{1: 1.5}},
+		{Name: "d", Address: &location{"Berlin", "10115"}, Tags: []string{"basic"}, Meta: map[string]any{"region": "eu"}},
+	}
+	tests := []struct {
+		cmp  Type
+		want string
+	}{
+		{Field("Address.City"), "bcda"},
+		{Field("Address.City", FieldNullsLast()), "cdab"},
+		{Field(`Address.json:"zip_code"`), "bdca"},
+		{Field("address.zip_code", FieldNullsLast()), "dcab"},
+		{Field("Tags[0]"), "bdca"},
+		{Field("Tags[1]", FieldNullsLast()), "cabd"},
+		{Field(`Meta["region"]`), "cadb"},
+		{Field(`.Scores[1]`, FieldNullsLast()), "cbad"},
+		{Then(Field(`Meta["region"]`, FieldNullsLast()), Reverse(Field("Name"))), "dabc"},
+		{Field("Address", FieldUsing(By(func(v any) any { return len(v.(location).City) }, Int))), "bacd"},
+	}
+	for i, tt := range tests {
+		s := append([]*contact(nil), cs...)
+		sort.SliceStable(s, func(i, j int) bool { return tt.cmp(s[i], s[j]) < 0 })
+		var names []string
+		for _, c := range s {
+			names = append(names, c.Name)
+		}
+		if got := strings.Join(names, ""); got != tt.want {
+			t.Errorf("case %d: order = %s, want %s", i, got, tt.want)
+		}
+	}
+	if r := Field("Address.City")(*cs[0], *cs[2]); r != 1 {
+		t.Errorf("Field() on struct values = %d, want 1", r)
+	}
+	if r := Field(`Meta["a]b"]`)(contact{Meta: map[string]any{"a]b": 1}}, contact{}); r != 1 {
+		t.Errorf(`Field(Meta["a]b"]) = %d, want 1`, r)
+	}
+}
+
+func TestFieldInvalidPath(t *testing.T) {
+	for _, path := range []string{"", "A..B", "Tags[", "Tags[x]", "Tags[-1]", `json:""`, "A]"} {
+		func() {
+			defer func() {
+				if r, _ := recover().(error); !errors.Is(r, ErrInvalidOrderBy) {
+					t.Errorf("Field(%q) panic = %v, want ErrInvalidOrderBy", path, r)
+				}
+			}()
+			Field(path)
+		}()
+	}
+	tests := []struct {
+		path string
+		a, b any
+		want string
+	}{
+		{"Address.Street", contact{Address: &location{}}, contact{Address: &location{}}, `unknown field "Street"`},
+		// 路径上的指针为 nil 时同样需要校验
+		{"Address.Street", contact{}, contact{}, `unknown field "Street"`},
+		{"Address.Street", nil, &contact{}, `unknown field "Street"`},
+		{"Tags.Name", contact{}, contact{}, `has no field "Name"`},
+		{"Scores[\"a\"]", contact{}, contact{}, "invalid map key"},
+		{"Name.First", contact{}, contact{}, "cannot access string"},
+	}
+	for _, tt := range tests {
+		func() {
+			defer func() {
+				r, _ := recover().(error)
+				if !errors.Is(r, ErrInvalidOrderBy) || !strings.Contains(r.Error(), tt.want) {
+					t.Errorf("Field(%q) panic = %v, want %s", tt.path, r, tt.want)
+				}
+			}()
+			Field(tt.path)(tt.a, tt.b)
+		}()
+	}
+}
+
+func TestParseField(t *testing.T) {
+	cmp, err := ParseField("Address.City", &contact{})
+	if err != nil {
+		t.Fatalf("ParseField() error = %v", err)
+	}
+	if r := cmp(contact{Address: &location{City: "Paris"}}, contact{}); r != 1 {
+		t.Errorf("compare = %d, want 1", r)
+	}
+	// 路径经过接口类型时, 之后的片段在比较时按照动态类型校验
+	if _, err = ParseField(`Meta["region"].Name`, contact{}); err != nil {
+		t.Errorf("ParseField() error = %v", err)
+	}
+	for _, path := range []string{"Address.Street", "Tags.Name", "Scores[\"a\"]", "Name.First", "A..B"} {
+		if _, err = ParseField(path, contact{}); !errors.Is(err, ErrInvalidOrderBy) {
+			t.Errorf("ParseField(%q) error = %v, want ErrInvalidOrderBy", path, err)
+		}
+	}
+	if _, err = ParseField("Name", nil); !errors.Is(err, ErrInvalidOrderBy) {
+		t.Errorf("ParseField(nil) error = %v, want ErrInvalidOrderBy", err)
+	}
+}
+
+type fieldInner struct {
+	Rank int
+}
+
+type fieldOuter struct {
+	*fieldInner
+	hidden int
+}
+
+func TestFieldEmbedded(t *testing.T) {
+	cmp := Field("Rank")
+	if r := cmp(fieldOuter{fieldInner: &fieldInner{2}}, fieldOuter{fieldInner: &fieldInner{1}}); r != 1 {
+		t.Errorf("compare = %d, want 1", r)
+	}
+	if r := cmp(fieldOuter{}, fieldOuter{fieldInner: &fieldInner{1}}); r != -1 {
+		t.Errorf("compare = %d, want -1", r)
+	}
+	if _, err := ParseField("hidden", fieldOuter{}); !errors.Is(err, ErrInvalidOrderBy) {
+		t.Errorf("ParseField(hidden) error = %v, want ErrInvalidOrderBy", err)
+	}
+}
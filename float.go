@@ -0,0 +1,120 @@
+package comparator
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/lmlat/go-comparator/typed"
+)
+
+/**
+ *
+ * @Author AiTao
+ * @Date 2026-10-19 16:10
+ * @Url
+ **/
+
+// TotalFloat64 函数用于按照 IEEE 754 的 totalOrder 谓词比较两个 float64 类型数据, 顺序为:
+// -NaN < -Inf < 负数 < -0 < +0 < 正数 < +Inf < +NaN. 与 Float64 不同, TotalFloat64 是一个全序关系,
+// NaN 仅与自身相等, 适用于对可能包含 NaN 的数据进行排序.
+//
+// Example:
+// TotalFloat64(math.Copysign(0, -1), 0.0) 返回 -1
+// TotalFloat64(math.NaN(), math.Inf(1)) 返回 1
+func TotalFloat64(x, y interface{}) int {
+	return typed.Compare(totalKey64(x.(float64)), totalKey64(y.(float64)))
+}
+
+// TotalFloat32 函数用于按照 IEEE 754 的 totalOrder 谓词比较两个 float32 类型数据, 参见 TotalFloat64.
+func TotalFloat32(x, y interface{}) int {
+	return typed.Compare(totalKey32(x.(float32)), totalKey32(y.(float32)))
+}
+
+// totalKey64 函数用于将 float64 转换为一个有符号整数, 整数的大小顺序与 totalOrder 一致,
+// 且相邻的两个浮点数对应的整数相差1, 其中 -0 对应 -1, +0 对应 0.
+func totalKey64(f float64) int64 {
+	b := int64(math.Float64bits(f))
+	if b < 0 {
+		return b ^ math.MaxInt64
+	}
+	return b
+}
+
+func totalKey32(f float32) int64 {
+	b := int32(math.Float32bits(f))
+	if b < 0 {
+		return int64(b ^ math.MaxInt32)
+	}
+	return int64(b)
+}
+
+// Approx 函数用于获取一个近似比较浮点数的比较器, 当 |x-y| <= max(absTol, relTol*max(|x|, |y|)) 时认为两个值相等,
+// 否则按照 TotalFloat64 的顺序比较. 比较器的参数可以是 float32 或 float64, 通过 FloatComparator 选项可以将其用于深度比较.
+//
+// Example:
+// Approx(1e-9, 0)(0.1+0.2, 0.3) 返回 0
+// EqualsWith(p1, p2, FloatComparator(Approx(0, 1e-6)))
+func Approx(absTol, relTol float64) Type {
+	return func(x, y interface{}) int {
+		a, b, total := floatArgs(x, y)
+		if a == b {
+			return 0
+		}
+		if !math.IsNaN(a) && !math.IsNaN(b) && math.Abs(a-b) <= math.Max(absTol, relTol*math.Max(math.Abs(a), math.Abs(b))) {
+			return 0
+		}
+		return total
+	}
+}
+
+// WithinULP 函数用于获取一个按照最小精度单位(ULP)近似比较浮点数的比较器, 当 x 与 y 之间相差不超过 n 个可表示的浮点数时认为两个值相等,
+// 否则按照 TotalFloat64 的顺序比较. 参数为 float32 时按照 float32 的精度计算.
+//
+// Example:
+// WithinULP(1)(1.0, math.Nextafter(1, 2)) 返回 0
+func WithinULP(n uint64) Type {
+	return func(x, y interface{}) int {
+		var kx, ky int64
+		if a, ok := x.(float32); ok {
+			kx, ky = totalKey32(a), totalKey32(y.(float32))
+		} else {
+			kx, ky = totalKey64(x.(float64)), totalKey64(y.(float64))
+		}
+		a, b, total := floatArgs(x, y)
+		if a == b {
+			return 0
+		}
+		if !math.IsNaN(a) && !math.IsNaN(b) && ulpDistance(kx, ky) <= n {
+			return 0
+		}
+		return total
+	}
+}
+
+// ulpDistance 函数用于计算两个 totalKey 之间相差的可表示浮点数个数, 其中 -0 与 +0 视为同一个值.
+func ulpDistance(x, y int64) uint64 {
+	if x < 0 {
+		x++
+	}
+	if y < 0 {
+		y++
+	}
+	if x > y {
+		return uint64(x) - uint64(y)
+	}
+	return uint64(y) - uint64(x)
+}
+
+// floatArgs 函数用于将 float32 或 float64 类型的参数转换为 float64, 同时返回两者按照 totalOrder 比较的结果.
+func floatArgs(x, y interface{}) (float64, float64, int) {
+	switch a := x.(type) {
+	case float32:
+		b := y.(float32)
+		return float64(a), float64(b), TotalFloat32(a, b)
+	case float64:
+		b := y.(float64)
+		return a, b, TotalFloat64(a, b)
+	default:
+		panic(fmt.Sprintf("illegal argument: %T is not a floating-point number", x))
+	}
+}
@@ -0,0 +1,99 @@
+package comparator
+
+import (
+	"math"
+	"sort"
+	"testing"
+)
+
+/**
+ *
+ * @Author AiTao
+ * @Date 2026-10-19 16:10
+ * @Url
+ **/
+
+func TestTotalFloat64(t *testing.T) {
+	negNaN := math.Copysign(math.NaN(), -1)
+	negZero := math.Copysign(0, -1)
+	ordered := []float64{negNaN, math.Inf(-1), -math.MaxFloat64, -1, -math.SmallestNonzeroFloat64, negZero, 0,
+		math.SmallestNonzeroFloat64, 1, math.MaxFloat64, math.Inf(1), math.NaN()}
+	for i := range ordered {
+		for j := range ordered {
+			want := 0
+			if i < j {
+				want = -1
+			} else if i > j {
+				want = 1
+			}
+			if got := TotalFloat64(ordered[i], ordered[j]); got != want {
+				t.Errorf("TotalFloat64(%v, %v) = %d, want %d", ordered[i], ordered[j], got, want)
+			}
+		}
+	}
+}
+
+func TestTotalFloat32(t *testing.T) {
+	nan := float32(math.NaN())
+	s := []float32{nan, 3, float32(math.Inf(-1)), float32(math.Copysign(0, -1)), -2, 0, nan}
+	sort.Slice(s, func(i, j int) bool { return TotalFloat32(s[i], s[j]) < 0 })
+	if s[0] != float32(math.Inf(-1)) || s[1] != -2 || !math.Signbit(float64(s[2])) || s[3] != 0 || s[4] != 3 ||
+		!math.IsNaN(float64(s[5])) || !math.IsNaN(float64(s[6])) {
+		t.Errorf("sorted = %v", s)
+	}
+}
+
+func TestApprox(t *testing.T) {
+	a, b := 0.1, float32(0.1)
+	tests := []struct {
+		abs, rel float64
+		x, y     interface{}
+		want     int
+	}{
+		{1e-9, 0, a + 0.2, 0.3, 0},
+		{0, 0, a + 0.2, 0.3, 1},
+		{0, 1e-3, 1000.0, 1000.9, 0},
+		{0, 1e-3, 1000.0, 1001.1, -1},
+		{0.5, 0, 2.0, 1.0, 1},
+		{0, 0, math.Copysign(0, -1), 0.0, 0},
+		{1, 1, math.NaN(), math.NaN(), 0},
+		{1, 1, math.NaN(), 1.0, 1},
+		{math.Inf(1), 0, math.Inf(1), math.Inf(1), 0},
+		{1e-6, 0, b + 0.2, float32(0.3), 0},
+	}
+	for _, tt := range tests {
+		if got := Approx(tt.abs, tt.rel)(tt.x, tt.y); got != tt.want {
+			t.Errorf("Approx(%v, %v)(%v, %v) = %d, want %d", tt.abs, tt.rel, tt.x, tt.y, got, tt.want)
+		}
+	}
+}
+
+func TestWithinULP(t *testing.T) {
+	next := func(f float64, n int) float64 {
+		for i := 0; i < n; i++ {
+			f = math.Nextafter(f, math.Inf(1))
+		}
+		return f
+	}
+	tests := []struct {
+		n    uint64
+		x, y interface{}
+		want int
+	}{
+		{0, 1.0, 1.0, 0},
+		{0, 1.0, next(1, 1), -1},
+		{1, 1.0, next(1, 1), 0},
+		{3, next(1, 4), 1.0, 1},
+		{4, next(1, 4), 1.0, 0},
+		{2, -math.SmallestNonzeroFloat64, math.SmallestNonzeroFloat64, 0},
+		{1, -math.SmallestNonzeroFloat64, math.SmallestNonzeroFloat64, -1},
+		{0, math.Copysign(0, -1), 0.0, 0},
+		{1, float32(1), math.Nextafter32(1, 2), 0},
+		{1 << 20, math.NaN(), 1.0, 1},
+	}
+	for _, tt := range tests {
+		if got := WithinULP(tt.n)(tt.x, tt.y); got != tt.want {
+			t.Errorf("WithinULP(%d)(%v, %v) = %d, want %d", tt.n, tt.x, tt.y, got, tt.want)
+		}
+	}
+}
@@ -9,6 +9,6 @@ package comparator
  **/
 
 func Greater(a, b interface{}) bool {
-	r, _ := compareValue(a, b, false)
+	r, _ := compareValue(a, b, false, newOptions(nil))
 	return r == greater
 }
@@ -0,0 +1,244 @@
+// gen/collation 用于根据 Unicode 排序算法的 DUCET(allkeys.txt)以及 Unicode::Collate 中的 CLDR 语言区域定制规则
+// 生成 collation_tables.go.
+//
+// 在仓库根目录执行 go generate 即可重新生成. allkeys.txt 默认从 unicode.org 下载, 定制规则默认从 Perl 源码仓库中
+// 对应版本的 Unicode::Collate 下载, 也可以通过 -dir 指定本地的 Unicode::Collate 目录(包含 allkeys.txt 与 Locale 子目录):
+//
+//	go run ./internal/gen/collation -o collation_tables.go
+//	go run ./internal/gen/collation -dir /usr/share/perl/5.36.0/Unicode/Collate -o collation_tables.go
+//
+// 升级版本时修改 -version 与 -perl 的默认值后重新生成即可, 两者的 DUCET 版本必须一致.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+/**
+ *
+ * @Author AiTao
+ * @Date 2026-10-23 17:30
+ * @Url
+ **/
+
+var (
+	version = flag.String("version", "13.0.0", "UCA version of allkeys.txt")
+	perl    = flag.String("perl", "v5.36.0", "Perl release providing the Unicode::Collate locale files")
+	dir     = flag.String("dir", "", "local Unicode::Collate directory containing allkeys.txt and Locale, downloaded if empty")
+	out     = flag.String("o", "collation_tables.go", "output file")
+)
+
+// blocks 为写入 ducet 的 Unicode 区块, 排序元素的所有字符都必须位于这些区块中.
+var blocks = [][2]rune{
+	{0x0000, 0x052F}, // Basic Latin ~ Cyrillic Supplement
+	{0x1E00, 0x1FFF}, // Latin Extended Additional, Greek Extended
+	{0x2000, 0x206F}, // General Punctuation
+	{0x20A0, 0x20CF}, // Currency Symbols
+}
+
+// tailorings 为语言区域与 Unicode::Collate 中定制规则文件的对应关系, 文件名为空表示使用 DUCET 的默认顺序.
+var tailorings = []struct {
+	locale, file, comment string
+}{
+	{"de", "", "德语使用 DUCET 的默认顺序, 无需定制"},
+	{"de-u-co-phonebk", "de_phone.pl", ""},
+	{"es", "es.pl", ""},
+	{"es-u-co-trad", "es_trad.pl", ""},
+	{"sv", "sv.pl", ""},
+}
+
+// entry 为一个排序元素映射.
+type entry struct {
+	key     []rune
+	weights []uint32
+}
+
+func main() {
+	flag.Parse()
+
+	var buf bytes.Buffer
+	writeTables(&buf, loadEntries("allkeys.txt", inBlocks))
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err = os.WriteFile(*out, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// open 函数用于打开 allkeys.txt 或者 Locale 目录中的定制规则文件.
+func open(name string) io.ReadCloser {
+	if *dir != "" {
+		f, err := os.Open(filepath.Join(*dir, filepath.FromSlash(name)))
+		if err != nil {
+			log.Fatal(err)
+		}
+		return f
+	}
+	url := "https://raw.githubusercontent.com/Perl/perl5/" + *perl + "/cpan/Unicode-Collate/Collate/" + name
+	if name == "allkeys.txt" {
+		url = "https://www.unicode.org/Public/UCA/" + *version + "/allkeys.txt"
+	}
+	resp, err := http.Get(url)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		log.Fatalf("%s: %s", url, resp.Status)
+	}
+	return resp.Body
+}
+
+func inBlocks(rs []rune) bool {
+	for _, r := range rs {
+		ok := false
+		for _, b := range blocks {
+			if r >= b[0] && r <= b[1] {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// loadEntries 函数用于按照文件中的顺序读取形如 "0061 0308 ; [.1FA2.0020.0002][.0000.002B.0002] # ..." 的排序元素映射,
+// 忽略空行、注释、@ 开头的指令以及 Perl 文件中的其它内容.
+func loadEntries(name string, keep func([]rune) bool) []entry {
+	r := open(name)
+	defer r.Close()
+	var entries []entry
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line, _, _ := strings.Cut(s.Text(), "#")
+		cps, elems, ok := strings.Cut(line, ";")
+		if !ok || strings.HasPrefix(line, "@") {
+			continue
+		}
+		key, ok := parseRunes(cps)
+		if !ok || !keep(key) {
+			continue
+		}
+		entries = append(entries, entry{key: key, weights: parseElements(strings.TrimSpace(elems))})
+	}
+	if err := s.Err(); err != nil {
+		log.Fatal(err)
+	}
+	return entries
+}
+
+func parseRunes(s string) ([]rune, bool) {
+	var rs []rune
+	for _, f := range strings.Fields(s) {
+		v, err := strconv.ParseUint(f, 16, 32)
+		if err != nil {
+			return nil, false
+		}
+		rs = append(rs, rune(v))
+	}
+	return rs, len(rs) > 0
+}
+
+// parseElements 函数用于将 "[.P.S.T]" 或 "[*P.S.T]" 形式的排序元素按照主权重(16位)、次权重(11位)、第三权重(5位)的
+// 顺序打包为 uint32, 可变权重标记 * 不保留.
+func parseElements(s string) []uint32 {
+	var ws []uint32
+	for s != "" {
+		if len(s) < 2 || s[0] != '[' || (s[1] != '.' && s[1] != '*') {
+			log.Fatalf("invalid collation element %q", s)
+		}
+		elem, rest, ok := strings.Cut(s[2:], "]")
+		if !ok {
+			log.Fatalf("invalid collation element %q", s)
+		}
+		parts := strings.Split(elem, ".")
+		if len(parts) != 3 {
+			log.Fatalf("invalid collation element %q", elem)
+		}
+		var w [3]uint64
+		for i, p := range parts {
+			v, err := strconv.ParseUint(p, 16, 16)
+			if err != nil {
+				log.Fatal(err)
+			}
+			w[i] = v
+		}
+		if w[1] >= 1<<11 || w[2] >= 1<<5 {
+			log.Fatalf("collation element %q out of range", elem)
+		}
+		ws = append(ws, uint32(w[0]<<16|w[1]<<5|w[2]))
+		s = strings.TrimSpace(rest)
+	}
+	return ws
+}
+
+// quote 函数用于生成字符串字面量, 除空格、双引号与反斜杠以外的 ASCII 可打印字符原样输出, 其余字符使用小写十六进制的 \u 转义.
+func quote(rs []rune) string {
+	var sb strings.Builder
+	sb.WriteByte('"')
+	for _, r := range rs {
+		if r > ' ' && r < 0x7F && r != '"' && r != '\\' {
+			sb.WriteRune(r)
+		} else {
+			fmt.Fprintf(&sb, `\u%04x`, r)
+		}
+	}
+	sb.WriteByte('"')
+	return sb.String()
+}
+
+func writeEntries(w *bytes.Buffer, indent string, entries []entry) {
+	for _, e := range entries {
+		ws := make([]string, len(e.weights))
+		for i, x := range e.weights {
+			ws[i] = fmt.Sprintf("0x%08x", x)
+		}
+		fmt.Fprintf(w, "%s%s: {%s},\n", indent, quote(e.key), strings.Join(ws, ", "))
+	}
+}
+
+func writeTables(w *bytes.Buffer, ducet []entry) {
+	fmt.Fprintln(w, "// Code generated by go run ./internal/gen/collation. DO NOT EDIT.")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "package comparator")
+	fmt.Fprintln(w)
+	fmt.Fprintf(w, "// 本文件中的数据来源于 Unicode 排序算法的 DUCET %s(allkeys.txt) 以及 Perl %s 中 Unicode::Collate 提供的\n", *version, *perl)
+	fmt.Fprintln(w, "// CLDR 语言区域定制规则.")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "// ducet 为 DUCET 中拉丁文、希腊文、西里尔文以及常用标点符号的排序元素, 每个排序元素按照")
+	fmt.Fprintln(w, "// 主权重(16位)、次权重(11位)、第三权重(5位)的顺序打包为一个 uint32.")
+	fmt.Fprintln(w, "var ducet = map[string][]uint32{")
+	writeEntries(w, "\t", ducet)
+	fmt.Fprintln(w, "}")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "// collationTailorings 为各个语言区域对 ducet 的定制规则, 规则中的排序元素会覆盖 ducet 中的同名条目.")
+	fmt.Fprintln(w, "var collationTailorings = map[string]map[string][]uint32{")
+	for _, t := range tailorings {
+		if t.comment != "" {
+			fmt.Fprintf(w, "\t// %s\n", t.comment)
+		}
+		if t.file == "" {
+			fmt.Fprintf(w, "\t%q: {},\n", t.locale)
+			continue
+		}
+		fmt.Fprintf(w, "\t%q: {\n", t.locale)
+		writeEntries(w, "\t\t", loadEntries("Locale/"+t.file, func([]rune) bool { return true }))
+		fmt.Fprintln(w, "\t},")
+	}
+	fmt.Fprintln(w, "}")
+}
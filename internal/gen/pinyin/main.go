@@ -0,0 +1,299 @@
+// gen/pinyin 用于根据 readings.txt 中的默认读音以及 CLDR 拼音、笔画排序数据生成 pinyin_table.go.
+//
+// 在仓库根目录执行 go generate 即可重新生成:
+//
+//	go run ./internal/gen/pinyin -o pinyin_table.go
+//
+// 数据文件的来源及许可参见同目录下的 NOTICE 文件.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+/**
+ *
+ * @Author AiTao
+ * @Date 2026-10-22 10:30
+ * @Url
+ **/
+
+// 笔画数只记录 CJK 统一汉字基本区中的汉字
+const hanFirst, hanLast = 0x4E00, 0x9FFF
+
+func main() {
+	out := flag.String("o", "pinyin_table.go", "output file")
+	flag.Parse()
+
+	dir := dataDir()
+	order := loadOrder(filepath.Join(dir, "pinyin.txt"))
+	rank := make(map[rune]int, len(order))
+	for i, c := range order {
+		rank[c] = i
+	}
+	readings := inferReadings(loadReadings(filepath.Join(dir, "readings.txt")), order, rank)
+	strokes := loadStrokes(filepath.Join(dir, "stroke.txt"))
+
+	var buf bytes.Buffer
+	writeTable(&buf, readings, rank, strokes)
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err = os.WriteFile(*out, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// dataDir 函数用于获取数据文件所在的目录, 即本文件所在的目录.
+func dataDir() string {
+	_, file, _, ok := runtime.Caller(0)
+	if !ok {
+		log.Fatal("cannot locate data directory")
+	}
+	return filepath.Dir(file)
+}
+
+// readLines 函数用于读取文件中除空行与注释以外的所有行.
+func readLines(name string) []string {
+	f, err := os.Open(name)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+	var lines []string
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		if line := strings.TrimSpace(s.Text()); line != "" && line[0] != '#' {
+			lines = append(lines, line)
+		}
+	}
+	if err = s.Err(); err != nil {
+		log.Fatal(err)
+	}
+	return lines
+}
+
+// loadOrder 函数用于读取 CLDR 拼音排序中汉字的先后顺序, 形如 FDD0-0041 的字母分隔符会被忽略.
+func loadOrder(name string) []rune {
+	var order []rune
+	for _, line := range readLines(name) {
+		for _, f := range strings.Fields(line) {
+			if !strings.Contains(f, "-") {
+				order = append(order, parseRune(f))
+			}
+		}
+	}
+	return order
+}
+
+// loadStrokes 函数用于读取汉字的笔画数, 形如 FDD0-28xx 的分隔符表示之后的汉字的笔画数为 xx.
+func loadStrokes(name string) map[rune]int {
+	strokes := make(map[rune]int)
+	n := 0
+	for _, line := range readLines(name) {
+		for _, f := range strings.Fields(line) {
+			if rest, ok := strings.CutPrefix(f, "FDD0-28"); ok {
+				v, err := strconv.ParseInt(rest, 16, 32)
+				if err != nil {
+					log.Fatal(err)
+				}
+				n = int(v)
+			} else if !strings.Contains(f, "-") {
+				if c := parseRune(f); c >= hanFirst && c <= hanLast {
+					strokes[c] = n
+				}
+			}
+		}
+	}
+	return strokes
+}
+
+func parseRune(s string) rune {
+	v, err := strconv.ParseInt(s, 16, 32)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return rune(v)
+}
+
+// loadReadings 函数用于读取汉字的默认读音, 同一个汉字只能出现一次.
+func loadReadings(name string) map[rune]string {
+	readings := make(map[rune]string)
+	for _, line := range readLines(name) {
+		f := strings.Fields(line)
+		if len(f) != 2 {
+			log.Fatalf("%s: malformed line %q", name, line)
+		}
+		for _, c := range f[1] {
+			if r, ok := readings[c]; ok {
+				log.Fatalf("%s: %c is listed under both %s and %s", name, c, r, f[0])
+			}
+			readings[c] = f[0]
+		}
+	}
+	return readings
+}
+
+type entry struct {
+	rank    int
+	reading string
+}
+
+// inferReadings 函数用于推断未在 readings.txt 中列出的汉字的读音.
+// CLDR 拼音排序中同一读音的汉字是连续排列的, 因此位于同一读音的两个已知汉字之间的汉字也使用该读音.
+// 为了避免多音字干扰推断, 只使用读音顺序与 CLDR 顺序一致的最长子序列中的汉字作为边界.
+func inferReadings(known map[rune]string, order []rune, rank map[rune]int) map[rune]string {
+	var entries []entry
+	for c, r := range known {
+		if i, ok := rank[c]; ok {
+			entries = append(entries, entry{i, r})
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].rank < entries[j].rank })
+
+	// 按照每个读音在 CLDR 顺序中的中位数确定读音的先后顺序
+	positions := make(map[string][]int)
+	for _, e := range entries {
+		positions[e.reading] = append(positions[e.reading], e.rank)
+	}
+	medians := make(map[string]float64, len(positions))
+	names := make([]string, 0, len(positions))
+	for r, p := range positions {
+		n := len(p)
+		medians[r] = float64(p[(n-1)/2]+p[n/2]) / 2
+		names = append(names, r)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if mi, mj := medians[names[i]], medians[names[j]]; mi != mj {
+			return mi < mj
+		}
+		return names[i] < names[j]
+	})
+	index := make(map[string]int, len(names))
+	for i, r := range names {
+		index[r] = i
+	}
+
+	// 最长不下降子序列
+	var tails, tailIdx []int
+	prev := make([]int, len(entries))
+	for i, e := range entries {
+		k := index[e.reading]
+		j := sort.Search(len(tails), func(j int) bool { return tails[j] > k })
+		if j == len(tails) {
+			tails, tailIdx = append(tails, k), append(tailIdx, i)
+		} else {
+			tails[j], tailIdx[j] = k, i
+		}
+		prev[i] = -1
+		if j > 0 {
+			prev[i] = tailIdx[j-1]
+		}
+	}
+	var keep []int
+	for i := tailIdx[len(tailIdx)-1]; i >= 0; i = prev[i] {
+		keep = append(keep, i)
+	}
+
+	readings := make(map[rune]string, len(known))
+	for c, r := range known {
+		readings[c] = r
+	}
+	for i := len(keep) - 1; i > 0; i-- {
+		a, b := entries[keep[i]], entries[keep[i-1]]
+		if a.reading != b.reading {
+			continue
+		}
+		for j := a.rank + 1; j < b.rank; j++ {
+			if c := order[j]; c >= hanFirst && c <= hanLast {
+				if _, ok := readings[c]; !ok {
+					readings[c] = a.reading
+				}
+			}
+		}
+	}
+	return readings
+}
+
+// readingKey 函数用于将读音拆分为不带声调的拼音与声调, ü 记为 v.
+func readingKey(r string) (string, int) {
+	r = strings.ReplaceAll(r, "ü", "v")
+	return r[:len(r)-1], int(r[len(r)-1] - '0')
+}
+
+func writeTable(w *bytes.Buffer, readings map[rune]string, rank map[rune]int, strokes map[rune]int) {
+	groups := make(map[string][]rune)
+	for c, r := range readings {
+		groups[r] = append(groups[r], c)
+	}
+	names := make([]string, 0, len(groups))
+	for r := range groups {
+		names = append(names, r)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		si, ti := readingKey(names[i])
+		sj, tj := readingKey(names[j])
+		if si != sj {
+			return si < sj
+		}
+		return ti < tj
+	})
+	rankOf := func(c rune) int {
+		if i, ok := rank[c]; ok {
+			return i
+		}
+		return 1 << 30
+	}
+
+	fmt.Fprintln(w, "// Code generated by go run ./internal/gen/pinyin. DO NOT EDIT.")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "package comparator")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "// 本文件中的数据来源于 CLDR 以及 Unicode::Collate::CJK, 许可参见 internal/gen/pinyin/NOTICE.")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "// pinyinTable 按照音节、声调的顺序列出汉字的默认读音, 声调 5 表示轻声, ü 记为 v.")
+	fmt.Fprintln(w, "// 同一读音的汉字按照 CLDR 拼音排序规则中的先后顺序排列.")
+	fmt.Fprintln(w, "var pinyinTable = [...]struct{ reading, chars string }{")
+	for _, r := range names {
+		cs := groups[r]
+		sort.Slice(cs, func(i, j int) bool {
+			if ri, rj := rankOf(cs[i]), rankOf(cs[j]); ri != rj {
+				return ri < rj
+			}
+			return cs[i] < cs[j]
+		})
+		s, t := readingKey(r)
+		fmt.Fprintf(w, "\t{%q, %q},\n", s+strconv.Itoa(t), string(cs))
+	}
+	fmt.Fprintln(w, "}")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "// hanStrokes 记录了 U+4E00 至 U+9FFF 范围内每个汉字的笔画数, 每个字节表示一个汉字, 取值为笔画数加上 '0'.")
+	fmt.Fprintln(w, "const hanStrokes = \"\" +")
+	data := make([]byte, 0, hanLast-hanFirst+1)
+	for c := rune(hanFirst); c <= hanLast; c++ {
+		data = append(data, byte('0'+strokes[c]))
+	}
+	for i := 0; i < len(data); i += 96 {
+		end := i + 96
+		if end > len(data) {
+			end = len(data)
+		}
+		sep := " +"
+		if end == len(data) {
+			sep = ""
+		}
+		fmt.Fprintf(w, "\t%q%s\n", data[i:end], sep)
+	}
+}
@@ -0,0 +1,227 @@
+// gen/unicode 用于根据 Unicode 字符数据库(UCD)中的 UnicodeData.txt 与 CaseFolding.txt 生成 unicode_tables.go.
+//
+// 在仓库根目录执行 go generate 即可重新生成, 数据文件默认从 unicode.org 下载, 也可以通过 -ucd 指定本地目录:
+//
+//	go run ./internal/gen/unicode -o unicode_tables.go
+//	go run ./internal/gen/unicode -ucd /path/to/ucd -o unicode_tables.go
+//
+// 升级 Unicode 版本时修改 -version 的默认值后重新生成即可.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+/**
+ *
+ * @Author AiTao
+ * @Date 2026-10-23 16:10
+ * @Url
+ **/
+
+var (
+	version = flag.String("version", "14.0.0", "Unicode version")
+	ucd     = flag.String("ucd", "", "local directory containing the UCD files, downloaded from unicode.org if empty")
+	out     = flag.String("o", "unicode_tables.go", "output file")
+)
+
+func main() {
+	flag.Parse()
+
+	ccc, decomp := loadUnicodeData()
+	folds := loadFullFolds()
+
+	var buf bytes.Buffer
+	writeTables(&buf, fullDecompositions(ccc, decomp), folds)
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err = os.WriteFile(*out, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// open 函数用于打开 UCD 中的数据文件.
+func open(name string) io.ReadCloser {
+	if *ucd != "" {
+		f, err := os.Open(filepath.Join(*ucd, name))
+		if err != nil {
+			log.Fatal(err)
+		}
+		return f
+	}
+	url := "https://www.unicode.org/Public/" + *version + "/ucd/" + name
+	resp, err := http.Get(url)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		log.Fatalf("%s: %s", url, resp.Status)
+	}
+	return resp.Body
+}
+
+// readFields 函数用于按行读取以分号分隔的数据文件, 忽略空行与注释.
+func readFields(name string, fn func(fields []string)) {
+	r := open(name)
+	defer r.Close()
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		line, _, _ := strings.Cut(s.Text(), "#")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		fields := strings.Split(line, ";")
+		for i := range fields {
+			fields[i] = strings.TrimSpace(fields[i])
+		}
+		fn(fields)
+	}
+	if err := s.Err(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func parseRune(s string) rune {
+	v, err := strconv.ParseUint(s, 16, 32)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return rune(v)
+}
+
+func parseRunes(s string) []rune {
+	var rs []rune
+	for _, f := range strings.Fields(s) {
+		rs = append(rs, parseRune(f))
+	}
+	return rs
+}
+
+// loadUnicodeData 函数用于读取字符的规范组合类以及规范分解映射(不包括兼容分解).
+func loadUnicodeData() (map[rune]int, map[rune][]rune) {
+	ccc := make(map[rune]int)
+	decomp := make(map[rune][]rune)
+	readFields("UnicodeData.txt", func(f []string) {
+		r := parseRune(f[0])
+		if c, err := strconv.Atoi(f[3]); err != nil {
+			log.Fatal(err)
+		} else if c != 0 {
+			ccc[r] = c
+		}
+		if f[5] != "" && !strings.HasPrefix(f[5], "<") {
+			decomp[r] = parseRunes(f[5])
+		}
+	})
+	return ccc, decomp
+}
+
+// loadFullFolds 函数用于读取 CaseFolding.txt 中状态为 F 的完全大小写折叠映射.
+func loadFullFolds() map[rune][]rune {
+	folds := make(map[rune][]rune)
+	readFields("CaseFolding.txt", func(f []string) {
+		if f[1] == "F" {
+			folds[parseRune(f[0])] = parseRunes(f[2])
+		}
+	})
+	return folds
+}
+
+// fullDecompositions 函数用于递归地展开规范分解映射, 并按照规范组合类对组合字符进行规范排序.
+func fullDecompositions(ccc map[rune]int, decomp map[rune][]rune) map[rune][]rune {
+	var expand func(r rune) []rune
+	expand = func(r rune) []rune {
+		d, ok := decomp[r]
+		if !ok {
+			return []rune{r}
+		}
+		var rs []rune
+		for _, c := range d {
+			rs = append(rs, expand(c)...)
+		}
+		return rs
+	}
+	full := make(map[rune][]rune, len(decomp))
+	for r := range decomp {
+		rs := expand(r)
+		// 规范排序: 相邻且组合类均不为 0 的字符按照组合类稳定排序
+		for i := 0; i < len(rs); {
+			j := i
+			for j < len(rs) && ccc[rs[j]] != 0 {
+				j++
+			}
+			if j > i {
+				seg := rs[i:j]
+				sort.SliceStable(seg, func(a, b int) bool { return ccc[seg[a]] < ccc[seg[b]] })
+				i = j
+			} else {
+				i++
+			}
+		}
+		full[r] = rs
+	}
+	return full
+}
+
+// quote 函数用于生成字符串字面量, ASCII 可打印字符原样输出, 其余字符使用大写十六进制的 \u、\U 转义.
+func quote(rs []rune) string {
+	var sb strings.Builder
+	sb.WriteByte('"')
+	for _, r := range rs {
+		switch {
+		case r == '"' || r == '\\':
+			sb.WriteByte('\\')
+			sb.WriteRune(r)
+		case r >= 0x20 && r < 0x7F:
+			sb.WriteRune(r)
+		case r <= 0xFFFF:
+			fmt.Fprintf(&sb, `\u%04X`, r)
+		default:
+			fmt.Fprintf(&sb, `\U%08X`, r)
+		}
+	}
+	sb.WriteByte('"')
+	return sb.String()
+}
+
+func writeMap(w *bytes.Buffer, m map[rune][]rune) {
+	keys := make([]rune, 0, len(m))
+	for r := range m {
+		keys = append(keys, r)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	for _, r := range keys {
+		fmt.Fprintf(w, "\t0x%04X: %s,\n", r, quote(m[r]))
+	}
+}
+
+func writeTables(w *bytes.Buffer, decomp, folds map[rune][]rune) {
+	fmt.Fprintln(w, "// Code generated by go run ./internal/gen/unicode. DO NOT EDIT.")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "package comparator")
+	fmt.Fprintln(w)
+	fmt.Fprintf(w, "// 本文件中的数据来源于 Unicode 字符数据库(UCD) %s 的 UnicodeData.txt 与 CaseFolding.txt.\n", *version)
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "// decompositions 保存了字符的完全规范分解(NFD)结果, 不包括算法分解的韩文音节.")
+	fmt.Fprintln(w, "var decompositions = map[rune]string{")
+	writeMap(w, decomp)
+	fmt.Fprintln(w, "}")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "// fullFolds 保存了完全大小写折叠(CaseFolding.txt 中状态为 F 的映射)结果为多个字符的字符.")
+	fmt.Fprintln(w, "var fullFolds = map[rune]string{")
+	writeMap(w, folds)
+	fmt.Fprintln(w, "}")
+}
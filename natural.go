@@ -0,0 +1,98 @@
+package comparator
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+/**
+ *
+ * @Author AiTao
+ * @Date 2026-10-17 21:20
+ * @Url
+ **/
+
+// Natural 函数用于按照自然顺序比较两个字符串, 字符串被拆分为连续的数字与非数字片段, 数字片段按照数值大小比较,
+// 非数字片段按照字节比较, 例如 "file2.txt" 小于 "file10.txt".
+// 数值相等但前导零个数不同的数字片段(例如 "01" 与 "1"), 在其余部分均相等时前导零较少的一方较小.
+// 数字片段的长度不受限制, 不会发生溢出.
+//
+// Example:
+// Natural("file2.txt", "file10.txt") 返回 -1
+// Natural("v1.10", "v1.9") 返回 1
+func Natural(x, y interface{}) int {
+	return naturalCompare(x.(string), y.(string), false)
+}
+
+// NaturalFold 函数与 Natural 函数相同, 区别在于非数字片段的比较忽略大小写.
+//
+// Example:
+// NaturalFold("IMG_2.png", "img_10.PNG") 返回 -1
+func NaturalFold(x, y interface{}) int {
+	return naturalCompare(x.(string), y.(string), true)
+}
+
+func naturalCompare(a, b string, fold bool) int {
+	// 前导零个数不同的比较结果, 仅当其余部分均相等时生效
+	zeros := 0
+	for a != "" && b != "" {
+		if isDigit(a[0]) && isDigit(b[0]) {
+			var x, y string
+			x, a = digitRun(a)
+			y, b = digitRun(b)
+			tx, ty := trimZeros(x), trimZeros(y)
+			if r := Int(len(tx), len(ty)); r != 0 {
+				return r
+			}
+			if r := String(tx, ty); r != 0 {
+				return r
+			}
+			if zeros == 0 {
+				zeros = Int(len(x), len(y))
+			}
+			continue
+		}
+		if !fold {
+			if a[0] != b[0] {
+				return Byte(a[0], b[0])
+			}
+			a, b = a[1:], b[1:]
+			continue
+		}
+		ra, na := utf8.DecodeRuneInString(a)
+		rb, nb := utf8.DecodeRuneInString(b)
+		if ra == utf8.RuneError || rb == utf8.RuneError {
+			// 无效的 UTF-8 编码按照字节比较
+			ra, rb, na, nb = rune(a[0]), rune(b[0]), 1, 1
+		}
+		if r := Rune(unicode.ToLower(ra), unicode.ToLower(rb)); r != 0 {
+			return r
+		}
+		a, b = a[na:], b[nb:]
+	}
+	if r := Int(len(a), len(b)); r != 0 {
+		return r
+	}
+	return zeros
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+// digitRun 函数用于拆分字符串开头的数字片段.
+func digitRun(s string) (string, string) {
+	i := 0
+	for i < len(s) && isDigit(s[i]) {
+		i++
+	}
+	return s[:i], s[i:]
+}
+
+// trimZeros 函数用于移除数字片段的前导零.
+func trimZeros(s string) string {
+	for len(s) > 1 && s[0] == '0' {
+		s = s[1:]
+	}
+	return s
+}
@@ -0,0 +1,92 @@
+package comparator
+
+import (
+	"sort"
+	"strings"
+	"testing"
+)
+
+/**
+ *
+ * @Author AiTao
+ * @Date 2026-10-17 21:20
+ * @Url
+ **/
+
+func TestNatural(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"file2.txt", "file10.txt", -1},
+		{"file10.txt", "file2.txt", 1},
+		{"file02.txt", "file2.txt", 1},
+		{"file02.txt", "file2.tx", 1},
+		{"a01b2", "a1b10", -1},
+		{"v1.10.0", "v1.9.12", 1},
+		{"", "0", -1},
+		{"abc", "abc", 0},
+		{"x123456789012345678901234567890", "x123456789012345678901234567891", -1},
+		{"x99999999999999999999999999999", "x100000000000000000000000000000", -1},
+		{"x0000000000000000000000000000001", "x2", -1},
+		{"Img2", "img10", -1},
+		{"img2", "Img10", 1},
+		{"a-1", "a1", -1},
+	}
+	for _, tt := range tests {
+		if got := Natural(tt.a, tt.b); got != tt.want {
+			t.Errorf("Natural(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+		if got := Natural(tt.b, tt.a); got != -tt.want {
+			t.Errorf("Natural(%q, %q) = %d, want %d", tt.b, tt.a, got, -tt.want)
+		}
+	}
+	fold := []struct {
+		a, b string
+		want int
+	}{
+		{"IMG_2.png", "img_10.PNG", -1},
+		{"Straße", "STRASSE", 1},
+		{"ÄPFEL1", "äpfel1", 0},
+		{"b", "A", 1},
+		{"a\xff", "a\xfe", 1},
+	}
+	for _, tt := range fold {
+		if got := NaturalFold(tt.a, tt.b); got != tt.want {
+			t.Errorf("NaturalFold(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestNaturalSort(t *testing.T) {
+	files := []string{"file10.txt", "file2.txt", "File1.txt", "file1.txt", "file02.txt", "file20.txt"}
+	sort.SliceStable(files, func(i, j int) bool { return NaturalFold(files[i], files[j]) < 0 })
+	if got, want := strings.Join(files, " "), "File1.txt file1.txt file2.txt file02.txt file10.txt file20.txt"; got != want {
+		t.Errorf("sorted = %s, want %s", got, want)
+	}
+	sort.Slice(files, func(i, j int) bool { return Reverse(Natural)(files[i], files[j]) < 0 })
+	if files[0] != "file20.txt" {
+		t.Errorf("Reverse(Natural) first = %s", files[0])
+	}
+}
+
+type artifact struct {
+	Name string `compare:"using=natural"`
+	Tags []string
+}
+
+func TestNaturalDeepCompare(t *testing.T) {
+	a, b := artifact{"build-9", []string{"v2"}}, artifact{"build-10", []string{"v10"}}
+	if r := Compare(a, b); r != -1 {
+		t.Errorf("Compare() with using=natural = %d, want -1", r)
+	}
+	if r := Compare(a.Tags, b.Tags); r != 1 {
+		t.Errorf("Compare() = %d, want 1", r)
+	}
+	if r := CompareWith(a.Tags, b.Tags, StringComparator(Natural)); r != -1 {
+		t.Errorf("CompareWith(StringComparator) = %d, want -1", r)
+	}
+	if r := CompareWith(map[string]Str{"k": "v2"}, map[string]Str{"k": "V10"}, StringComparator(NaturalFold)); r != -1 {
+		t.Errorf("CompareWith(StringComparator) on named type = %d, want -1", r)
+	}
+}
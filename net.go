@@ -0,0 +1,161 @@
+package comparator
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"net/netip"
+	"reflect"
+
+	"github.com/lmlat/go-comparator/typed"
+)
+
+/**
+ *
+ * @Author AiTao
+ * @Date 2026-10-21 14:30
+ * @Url
+ **/
+
+// netComparators 保存了网络地址类型对应的比较器, 深度比较时直接使用这些比较器, 而不是比较其内部字段.
+var netComparators = map[reflect.Type]Type{
+	reflect.TypeOf(net.IP(nil)):      IP,
+	reflect.TypeOf(net.IPNet{}):      IPNet,
+	reflect.TypeOf(netip.Addr{}):     Addr,
+	reflect.TypeOf(netip.AddrPort{}): AddrPort,
+	reflect.TypeOf(netip.Prefix{}):   Prefix,
+}
+
+// isNetType 函数用于判断 t 是否为 net.IP、net.IPNet 或 netip 包中的地址类型.
+func isNetType(t reflect.Type) bool {
+	_, ok := netComparators[t]
+	return ok
+}
+
+// compareNet 函数用于比较两个网络地址类型的值, 当 va 不是网络地址类型或者无法访问时返回 false.
+func compareNet(va, vb reflect.Value) (int, bool) {
+	cmp, ok := netComparators[va.Type()]
+	if !ok || va.Type() != vb.Type() || !va.CanInterface() || !vb.CanInterface() {
+		return invalid, false
+	}
+	return fromResult(cmp(va.Interface(), vb.Interface())), true
+}
+
+// IP 函数用于比较两个 net.IP 类型数据, IPv4 地址排在 IPv6 地址之前, 同一协议族的地址按照数值比较.
+// 4字节与16字节(IPv4-mapped IPv6)形式的 IPv4 地址视为相等. 值为 nil 或长度非法的地址排在最前面, 彼此之间按照字节比较.
+//
+// Example:
+// IP(net.ParseIP("10.0.0.2"), net.ParseIP("10.0.0.10")) 返回 -1
+// IP(net.IPv4(1, 2, 3, 4), net.IP{1, 2, 3, 4}) 返回 0
+func IP(x, y interface{}) int {
+	a, b := x.(net.IP), y.(net.IP)
+	if r := Addr(ipAddr(a), ipAddr(b)); r != 0 {
+		return r
+	}
+	if !ipAddr(a).IsValid() {
+		return bytes.Compare(a, b)
+	}
+	return 0
+}
+
+// ipAddr 函数用于将 net.IP 转换为 netip.Addr, 长度非法时返回零值.
+func ipAddr(ip net.IP) netip.Addr {
+	addr, _ := netip.AddrFromSlice(ip)
+	return addr.Unmap()
+}
+
+// IPNet 函数用于比较两个 net.IPNet 或 *net.IPNet 类型数据, 比较规则与 Prefix 相同, 值为 nil 的指针排在最前面.
+// 掩码不是连续的1时无法转换为前缀, 这样的网络排在其它网络之前, 彼此之间依次按照 IP 与掩码的字节比较.
+//
+// Example:
+// IPNet(cidr("10.0.0.0/8"), cidr("10.0.0.0/16")) 返回 -1
+func IPNet(x, y interface{}) int {
+	a, b := ipNetArg(x), ipNetArg(y)
+	if a == nil || b == nil {
+		return compareNil(a == nil, b == nil)
+	}
+	p, ok1 := ipNetPrefix(a)
+	q, ok2 := ipNetPrefix(b)
+	if ok1 && ok2 {
+		return Prefix(p, q)
+	}
+	if ok1 != ok2 {
+		return Bool(ok1, ok2)
+	}
+	if r := bytes.Compare(a.IP, b.IP); r != 0 {
+		return r
+	}
+	return bytes.Compare(a.Mask, b.Mask)
+}
+
+func ipNetArg(x interface{}) *net.IPNet {
+	switch v := x.(type) {
+	case net.IPNet:
+		return &v
+	case *net.IPNet:
+		return v
+	default:
+		panic(fmt.Sprintf("illegal argument: %T is not a net.IPNet", x))
+	}
+}
+
+// ipNetPrefix 函数用于将 net.IPNet 转换为 netip.Prefix, 掩码非法或者与 IP 的长度不匹配时返回 false.
+func ipNetPrefix(n *net.IPNet) (netip.Prefix, bool) {
+	ones, bits := n.Mask.Size()
+	addr, ok := netip.AddrFromSlice(n.IP)
+	if !ok || bits == 0 {
+		return netip.Prefix{}, false
+	}
+	if bits == 32 {
+		if addr = addr.Unmap(); !addr.Is4() {
+			return netip.Prefix{}, false
+		}
+	} else {
+		addr = netip.AddrFrom16(addr.As16())
+	}
+	return netip.PrefixFrom(addr, ones), true
+}
+
+// Addr 函数用于比较两个 netip.Addr 类型数据, 比较规则与 netip.Addr.Compare 相同: 零值排在最前面, IPv4 地址排在 IPv6 地址之前,
+// 其次按照数值与 zone 比较. 区别在于 IPv4-mapped IPv6 地址会被视为对应的 IPv4 地址.
+//
+// Example:
+// Addr(netip.MustParseAddr("::ffff:1.2.3.4"), netip.MustParseAddr("1.2.3.4")) 返回 0
+func Addr(x, y interface{}) int {
+	return x.(netip.Addr).Unmap().Compare(y.(netip.Addr).Unmap())
+}
+
+// AddrPort 函数用于比较两个 netip.AddrPort 类型数据, 先按照 Addr 的规则比较地址, 再比较端口.
+func AddrPort(x, y interface{}) int {
+	a, b := x.(netip.AddrPort), y.(netip.AddrPort)
+	if r := Addr(a.Addr(), b.Addr()); r != 0 {
+		return r
+	}
+	return typed.Compare(a.Port(), b.Port())
+}
+
+// Prefix 函数用于比较两个 netip.Prefix 类型数据, 先按照 Addr 的规则比较网络地址(即清除主机位之后的地址), 再比较前缀长度,
+// 因此同一网络中范围较大的前缀排在前面. 前缀长度不小于96的 IPv4-mapped IPv6 前缀会被视为对应的 IPv4 前缀, 非法的前缀排在最前面.
+//
+// Example:
+// Prefix(netip.MustParsePrefix("10.1.2.3/8"), netip.MustParsePrefix("10.0.0.0/8")) 返回 0
+// Prefix(netip.MustParsePrefix("10.0.0.0/8"), netip.MustParsePrefix("10.0.0.0/16")) 返回 -1
+func Prefix(x, y interface{}) int {
+	a, b := unmapPrefix(x.(netip.Prefix)), unmapPrefix(y.(netip.Prefix))
+	if a.IsValid() != b.IsValid() {
+		return Bool(a.IsValid(), b.IsValid())
+	}
+	if r := Addr(a.Addr(), b.Addr()); r != 0 {
+		return r
+	}
+	return typed.Compare(a.Bits(), b.Bits())
+}
+
+// unmapPrefix 函数用于清除前缀的主机位, 并将 IPv4-mapped IPv6 前缀转换为 IPv4 前缀.
+func unmapPrefix(p netip.Prefix) netip.Prefix {
+	p = p.Masked()
+	if addr := p.Addr(); addr.Is4In6() && p.Bits() >= 96 {
+		return netip.PrefixFrom(addr.Unmap(), p.Bits()-96)
+	}
+	return p
+}
@@ -0,0 +1,120 @@
+package comparator
+
+import (
+	"net"
+	"net/netip"
+	"sort"
+	"testing"
+)
+
+/**
+ *
+ * @Author AiTao
+ * @Date 2026-10-21 14:30
+ * @Url
+ **/
+
+func cidr(s string) *net.IPNet {
+	_, n, err := net.ParseCIDR(s)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func TestIP(t *testing.T) {
+	tests := []struct {
+		x, y net.IP
+		want int
+	}{
+		{net.ParseIP("10.0.0.2"), net.ParseIP("10.0.0.10"), -1},
+		{net.IPv4(1, 2, 3, 4), net.IP{1, 2, 3, 4}, 0},
+		{net.ParseIP("::ffff:1.2.3.4"), net.ParseIP("1.2.3.4").To4(), 0},
+		{net.ParseIP("255.255.255.255"), net.ParseIP("::1"), -1},
+		{net.ParseIP("::1"), net.ParseIP("::2"), -1},
+		{nil, net.ParseIP("0.0.0.0"), -1},
+		{nil, net.IP{}, 0},
+		{net.IP{1, 2}, net.IP{1, 3}, -1},
+		{net.IP{9, 9}, net.ParseIP("0.0.0.0"), -1},
+	}
+	for _, tt := range tests {
+		if got := IP(tt.x, tt.y); got != tt.want {
+			t.Errorf("IP(%v, %v) = %d, want %d", tt.x, tt.y, got, tt.want)
+		}
+		if got := IP(tt.y, tt.x); got != -tt.want {
+			t.Errorf("IP(%v, %v) = %d, want %d", tt.y, tt.x, got, -tt.want)
+		}
+	}
+}
+
+func TestAddrAndPrefix(t *testing.T) {
+	addr, prefix := netip.MustParseAddr, netip.MustParsePrefix
+	tests := []struct {
+		name string
+		cmp  Type
+		x, y interface{}
+		want int
+	}{
+		{"addr", Addr, addr("10.0.0.2"), addr("10.0.0.10"), -1},
+		{"addr mapped", Addr, addr("::ffff:1.2.3.4"), addr("1.2.3.4"), 0},
+		{"addr family", Addr, addr("::ffff:255.255.255.255"), addr("::1"), -1},
+		{"addr zero", Addr, netip.Addr{}, addr("0.0.0.0"), -1},
+		{"addr zone", Addr, addr("fe80::1"), addr("fe80::1%eth0"), -1},
+		{"addrport", AddrPort, netip.MustParseAddrPort("1.2.3.4:80"), netip.MustParseAddrPort("1.2.3.4:443"), -1},
+		{"addrport addr", AddrPort, netip.MustParseAddrPort("1.2.3.5:80"), netip.MustParseAddrPort("1.2.3.4:443"), 1},
+		{"addrport mapped", AddrPort, netip.MustParseAddrPort("[::ffff:1.2.3.4]:80"), netip.MustParseAddrPort("1.2.3.4:80"), 0},
+		{"prefix masked", Prefix, prefix("10.1.2.3/8"), prefix("10.0.0.0/8"), 0},
+		{"prefix bits", Prefix, prefix("10.0.0.0/8"), prefix("10.0.0.0/16"), -1},
+		{"prefix network", Prefix, prefix("10.0.0.0/16"), prefix("10.1.0.0/16"), -1},
+		{"prefix mapped", Prefix, prefix("::ffff:10.0.0.0/104"), prefix("10.0.0.0/8"), 0},
+		{"prefix mapped short", Prefix, prefix("::ffff:0:0/80"), prefix("10.0.0.0/8"), 1},
+		{"prefix invalid", Prefix, netip.Prefix{}, prefix("0.0.0.0/0"), -1},
+		{"ipnet", IPNet, cidr("10.0.0.0/8"), cidr("10.0.0.0/16"), -1},
+		{"ipnet value", IPNet, *cidr("10.0.0.0/8"), cidr("10.0.0.0/8"), 0},
+		{"ipnet mapped", IPNet, &net.IPNet{IP: net.ParseIP("10.0.0.0"), Mask: net.CIDRMask(8, 32)}, cidr("10.0.0.0/8"), 0},
+		{"ipnet v6", IPNet, cidr("10.0.0.0/8"), cidr("::/0"), -1},
+		{"ipnet nil", IPNet, (*net.IPNet)(nil), cidr("0.0.0.0/0"), -1},
+		{"ipnet invalid", IPNet, &net.IPNet{IP: net.IP{10, 0, 0, 0}, Mask: net.IPMask{255, 0, 255, 0}}, cidr("0.0.0.0/0"), -1},
+	}
+	for _, tt := range tests {
+		if got := tt.cmp(tt.x, tt.y); got != tt.want {
+			t.Errorf("%s: got %d, want %d", tt.name, got, tt.want)
+		}
+		if got := tt.cmp(tt.y, tt.x); got != -tt.want {
+			t.Errorf("%s (reversed): got %d, want %d", tt.name, got, -tt.want)
+		}
+	}
+}
+
+func TestCompareNetDefault(t *testing.T) {
+	type host struct {
+		Name string
+		IP   net.IP
+		Net  *net.IPNet
+		Addr netip.Addr
+	}
+	h1 := host{"a", net.IPv4(10, 0, 0, 1), cidr("10.0.0.0/8"), netip.MustParseAddr("::ffff:10.0.0.1")}
+	h2 := host{"a", net.IP{10, 0, 0, 1}, &net.IPNet{IP: net.IP{10, 9, 9, 9}, Mask: net.CIDRMask(8, 32)}, netip.MustParseAddr("10.0.0.1")}
+	if !Equals(h1, h2) {
+		t.Errorf("Equals(%v, %v) = false, want true", h1, h2)
+	}
+	if ds := Diff(h1, h2); len(ds) != 0 {
+		t.Errorf("Diff() = %v, want none", ds)
+	}
+	h2.IP = net.ParseIP("10.0.0.2")
+	if got := Compare(h1, h2); got != -1 {
+		t.Errorf("Compare() = %d, want -1", got)
+	}
+	if ds := Diff(h1, h2); len(ds) != 1 || ds[0].Path != ".IP" {
+		t.Errorf("Diff() = %v, want a single change at .IP", ds)
+	}
+
+	ips := []net.IP{net.ParseIP("::1"), net.ParseIP("10.0.0.10"), net.IP{10, 0, 0, 2}, net.ParseIP("10.0.0.2"), net.ParseIP("9.255.255.255")}
+	sort.SliceStable(ips, func(i, j int) bool { return Compare(ips[i], ips[j]) < 0 })
+	want := []string{"9.255.255.255", "10.0.0.2", "10.0.0.2", "10.0.0.10", "::1"}
+	for i, ip := range ips {
+		if ip.String() != want[i] {
+			t.Fatalf("sorted = %v, want %v", ips, want)
+		}
+	}
+}
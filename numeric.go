@@ -0,0 +1,243 @@
+package comparator
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"github.com/lmlat/go-comparator/typed"
+)
+
+/**
+ *
+ * @Author AiTao
+ * @Date 2026-10-20 10:20
+ * @Url
+ **/
+
+// NumericPromotion 返回一个允许不同数值类型之间相互比较的选项, 参与比较的值可以是任意有符号整数、无符号整数、浮点数
+// (包括以这些类型为底层类型的新类型)以及 json.Number、*big.Int、*big.Float、*big.Rat, 比较结果是精确的,
+// 不会因为转换为 float64 而丢失精度. 默认情况下, 不同类型的数值之间无法比较.
+//
+// 该选项只作用于动态类型可以不同的位置, 即顶层参数以及 interface{} 类型的元素、字段、map 值.
+// 静态类型不同的容器之间仍然无法比较, 例如 JSON 解码得到的 map[string]interface{} 与结构体比较时返回 ErrTypeMismatch,
+// 需要先将两者转换为相同的形式(例如都解码为 map[string]interface{}).
+//
+// Example:
+// CompareWith(int(3), float64(3.0), NumericPromotion()) 返回 0
+// EqualsWith([]interface{}{int64(1)}, []interface{}{1.0}, NumericPromotion()) 返回 true
+func NumericPromotion() Option {
+	return func(o *options) { o.numericPromotion = true }
+}
+
+// CompareNumbers 函数用于精确地比较两个任意类型的数值, 支持的类型参见 NumericPromotion. 当参数不是数值时触发 panic.
+//
+// Example:
+// CompareNumbers(uint64(math.MaxUint64), int64(-1)) 返回 1
+// CompareNumbers(int64(1<<53+1), float64(1<<53)) 返回 1
+func CompareNumbers(a, b interface{}) int {
+	r, ok := compareNumbers(reflect.ValueOf(a), reflect.ValueOf(b), newOptions(nil))
+	if !ok {
+		panic(fmt.Sprintf("illegal argument: unable to compare %T with %T as numbers", a, b))
+	}
+	return int(toOrdering(r))
+}
+
+// number 表示一个数值, kind 为 numInt、numUint、numFloat 时分别使用 i、u、f 保存数值, 为 numRat 时使用 r 保存数值.
+type number struct {
+	kind numKind
+	i    int64
+	u    uint64
+	f    float64
+	r    *big.Rat
+}
+
+type numKind int
+
+const (
+	numInt numKind = iota + 1
+	numUint
+	numFloat
+	numRat
+)
+
+var (
+	jsonNumberType = reflect.TypeOf(json.Number(""))
+	bigIntType     = reflect.TypeOf((*big.Int)(nil))
+	bigFloatType   = reflect.TypeOf((*big.Float)(nil))
+	bigRatType     = reflect.TypeOf((*big.Rat)(nil))
+)
+
+// numberOf 函数用于将 v 转换为 number, 当 v 不是数值时返回 false.
+func numberOf(v reflect.Value) (number, bool) {
+	if !v.IsValid() {
+		return number{}, false
+	}
+	switch v.Type() {
+	case bigIntValueType, bigFloatValueType, bigRatValueType:
+		p, ok := pointerOf(v)
+		if !ok {
+			return number{}, false
+		}
+		return numberOf(p)
+	case jsonNumberType:
+		// big.Rat 的 SetString 方法还接受 "0x10"、"1/3" 等格式, 因此需要先检查是否符合 JSON 的数值语法
+		if !isJSONNumber(v.String()) {
+			return number{}, false
+		}
+		r, ok := new(big.Rat).SetString(v.String())
+		if !ok {
+			return number{}, false
+		}
+		return number{kind: numRat, r: r}, true
+	case bigIntType, bigFloatType, bigRatType:
+		if v.IsNil() {
+			return number{}, false
+		}
+		if !v.CanInterface() {
+			return numberOf(v.Elem())
+		}
+		switch x := v.Interface().(type) {
+		case *big.Int:
+			return number{kind: numRat, r: new(big.Rat).SetInt(x)}, true
+		case *big.Float:
+			if x.IsInf() {
+				return number{kind: numFloat, f: math.Inf(x.Sign())}, true
+			}
+			r, _ := x.Rat(nil)
+			return number{kind: numRat, r: r}, true
+		case *big.Rat:
+			return number{kind: numRat, r: x}, true
+		}
+	}
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return number{kind: numInt, i: v.Int()}, true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return number{kind: numUint, u: v.Uint()}, true
+	case reflect.Float32, reflect.Float64:
+		return number{kind: numFloat, f: v.Float()}, true
+	}
+	return number{}, false
+}
+
+// isJSONNumber 函数用于判断 s 是否符合 JSON 的数值语法: -?(0|[1-9][0-9]*)(.[0-9]+)?([eE][+-]?[0-9]+)?
+func isJSONNumber(s string) bool {
+	if s != "" && s[0] == '-' {
+		s = s[1:]
+	}
+	switch {
+	case s == "":
+		return false
+	case s[0] == '0':
+		s = s[1:]
+	case isDigit(s[0]):
+		s = strings.TrimLeft(s, "0123456789")
+	default:
+		return false
+	}
+	if s != "" && s[0] == '.' {
+		frac := strings.TrimLeft(s[1:], "0123456789")
+		if len(frac) == len(s)-1 {
+			return false
+		}
+		s = frac
+	}
+	if s != "" && (s[0] == 'e' || s[0] == 'E') {
+		s = s[1:]
+		if s != "" && (s[0] == '+' || s[0] == '-') {
+			s = s[1:]
+		}
+		exp := strings.TrimLeft(s, "0123456789")
+		if len(exp) == len(s) {
+			return false
+		}
+		s = exp
+	}
+	return s == ""
+}
+
+// rat 函数用于将有限的数值转换为 *big.Rat.
+func (n number) rat() *big.Rat {
+	switch n.kind {
+	case numInt:
+		return new(big.Rat).SetInt64(n.i)
+	case numUint:
+		return new(big.Rat).SetInt(new(big.Int).SetUint64(n.u))
+	case numFloat:
+		return new(big.Rat).SetFloat64(n.f)
+	default:
+		return n.r
+	}
+}
+
+// float 函数用于获取数值的近似 float64 值, 仅用于处理 NaN 与无穷大.
+func (n number) float() float64 {
+	switch n.kind {
+	case numInt:
+		return float64(n.i)
+	case numUint:
+		return float64(n.u)
+	case numFloat:
+		return n.f
+	default:
+		f, _ := n.r.Float64()
+		return f
+	}
+}
+
+// isBigNumber 函数用于判断 t 是否为需要按照数值比较的 json.Number 或 math/big 中的数值类型.
+func isBigNumber(t reflect.Type) bool {
+	return t == jsonNumberType || t == bigIntType || t == bigFloatType || t == bigRatType
+}
+
+// promoteNumbers 函数用于在指定 NumericPromotion 选项时比较两个不同类型的数值, 以及 json.Number 等需要按照数值比较的类型.
+func (o *options) promoteNumbers(va, vb reflect.Value) (int, bool) {
+	if !o.numericPromotion || va.Type() == vb.Type() && !isBigNumber(va.Type()) {
+		return invalid, false
+	}
+	return compareNumbers(va, vb, o)
+}
+
+// compareNumbers 函数用于精确地比较两个数值, 当 va 或 vb 不是数值时返回 false.
+// NaN 的处理方式与浮点数相同, 受 NaNsFirst、NaNsLast、FloatComparator 选项的影响.
+func compareNumbers(va, vb reflect.Value, o *options) (int, bool) {
+	x, ok1 := numberOf(va)
+	y, ok2 := numberOf(vb)
+	if !ok1 || !ok2 {
+		return invalid, false
+	}
+	switch {
+	case x.kind == numInt && y.kind == numInt:
+		return fromResult(typed.Compare(x.i, y.i)), true
+	case x.kind == numUint && y.kind == numUint:
+		return fromResult(typed.Compare(x.u, y.u)), true
+	case x.kind == numInt && y.kind == numUint:
+		if x.i < 0 {
+			return less, true
+		}
+		return fromResult(typed.Compare(uint64(x.i), y.u)), true
+	case x.kind == numUint && y.kind == numInt:
+		if y.i < 0 {
+			return greater, true
+		}
+		return fromResult(typed.Compare(x.u, uint64(y.i))), true
+	}
+	if x.kind == numFloat && y.kind == numFloat {
+		return o.compareFloats(x.f, y.f, false), true
+	}
+	// NaN 与无穷大无法转换为 *big.Rat, 需要单独处理
+	if xf, yf := x.float(), y.float(); math.IsNaN(xf) || math.IsNaN(yf) {
+		return o.compareFloats(xf, yf, false), true
+	}
+	switch {
+	case x.kind == numFloat && math.IsInf(x.f, 0):
+		return fromResult(int(math.Copysign(1, x.f))), true
+	case y.kind == numFloat && math.IsInf(y.f, 0):
+		return fromResult(-int(math.Copysign(1, y.f))), true
+	}
+	return fromResult(x.rat().Cmp(y.rat())), true
+}
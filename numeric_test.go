@@ -0,0 +1,148 @@
+package comparator
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+/**
+ *
+ * @Author AiTao
+ * @Date 2026-10-20 10:20
+ * @Url
+ **/
+
+func TestCompareNumbers(t *testing.T) {
+	type celsius float64
+	huge, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+	tests := []struct {
+		a, b interface{}
+		want int
+	}{
+		{3, 3.0, 0},
+		{int32(3), int64(3), 0},
+		{int8(-1), uint8(255), -1},
+		{uint64(math.MaxUint64), int64(-1), 1},
+		{int64(-1), uint64(math.MaxUint64), -1},
+		{uint64(math.MaxUint64), float64(math.MaxUint64), -1}, // float64(math.MaxUint64) 为 2^64
+		{int64(1<<53 + 1), float64(1 << 53), 1},
+		{int64(math.MaxInt64), float64(math.MaxInt64), -1},
+		{float32(0.1), 0.1, 1},
+		{celsius(36.6), 36.6, 0},
+		{json.Number("10"), 9, 1},
+		{json.Number("1e2"), uint8(100), 0},
+		{json.Number("12345678901234567891"), uint64(12345678901234567890), 1},
+		{huge, math.Inf(1), -1},
+		{huge, math.Inf(-1), 1},
+		{math.Inf(1), huge, 1},
+		{huge, 1.2345678901234568e29, 1},
+		{big.NewRat(1, 3), 0.3333333333333333, 1},
+		{big.NewFloat(2.5), big.NewRat(5, 2), 0},
+		{new(big.Float).SetInf(true), int64(math.MinInt64), -1},
+		{math.NaN(), 1, 1},
+		{-0.0, 0, 0},
+	}
+	for _, tt := range tests {
+		if got := CompareNumbers(tt.a, tt.b); got != tt.want {
+			t.Errorf("CompareNumbers(%v(%T), %v(%T)) = %d, want %d", tt.a, tt.a, tt.b, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestCompareNumbersPanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("CompareNumbers(1, \"1\") did not panic")
+		}
+	}()
+	CompareNumbers(1, "1")
+}
+
+func TestJSONNumberGrammar(t *testing.T) {
+	valid := []string{"0", "-0", "10", "1.5", "-0.25", "1e2", "1E+2", "2.5e-3", "12345678901234567891"}
+	invalid := []string{"", "-", "0x10", "1/3", "0b1", "0o7", "01", "+1", ".5", "1.", "1e", "1e+", " 1", "1_000", "Inf", "NaN"}
+	for _, s := range valid {
+		if !isJSONNumber(s) {
+			t.Errorf("isJSONNumber(%q) = false, want true", s)
+		}
+	}
+	for _, s := range invalid {
+		if isJSONNumber(s) {
+			t.Errorf("isJSONNumber(%q) = true, want false", s)
+		}
+		if _, ok := numberOf(reflect.ValueOf(json.Number(s))); ok {
+			t.Errorf("numberOf(json.Number(%q)) = true, want false", s)
+		}
+	}
+	// 不符合 JSON 数值语法的 json.Number 不会被当作数值, 与其它数值类型之间类型不一致
+	if r, err := compareWith(json.Number("0x10"), 16, newOptions([]Option{NumericPromotion()})); r != Incomparable || !errors.Is(err, ErrTypeMismatch) {
+		t.Errorf("compareWith(json.Number(0x10), 16) = %v, %v, want Incomparable, ErrTypeMismatch", r, err)
+	}
+	if EqualsWith(json.Number("0x10"), 16, NumericPromotion()) {
+		t.Error("EqualsWith(json.Number(0x10), 16) = true, want false")
+	}
+	if EqualsWith(json.Number("1/3"), json.Number("2/6"), NumericPromotion()) {
+		t.Error("EqualsWith(json.Number(1/3), json.Number(2/6)) = true, want false")
+	}
+}
+
+func TestNumericPromotion(t *testing.T) {
+	type order struct {
+		ID    int64
+		Total float64
+		Items []interface{}
+	}
+	var decoded map[string]interface{}
+	if err := json.Unmarshal([]byte(`{"ID": 42, "Total": 9.5, "Items": [1, 2.5]}`), &decoded); err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]interface{}{"ID": int64(42), "Total": float32(9.5), "Items": []interface{}{1, 2.5}}
+	if Equals(decoded, expected) {
+		t.Error("Equals = true, want false")
+	}
+	if !EqualsWith(decoded, expected, NumericPromotion()) {
+		t.Error("EqualsWith(NumericPromotion) = false, want true")
+	}
+	if got := CompareWith(3, 3.5, NumericPromotion()); got != -1 {
+		t.Errorf("CompareWith(3, 3.5) = %d, want -1", got)
+	}
+	if !EqualsWith([]interface{}{int32(1), uint(2)}, []interface{}{int64(1), 2.0}, NumericPromotion()) {
+		t.Error("EqualsWith(slices) = false, want true")
+	}
+	// 只有 interface{} 类型的位置才会进行数值提升, map[string]interface{} 与结构体之间仍然类型不一致
+	if r, err := compareWith(decoded, order{ID: 42, Total: 9.5, Items: []interface{}{1, 2.5}}, newOptions([]Option{NumericPromotion()})); r != Incomparable || !errors.Is(err, ErrTypeMismatch) {
+		t.Errorf("compareWith(map, struct) = %v, %v, want Incomparable, ErrTypeMismatch", r, err)
+	}
+	if !EqualsWith([]interface{}{decoded}, []interface{}{expected}, NumericPromotion()) {
+		t.Error("EqualsWith(nested interface slices) = false, want true")
+	}
+	if EqualsWith(map[string]interface{}{"n": int8(1)}, map[string]interface{}{"n": 1.5}, NumericPromotion()) {
+		t.Error("EqualsWith(1, 1.5) = true, want false")
+	}
+
+	dec := json.NewDecoder(strings.NewReader(`{"ID": 42, "Total": 10}`))
+	dec.UseNumber()
+	var numbers map[string]interface{}
+	if err := dec.Decode(&numbers); err != nil {
+		t.Fatal(err)
+	}
+	if got := CompareWith(numbers["Total"], json.Number("9"), NumericPromotion()); got != 1 {
+		t.Errorf("CompareWith(json.Number) = %d, want 1", got)
+	}
+	if !EqualsWith(numbers["ID"], order{}.ID+42, NumericPromotion()) {
+		t.Error("EqualsWith(json.Number, int64) = false, want true")
+	}
+	if !EqualsWith(json.Number("1.0"), json.Number("1"), NumericPromotion()) || Equals(json.Number("1.0"), json.Number("1")) {
+		t.Error("json.Number should be compared as numbers only with NumericPromotion")
+	}
+	// 相同类型的浮点数仍然使用 FloatComparator
+	x := 0.1
+	if !EqualsWith(order{Total: x + 0.2}, order{Total: 0.3}, NumericPromotion(), FloatComparator(Approx(1e-9, 0))) {
+		t.Error("EqualsWith(FloatComparator) = false, want true")
+	}
+}
@@ -0,0 +1,271 @@
+package comparator
+
+import (
+	"math"
+	"math/cmplx"
+	"reflect"
+	"sort"
+	"time"
+	"unsafe"
+)
+
+/**
+ *
+ * @Author AiTao
+ * @Date 2026-10-16 18:30
+ * @Url
+ **/
+
+// Option 用于配置深度比较的行为, 可以传递给 CompareWith、EqualsWith 等函数.
+type Option func(*options)
+
+type options struct {
+	ignoreFields     map[string]bool
+	ignoreUnexported bool
+	equateEmpty      bool
+	equateNaNs       bool
+	ignoreMapEntries []func(k, v interface{}) bool
+	ignoreSliceOrder bool
+	maxDepth         int
+	registry         *Registry
+	compareString    Type
+	compareFloat     Type
+	numericPromotion bool
+	nanOrder         int            // NaN 的位置, -1 表示排在最前面, 其它值表示排在最后面(默认)
+	timeTolerance    time.Duration  // 时间差不超过该值时视为相等
+	timeTruncate     time.Duration  // 比较之前将时间截断到该值的整数倍
+	strictLocation   bool           // 同一时刻但时区不同的时间是否视为不相等
+	ignoreMonotonic  bool           // 是否忽略单调时钟读数
+	depth            int            // 当前递归比较的深度
+	visiting         map[visit]bool // 正在比较的引用类型值, 用于检测循环引用
+	sequence         bool           // 差异比较时是否使用 Myers 差异算法比较切片
+}
+
+func newOptions(opts []Option) *options {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// IgnoreFields 返回一个忽略指定结构体字段的选项, 字段名的格式为"类型名.字段名"(例如 "User.UpdatedAt"),
+// 当省略类型名时将忽略所有结构体中的同名字段.
+func IgnoreFields(names ...string) Option {
+	return func(o *options) {
+		if o.ignoreFields == nil {
+			o.ignoreFields = make(map[string]bool, len(names))
+		}
+		for _, name := range names {
+			o.ignoreFields[name] = true
+		}
+	}
+}
+
+// IgnoreUnexported 返回一个忽略结构体中不可导出字段的选项.
+func IgnoreUnexported() Option {
+	return func(o *options) { o.ignoreUnexported = true }
+}
+
+// EquateEmpty 返回一个将值为 nil 的切片(map)与长度为0的切片(map)视为相等的选项.
+// 默认情况下, 值为 nil 的切片(map)小于长度为0的切片(map).
+func EquateEmpty() Option {
+	return func(o *options) { o.equateEmpty = true }
+}
+
+// EquateNaNs 返回一个将两个实部或虚部为 NaN 的复数视为相等的选项.
+// 浮点数之间无需指定该选项, 两个 NaN 始终视为相等, 参见 NaNsFirst、NaNsLast.
+func EquateNaNs() Option {
+	return func(o *options) { o.equateNaNs = true }
+}
+
+// NaNsFirst 返回一个将 NaN 视为小于任何数值的选项, 两个 NaN 之间视为相等.
+// 默认情况下 NaN 大于任何数值, 与 NaNsLast、TotalOrder 相同, 从而满足排序所需的全序关系.
+func NaNsFirst() Option {
+	return func(o *options) { o.nanOrder = -1 }
+}
+
+// NaNsLast 返回一个将 NaN 视为大于任何数值的选项, 两个 NaN 之间视为相等(默认).
+func NaNsLast() Option {
+	return func(o *options) { o.nanOrder = 1 }
+}
+
+// FloatComparator 返回一个使用 cmp 比较浮点数(包括底层类型为 float32、float64 的新类型)的选项,
+// cmp 接收的参数类型与值的底层类型一致, 即 float32 或 float64. 指定该选项后 NaNsFirst、NaNsLast 选项不再生效.
+//
+// Example:
+// EqualsWith(p1, p2, FloatComparator(Approx(1e-9, 1e-6)))
+// CompareWith(s1, s2, FloatComparator(WithinULP(4)))
+func FloatComparator(cmp Type) Option {
+	return func(o *options) { o.compareFloat = cmp }
+}
+
+// IgnoreMapEntries 返回一个忽略 map 中指定键值对的选项, 当 ignore 返回 true 时, 对应的键值对不参与比较.
+//
+// Example:
+// IgnoreMapEntries(func(k, v interface{}) bool { return strings.HasPrefix(k.(string), "_") })
+func IgnoreMapEntries(ignore func(k, v interface{}) bool) Option {
+	return func(o *options) { o.ignoreMapEntries = append(o.ignoreMapEntries, ignore) }
+}
+
+// IgnoreSliceOrder 返回一个忽略切片中元素顺序的选项, 切片中的元素会在排序之后再进行比较.
+func IgnoreSliceOrder() Option {
+	return func(o *options) { o.ignoreSliceOrder = true }
+}
+
+// MaxDepth 返回一个限制递归比较深度的选项, 超出深度 n 的结构体、数组、切片、map 均被视为相等.
+func MaxDepth(n int) Option {
+	return func(o *options) { o.maxDepth = n }
+}
+
+// StringComparator 返回一个使用 cmp 比较字符串(包括底层类型为 string 的新类型)的选项, 默认按照字节比较.
+//
+// Example:
+// CompareWith(files1, files2, StringComparator(Natural))
+func StringComparator(cmp Type) Option {
+	return func(o *options) { o.compareString = cmp }
+}
+
+// WithinDuration 返回一个近似比较时间的选项, 两个时间相差不超过 d 时视为相等. 该选项同样适用于 TimeWith 函数.
+// 注意近似相等不具有传递性, 用于排序时可能得到不确定的结果.
+//
+// Example:
+// EqualsWith(e1, e2, WithinDuration(time.Second))
+func WithinDuration(d time.Duration) Option {
+	return func(o *options) {
+		if d > 0 {
+			o.timeTolerance = d
+		}
+	}
+}
+
+// TruncateTo 返回一个在比较之前按照 time.Time.Truncate 将时间截断到 d 的整数倍的选项, d 不大于0时不作截断.
+// 该选项同样适用于 TimeWith 函数.
+//
+// Example:
+// EqualsWith(r1, r2, TruncateTo(time.Millisecond)) 用于比较经过数据库往返之后精度降低的时间
+func TruncateTo(d time.Duration) Option {
+	return func(o *options) { o.timeTruncate = d }
+}
+
+// StrictLocation 返回一个区分时区的选项, 默认情况下同一时刻的时间视为相等, 指定该选项后同一时刻但时区不同的时间
+// 按照时区名称以及 UTC 偏移量比较. 该选项同样适用于 TimeWith 函数.
+func StrictLocation() Option {
+	return func(o *options) { o.strictLocation = true }
+}
+
+// IgnoreMonotonic 返回一个忽略单调时钟读数的选项, 默认情况下与 time.Time.Compare 相同, 两个时间均带有单调时钟读数时使用读数进行比较.
+// 该选项同样适用于 TimeWith 函数.
+func IgnoreMonotonic() Option {
+	return func(o *options) { o.ignoreMonotonic = true }
+}
+
+// ignoreField 函数用于判断结构体 t 中的字段 f 是否需要被忽略.
+func (o *options) ignoreField(t reflect.Type, f reflect.StructField) bool {
+	if o.ignoreUnexported && !f.IsExported() {
+		return true
+	}
+	if len(o.ignoreFields) == 0 {
+		return false
+	}
+	return o.ignoreFields[f.Name] || o.ignoreFields[t.Name()+"."+f.Name]
+}
+
+// ignoreEntry 函数用于判断 map 中的键值对是否需要被忽略.
+func (o *options) ignoreEntry(k, v interface{}) bool {
+	for _, ignore := range o.ignoreMapEntries {
+		if ignore(k, v) {
+			return true
+		}
+	}
+	return false
+}
+
+// compareFloats 函数用于按照选项比较两个浮点数, f32 表示 x、y 的底层类型为 float32, 此时以 float32 类型传递给 FloatComparator.
+func (o *options) compareFloats(x, y float64, f32 bool) int {
+	if o.compareFloat != nil {
+		if f32 {
+			return fromResult(o.compareFloat(float32(x), float32(y)))
+		}
+		return fromResult(o.compareFloat(x, y))
+	}
+	// NaN 之间相等, 默认排在所有数值之后
+	if xn, yn := math.IsNaN(x), math.IsNaN(y); xn || yn {
+		switch {
+		case xn && yn:
+			return equal
+		case xn == (o.nanOrder < 0):
+			return less
+		default:
+			return greater
+		}
+	}
+	switch {
+	case x == y:
+		return equal
+	case x < y:
+		return less
+	default:
+		return greater
+	}
+}
+
+// equalComplexNaNs 函数用于判断在指定 EquateNaNs 选项时 x、y 是否均为 NaN.
+func (o *options) equalComplexNaNs(x, y complex128) bool {
+	return o.equateNaNs && cmplx.IsNaN(x) && cmplx.IsNaN(y)
+}
+
+// enter 函数用于进入下一层递归比较, 当超出最大深度时返回 false.
+func (o *options) enter() bool {
+	if o.maxDepth > 0 && o.depth >= o.maxDepth {
+		return false
+	}
+	o.depth++
+	return true
+}
+
+func (o *options) leave() {
+	o.depth--
+}
+
+// visit 记录了一对正在比较的指针、切片或 map.
+type visit struct {
+	x, y unsafe.Pointer
+	t    reflect.Type
+}
+
+// visit 函数用于记录一对正在比较的指针、切片或 map, 当这对值已经处于比较过程中(即存在循环引用)时返回 false.
+// 与 reflect.DeepEqual 不同, 比较结束后需要调用 unvisit 函数移除记录, 因为同一对值在其它位置上可能会得到不同的比较结果.
+func (o *options) visit(va, vb reflect.Value) (visit, bool) {
+	k := visit{va.UnsafePointer(), vb.UnsafePointer(), va.Type()}
+	if o.visiting[k] {
+		return k, false
+	}
+	if o.visiting == nil {
+		o.visiting = make(map[visit]bool)
+	}
+	o.visiting[k] = true
+	return k, true
+}
+
+func (o *options) unvisit(k visit) {
+	delete(o.visiting, k)
+}
+
+// typeName 函数用于获取值的类型名称, 当值之间无法比较时用于保证排序结果的稳定.
+func typeName(v reflect.Value) string {
+	if !v.IsValid() {
+		return ""
+	}
+	return v.Type().String()
+}
+
+// sortedIndices 函数用于获取按照 less 排序后的下标序列.
+func sortedIndices(n int, less func(i, j int) bool) []int {
+	idx := make([]int, n)
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(i, j int) bool { return less(idx[i], idx[j]) })
+	return idx
+}
@@ -0,0 +1,296 @@
+package comparator
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+	"unsafe"
+
+	"github.com/lmlat/go-comparator/typed"
+)
+
+/**
+ *
+ * @Author AiTao
+ * @Date 2026-10-16 19:40
+ * @Url
+ **/
+
+type account struct {
+	ID        int
+	Name      string
+	Scores    []float64
+	Labels    map[string]string
+	UpdatedAt time.Time
+	Nested    *account
+	secret    []string
+}
+
+func TestCompareWith(t *testing.T) {
+	now := time.Now()
+	a1 := account{ID: 1, Name: "aitao", UpdatedAt: now, secret: []string{"x"}}
+	a2 := account{ID: 1, Name: "aitao", UpdatedAt: now.Add(time.Second), secret: []string{"y"}}
+	if EqualsWith(a1, a2, IgnoreUnexported()) {
+		t.Error("EqualsWith(IgnoreUnexported) = true, want false")
+	}
+	if !EqualsWith(a1, a2, IgnoreUnexported(), IgnoreFields("account.UpdatedAt")) {
+		t.Error("EqualsWith(IgnoreUnexported, IgnoreFields) = false, want true")
+	}
+	if !EqualsWith(a1, a2, IgnoreFields("UpdatedAt", "secret")) {
+		t.Error("EqualsWith(IgnoreFields) = false, want true")
+	}
+	if r := CompareWith(a1, a2, IgnoreFields("secret")); r != -1 {
+		t.Errorf("CompareWith() = %d, want -1", r)
+	}
+}
+
+func TestEquateEmpty(t *testing.T) {
+	a1 := account{Scores: nil, Labels: map[string]string{}}
+	a2 := account{Scores: []float64{}, Labels: nil}
+	if Equals(a1, a2) {
+		t.Error("Equals(nil, empty) = true, want false")
+	}
+	if r := Compare([]int(nil), []int{}); r != -1 {
+		t.Errorf("Compare(nil, empty) = %d, want -1", r)
+	}
+	if !EqualsWith(a1, a2, EquateEmpty()) {
+		t.Error("EqualsWith(EquateEmpty) = false, want true")
+	}
+}
+
+func TestEquateNaNs(t *testing.T) {
+	// 浮点数中的 NaN 默认相等, EquateNaNs 只影响复数
+	s1, s2 := []float64{1, math.NaN()}, []float64{1, math.NaN()}
+	if !Equals(s1, s2) || !Equals(account{Scores: s1}, account{Scores: s2}) {
+		t.Error("Equals(NaN, NaN) = false, want true")
+	}
+	c1, c2 := []complex128{1, complex(math.NaN(), 0)}, []complex128{1, complex(0, math.NaN())}
+	if Equals(c1, c2) {
+		t.Error("Equals(complex NaN) = true, want false")
+	}
+	if !EqualsWith(c1, c2, EquateNaNs()) {
+		t.Error("EqualsWith(EquateNaNs) = false, want true")
+	}
+}
+
+func TestNaNDefaultOrder(t *testing.T) {
+	nan := math.NaN()
+	tests := []struct {
+		a, b interface{}
+		opts []Option
+		want int
+	}{
+		{nan, 1.0, nil, 1},
+		{nan, math.Inf(1), nil, 1},
+		{nan, nan, nil, 0},
+		{float32(nan), float32(-1), nil, 1},
+		{[]float64{nan, 1}, []float64{nan, 2}, nil, -1},
+		{nan, 1, []Option{NumericPromotion()}, 1},
+		{nan, int64(math.MaxInt64), []Option{NumericPromotion()}, 1},
+		{nan, float32(nan), []Option{NumericPromotion()}, 0},
+	}
+	for _, tt := range tests {
+		// 交换参数后结果应当取反
+		if got := CompareWith(tt.a, tt.b, tt.opts...); got != tt.want {
+			t.Errorf("CompareWith(%v, %v) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+		if got := CompareWith(tt.b, tt.a, tt.opts...); got != -tt.want {
+			t.Errorf("CompareWith(%v, %v) = %d, want %d", tt.b, tt.a, got, -tt.want)
+		}
+	}
+	s := []float64{3, nan, 1, nan, 2}
+	sort.Slice(s, func(i, j int) bool { return Compare(s[i], s[j]) < 0 })
+	if s[0] != 1 || s[1] != 2 || s[2] != 3 || !math.IsNaN(s[3]) || !math.IsNaN(s[4]) {
+		t.Errorf("sorted = %v, want [1 2 3 NaN NaN]", s)
+	}
+}
+
+func TestNaNsFirstLast(t *testing.T) {
+	nan := math.NaN()
+	tests := []struct {
+		opt  Option
+		a, b interface{}
+		want int
+	}{
+		{NaNsFirst(), nan, math.Inf(-1), -1},
+		{NaNsFirst(), 1.0, nan, 1},
+		{NaNsFirst(), nan, nan, 0},
+		{NaNsLast(), nan, math.Inf(1), 1},
+		{NaNsLast(), float32(1), float32(nan), -1},
+		{NaNsLast(), []float64{1, nan}, []float64{1, nan}, 0},
+		{NaNsLast(), []float64{nan, 1}, []float64{2, 1}, 1},
+	}
+	for _, tt := range tests {
+		if got := CompareWith(tt.a, tt.b, tt.opt); got != tt.want {
+			t.Errorf("CompareWith(%v, %v) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestFloatComparator(t *testing.T) {
+	type point struct {
+		X, Y float64
+		Z    float32
+	}
+	x, z := 0.1, float32(0.1)
+	p1 := point{X: x + 0.2, Y: 1, Z: z + 0.2}
+	p2 := point{X: 0.3, Y: 1, Z: 0.3}
+	if Equals(p1, p2) {
+		t.Error("Equals = true, want false")
+	}
+	if !EqualsWith(p1, p2, FloatComparator(Approx(1e-9, 0))) {
+		t.Error("EqualsWith(Approx) = false, want true")
+	}
+	if !EqualsWith(p1, p2, FloatComparator(WithinULP(1))) {
+		t.Error("EqualsWith(WithinULP) = false, want true")
+	}
+	if got := CompareWith(point{X: 1}, point{X: 2}, FloatComparator(Approx(0.5, 0))); got != -1 {
+		t.Errorf("CompareWith(Approx) = %d, want -1", got)
+	}
+	if got := CompareWith([]float64{math.Copysign(0, -1)}, []float64{0}, FloatComparator(TotalFloat64)); got != -1 {
+		t.Errorf("CompareWith(TotalFloat64) = %d, want -1", got)
+	}
+}
+
+func TestIgnoreMapEntries(t *testing.T) {
+	ignore := IgnoreMapEntries(func(k, v interface{}) bool { return strings.HasPrefix(k.(string), "_") })
+	m1 := map[string]int{"a": 1, "_ts": 100}
+	m2 := map[string]int{"a": 1, "_ts": 200, "_id": 3}
+	if Equals(m1, m2) || !EqualsWith(m1, m2, ignore) {
+		t.Error("IgnoreMapEntries on map[string]int failed")
+	}
+	a1 := account{Labels: map[string]string{"k": "v", "_x": "1"}}
+	a2 := account{Labels: map[string]string{"k": "v"}}
+	if !EqualsWith(a1, a2, ignore) {
+		t.Error("IgnoreMapEntries on struct field failed")
+	}
+}
+
+func TestIgnoreSliceOrder(t *testing.T) {
+	if Equals([]int{3, 1, 2}, []int{1, 2, 3}) || !EqualsWith([]int{3, 1, 2}, []int{1, 2, 3}, IgnoreSliceOrder()) {
+		t.Error("IgnoreSliceOrder on []int failed")
+	}
+	s1 := []interface{}{"a", 1, true}
+	s2 := []interface{}{true, "a", 1}
+	if !EqualsWith(s1, s2, IgnoreSliceOrder()) {
+		t.Error("IgnoreSliceOrder on []interface{} failed")
+	}
+	a1 := []account{{ID: 2}, {ID: 1}}
+	a2 := []account{{ID: 1}, {ID: 2}}
+	if !EqualsWith(a1, a2, IgnoreSliceOrder()) {
+		t.Error("IgnoreSliceOrder on []account failed")
+	}
+}
+
+func TestMaxDepth(t *testing.T) {
+	a1 := account{ID: 1, Nested: &account{ID: 2, Nested: &account{ID: 3}}}
+	a2 := account{ID: 1, Nested: &account{ID: 2, Nested: &account{ID: 4}}}
+	if Equals(a1, a2) {
+		t.Error("Equals() = true, want false")
+	}
+	if !EqualsWith(a1, a2, MaxDepth(2)) {
+		t.Error("EqualsWith(MaxDepth(2)) = false, want true")
+	}
+	if EqualsWith(a1, a2, MaxDepth(3)) {
+		t.Error("EqualsWith(MaxDepth(3)) = true, want false")
+	}
+}
+
+func TestCompareNestedArray(t *testing.T) {
+	type s struct{ A [2]int }
+	if r := Compare(s{[2]int{1, 2}}, s{[2]int{1, 3}}); r != -1 {
+		t.Errorf("Compare() = %d, want -1", r)
+	}
+}
+
+func TestTimeOptions(t *testing.T) {
+	type event struct {
+		Name string
+		At   time.Time
+		at   time.Time
+	}
+	base := time.Date(2024, 5, 6, 7, 8, 9, 123456789, time.UTC)
+	e1 := event{"a", base, base}
+	e2 := event{"a", base.Add(400 * time.Microsecond), base}
+	if Equals(e1, e2) {
+		t.Error("Equals() = true, want false")
+	}
+	if !EqualsWith(e1, e2, TruncateTo(time.Millisecond)) {
+		t.Error("EqualsWith(TruncateTo) = false, want true")
+	}
+	if !EqualsWith(e1, e2, WithinDuration(time.Millisecond)) {
+		t.Error("EqualsWith(WithinDuration) = false, want true")
+	}
+	e2.At = base.Add(2 * time.Millisecond)
+	if r := CompareWith(e1, e2, WithinDuration(time.Millisecond)); r != -1 {
+		t.Errorf("CompareWith(WithinDuration) = %d, want -1", r)
+	}
+	// 不可导出字段中的时间同样受选项的影响
+	e3 := event{"a", base, base.Add(time.Microsecond)}
+	if r := Compare(e1, e3); r != -1 {
+		t.Errorf("Compare(unexported) = %d, want -1", r)
+	}
+	if !EqualsWith(e1, e3, TruncateTo(time.Millisecond)) {
+		t.Error("EqualsWith(unexported, TruncateTo) = false, want true")
+	}
+
+	local := event{"a", base.In(time.FixedZone("CEST", 2*3600)), base}
+	if !Equals(e1, local) {
+		t.Error("Equals(same instant) = false, want true")
+	}
+	if EqualsWith(e1, local, StrictLocation()) {
+		t.Error("EqualsWith(StrictLocation) = true, want false")
+	}
+
+	// 两个时间的墙上时钟相同, 单调时钟读数不同
+	t1 := time.Now()
+	t2 := withMonotonic(t1, time.Second)
+	if !t1.Round(0).Equal(t2.Round(0)) || t1.Equal(t2) {
+		t.Fatal("withMonotonic should only change the monotonic clock reading")
+	}
+	if Equals(t1, t2) {
+		t.Error("Equals(different monotonic readings) = true, want false")
+	}
+	if r := Compare(t1, t2); r != -1 {
+		t.Errorf("Compare(different monotonic readings) = %d, want -1", r)
+	}
+	if !EqualsWith(t1, t2, IgnoreMonotonic()) {
+		t.Error("EqualsWith(IgnoreMonotonic) = false, want true")
+	}
+}
+
+// withMonotonic 函数用于获取一个墙上时钟与 t 相同、单调时钟读数增加 d 的时间, t 必须带有单调时钟读数.
+// time 包没有提供修改单调时钟读数的方法, 因此按照 time.Time 的内存布局直接修改.
+func withMonotonic(t time.Time, d time.Duration) time.Time {
+	(*struct {
+		wall uint64
+		ext  int64 // 带有单调时钟读数时为读数(纳秒)
+		loc  *time.Location
+	})(unsafe.Pointer(&t)).ext += int64(d)
+	return t
+}
+
+func TestCompareTimeRange(t *testing.T) {
+	// 超出 UnixNano 表示范围(1678~2262年)的时间同样能够正确比较
+	times := []time.Time{
+		{},
+		time.Date(1000, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(1677, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2263, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC),
+	}
+	for i := range times {
+		for j := range times {
+			want := typed.Compare(i, j)
+			if r := Compare(times[i], times[j]); r != want {
+				t.Errorf("Compare(%v, %v) = %d, want %d", times[i], times[j], r, want)
+			}
+			if r := CompareWith(times[i], times[j], WithinDuration(time.Hour)); r != want {
+				t.Errorf("CompareWith(%v, %v, WithinDuration) = %d, want %d", times[i], times[j], r, want)
+			}
+		}
+	}
+}
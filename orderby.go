@@ -0,0 +1,267 @@
+package comparator
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+/**
+ *
+ * @Author AiTao
+ * @Date 2026-10-17 18:45
+ * @Url
+ **/
+
+// orderTerm 描述了排序规则中的一项.
+type orderTerm struct {
+	path  []int // 字段的访问路径, 每一项为字段在所属结构体中的下标
+	desc  bool
+	nulls int
+	cmp   Type
+}
+
+// ParseOrderBy 函数用于将 SQL 风格的排序规则解析为比较器, sample 为参与排序的结构体(或结构体指针)的样例值,
+// 比较器的参数必须与 sample 的类型相同(或者为指向该类型的指针), 否则会发生 panic, panic 的值为包装了 ErrInvalidOrderBy 的 error.
+// 排序规则由逗号分隔的若干项组成, 每一项的格式为 "字段 [ASC|DESC] [NULLS FIRST|NULLS LAST]", 关键字不区分大小写.
+// 字段可以是 Go 字段名、json 标签名, 或者使用 "." 分隔的嵌套字段路径.
+//
+// 字段值按照其类型选择内置的比较器(Int、String、Time 等), 其它类型使用 Compare 函数进行深度比较.
+// 值为 nil 的指针字段默认小于任何非 nil 值, 即升序时排在最前面, 降序时排在最后面; NULLS FIRST、NULLS LAST 不受排序方向的影响.
+//
+// Example:
+// ParseOrderBy("last_name ASC, age DESC NULLS LAST, created_at", User{})
+func ParseOrderBy(spec string, sample any) (Type, error) {
+	t := reflect.TypeOf(sample)
+	if t != nil {
+		t = derefType(t)
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%w: sample must be a struct or a pointer to struct, got %T", ErrInvalidOrderBy, sample)
+	}
+	var terms []orderTerm
+	for _, item := range strings.Split(spec, ",") {
+		words := strings.Fields(item)
+		if len(words) == 0 {
+			return nil, fmt.Errorf("%w: empty term in %q", ErrInvalidOrderBy, spec)
+		}
+		path, ft, err := resolveFieldPath(t, words[0])
+		if err != nil {
+			return nil, err
+		}
+		term := orderTerm{path: path, cmp: builtinComparator(ft)}
+		if err = parseOrderModifiers(&term, words[1:]); err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalidOrderBy, strings.TrimSpace(item), err)
+		}
+		terms = append(terms, term)
+	}
+	return func(a, b any) int {
+		// 字段按照样例值类型中的下标访问, 其它类型的值会访问到错误的字段
+		for _, x := range []any{a, b} {
+			if xt := reflect.TypeOf(x); xt != nil && derefType(xt) != t {
+				panic(fmt.Errorf("%w: %q: cannot compare %T, want %s", ErrInvalidOrderBy, spec, x, t))
+			}
+		}
+		va, vb := indirectValue(reflect.ValueOf(a)), indirectValue(reflect.ValueOf(b))
+		for i := range terms {
+			if r := terms[i].compare(va, vb); r != 0 {
+				return r
+			}
+		}
+		return 0
+	}, nil
+}
+
+// parseOrderModifiers 函数用于解析排序规则中字段之后的排序方向与 nil 值位置.
+func parseOrderModifiers(term *orderTerm, words []string) error {
+	for i := 0; i < len(words); i++ {
+		switch strings.ToUpper(words[i]) {
+		case "ASC":
+			term.desc = false
+		case "DESC":
+			term.desc = true
+		case "NULLS":
+			if i+1 == len(words) {
+				return fmt.Errorf("NULLS requires FIRST or LAST")
+			}
+			i++
+			switch strings.ToUpper(words[i]) {
+			case "FIRST":
+				term.nulls = nullsFirst
+			case "LAST":
+				term.nulls = nullsLast
+			default:
+				return fmt.Errorf("invalid NULLS %q", words[i])
+			}
+		default:
+			return fmt.Errorf("unexpected %q", words[i])
+		}
+	}
+	return nil
+}
+
+// compare 函数用于按照排序规则中的一项比较两个结构体.
+func (term *orderTerm) compare(va, vb reflect.Value) int {
+	x, y := fieldByPath(va, term.path), fieldByPath(vb, term.path)
+	if n1, n2 := !x.IsValid(), !y.IsValid(); n1 || n2 {
+		if n1 == n2 {
+			return 0
+		}
+		// 默认情况下 nil 值较小, 其位置随排序方向变化
+		nulls := term.nulls
+		if nulls == nullsDefault {
+			if nulls = nullsFirst; term.desc {
+				nulls = nullsLast
+			}
+		}
+		if n1 == (nulls == nullsFirst) {
+			return -1
+		}
+		return 1
+	}
+	r := term.cmp(x.Interface(), y.Interface())
+	if term.desc {
+		return -r
+	}
+	return r
+}
+
+// resolveFieldPath 函数用于在结构体类型 t 中查找 name 对应的字段, 返回字段的访问路径以及字段的类型(已解除指针引用).
+func resolveFieldPath(t reflect.Type, name string) ([]int, reflect.Type, error) {
+	var path []int
+	ft := t
+	for _, seg := range strings.Split(name, ".") {
+		for ft.Kind() == reflect.Pointer {
+			ft = ft.Elem()
+		}
+		if ft.Kind() != reflect.Struct {
+			return nil, nil, fmt.Errorf("%w: field %q: %s is not a struct", ErrInvalidOrderBy, name, ft)
+		}
+		index, ok := lookupField(ft, seg)
+		if !ok {
+			return nil, nil, fmt.Errorf("%w: unknown field %q in %s (available: %s)", ErrInvalidOrderBy, name, ft, strings.Join(fieldNames(ft), ", "))
+		}
+		path = append(path, index...)
+		ft = ft.FieldByIndex(index).Type
+	}
+	for ft.Kind() == reflect.Pointer {
+		ft = ft.Elem()
+	}
+	return path, ft, nil
+}
+
+// lookupField 函数用于在结构体类型 t 中依次按照 Go 字段名、json 标签名、忽略大小写的 Go 字段名查找可导出字段(包括嵌入结构体中的字段).
+func lookupField(t reflect.Type, name string) ([]int, bool) {
+	for _, match := range []func(f reflect.StructField) bool{
+		func(f reflect.StructField) bool { return f.Name == name },
+		func(f reflect.StructField) bool { return jsonName(f) == name },
+		func(f reflect.StructField) bool { return strings.EqualFold(f.Name, name) },
+	} {
+		if index, ok := findField(t, match); ok {
+			return index, true
+		}
+	}
+	return nil, false
+}
+
+// findField 函数用于按照广度优先的顺序查找结构体类型 t 中满足 match 的可导出字段, 保证外层字段优先于嵌入结构体中的字段.
+func findField(t reflect.Type, match func(f reflect.StructField) bool) ([]int, bool) {
+	type level struct {
+		t     reflect.Type
+		index []int
+	}
+	current := []level{{t: t}}
+	for len(current) > 0 {
+		var next []level
+		for _, l := range current {
+			for i := 0; i < l.t.NumField(); i++ {
+				f := l.t.Field(i)
+				index := append(append([]int(nil), l.index...), i)
+				if f.IsExported() && match(f) {
+					return index, true
+				}
+				if ft := f.Type; f.Anonymous {
+					if ft.Kind() == reflect.Pointer {
+						ft = ft.Elem()
+					}
+					if ft.Kind() == reflect.Struct {
+						next = append(next, level{ft, index})
+					}
+				}
+			}
+		}
+		current = next
+	}
+	return nil, false
+}
+
+// jsonName 函数用于获取字段在 json 标签中的名称.
+func jsonName(f reflect.StructField) string {
+	name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+	return name
+}
+
+// fieldNames 函数用于获取结构体类型 t 中全部可导出字段的名称, 用于生成错误信息.
+func fieldNames(t reflect.Type) []string {
+	var names []string
+	for _, f := range reflect.VisibleFields(t) {
+		if f.IsExported() && !f.Anonymous {
+			names = append(names, f.Name)
+		}
+	}
+	return names
+}
+
+// derefType 函数用于解除类型 t 的指针引用.
+func derefType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t
+}
+
+// indirectValue 函数用于解除 v 的指针(接口)引用.
+func indirectValue(v reflect.Value) reflect.Value {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		v = v.Elem()
+	}
+	return v
+}
+
+// fieldByPath 函数用于获取结构体 v 中按照 path 访问的字段值, 当路径上存在值为 nil 的指针时返回无效的 reflect.Value.
+func fieldByPath(v reflect.Value, path []int) reflect.Value {
+	for _, i := range path {
+		if v = indirectValue(v); !v.IsValid() {
+			return v
+		}
+		v = v.Field(i)
+	}
+	return indirectValue(v)
+}
+
+var builtinComparators = map[reflect.Type]Type{
+	reflect.TypeOf(""):          String,
+	reflect.TypeOf(false):       Bool,
+	reflect.TypeOf(0):           Int,
+	reflect.TypeOf(int8(0)):     Int8,
+	reflect.TypeOf(int16(0)):    Int16,
+	reflect.TypeOf(int32(0)):    Int32,
+	reflect.TypeOf(int64(0)):    Int64,
+	reflect.TypeOf(uint(0)):     Uint,
+	reflect.TypeOf(uint8(0)):    Uint8,
+	reflect.TypeOf(uint16(0)):   Uint16,
+	reflect.TypeOf(uint32(0)):   Uint32,
+	reflect.TypeOf(uint64(0)):   Uint64,
+	reflect.TypeOf(float32(0)):  Float32,
+	reflect.TypeOf(float64(0)):  Float64,
+	reflect.TypeOf(time.Time{}): Time,
+}
+
+// builtinComparator 函数用于获取类型 t 对应的内置比较器, 不存在时返回 Compare 函数.
+func builtinComparator(t reflect.Type) Type {
+	if cmp, ok := builtinComparators[t]; ok {
+		return cmp
+	}
+	return Compare
+}
@@ -0,0 +1,122 @@
+package comparator
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+)
+
+/**
+ *
+ * @Author AiTao
+ * @Date 2026-10-17 18:45
+ * @Url
+ **/
+
+type audit struct {
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type profile struct {
+	City string
+}
+
+type customerRow struct {
+	audit
+	ID       int     `json:"id"`
+	LastName string  `json:"last_name"`
+	Age      *int    `json:"age,omitempty"`
+	Score    float64 `json:"-"`
+	Profile  *profile
+}
+
+func intPtr(n int) *int { return &n }
+
+func TestParseOrderBy(t *testing.T) {
+	t0 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	rows := []customerRow{
+		{audit{t0.Add(3)}, 1, "Smith", intPtr(30), 1, &profile{"Paris"}},
+		{audit{t0.Add(1)}, 2, "Jones", nil, 2, nil},
+		{audit{t0.Add(2)}, 3, "Smith", nil, 3, &profile{"Berlin"}},
+		{audit{t0.Add(4)}, 4, "Jones", intPtr(40), 4, &profile{"Austin"}},
+		{audit{t0.Add(0)}, 5, "Smith", intPtr(30), 5, nil},
+	}
+	tests := []struct {
+		spec string
+		want []int
+	}{
+		{"last_name ASC, age DESC NULLS LAST, created_at", []int{4, 2, 5, 1, 3}},
+		{"LastName desc, Age, id desc", []int{3, 5, 1, 2, 4}},
+		{"age nulls last, ID", []int{1, 5, 4, 2, 3}},
+		{"Profile.City DESC, id", []int{1, 3, 4, 2, 5}},
+		{"createdAt", []int{5, 2, 3, 1, 4}},
+		{"score desc", []int{5, 4, 3, 2, 1}},
+	}
+	for _, tt := range tests {
+		cmp, err := ParseOrderBy(tt.spec, customerRow{})
+		if err != nil {
+			t.Fatalf("ParseOrderBy(%q) error = %v", tt.spec, err)
+		}
+		s := append([]customerRow(nil), rows...)
+		sort.SliceStable(s, func(i, j int) bool { return cmp(s[i], s[j]) < 0 })
+		for i, id := range tt.want {
+			if s[i].ID != id {
+				var got []int
+				for _, r := range s {
+					got = append(got, r.ID)
+				}
+				t.Errorf("ParseOrderBy(%q) order = %v, want %v", tt.spec, got, tt.want)
+				break
+			}
+		}
+	}
+	cmp, _ := ParseOrderBy("id", &customerRow{})
+	if r := cmp(&rows[0], &rows[1]); r != -1 {
+		t.Errorf("compare pointers = %d, want -1", r)
+	}
+}
+
+func TestParseOrderByError(t *testing.T) {
+	tests := []struct {
+		spec   string
+		sample any
+		want   string
+	}{
+		{"name", customerRow{}, `unknown field "name" in comparator.customerRow`},
+		{"Profile.Zip", customerRow{}, `unknown field "Profile.Zip" in comparator.profile (available: City)`},
+		{"ID.x", customerRow{}, `int is not a struct`},
+		{"id up", customerRow{}, `unexpected "up"`},
+		{"id nulls", customerRow{}, `NULLS requires FIRST or LAST`},
+		{"id,", customerRow{}, `empty term`},
+		{"id", 1, `sample must be a struct`},
+	}
+	for _, tt := range tests {
+		_, err := ParseOrderBy(tt.spec, tt.sample)
+		if !errors.Is(err, ErrInvalidOrderBy) || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("ParseOrderBy(%q) error = %v, want %s", tt.spec, err, tt.want)
+		}
+	}
+}
+
+func TestParseOrderByTypeMismatch(t *testing.T) {
+	cmp, err := ParseOrderBy("id", customerRow{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := cmp(&customerRow{ID: 1}, customerRow{ID: 2}); got != -1 {
+		t.Errorf("cmp(*customerRow, customerRow) = %d, want -1", got)
+	}
+	if got := cmp((*customerRow)(nil), customerRow{ID: 2}); got != -1 {
+		t.Errorf("cmp(nil, customerRow) = %d, want -1", got)
+	}
+	// 其它结构体类型的值会按照下标访问到错误的字段, 因此需要发生 panic
+	defer func() {
+		err, _ := recover().(error)
+		if !errors.Is(err, ErrInvalidOrderBy) || !strings.Contains(err.Error(), "cannot compare comparator.profile") {
+			t.Errorf("cmp(profile, customerRow) panic = %v, want ErrInvalidOrderBy", err)
+		}
+	}()
+	cmp(profile{City: "x"}, customerRow{})
+}
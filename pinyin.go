@@ -0,0 +1,160 @@
+package comparator
+
+//go:generate go run ./internal/gen/pinyin -o pinyin_table.go
+
+import (
+	"sync"
+	"unicode"
+
+	"github.com/lmlat/go-comparator/typed"
+)
+
+/**
+ *
+ * @Author AiTao
+ * @Date 2026-10-18 10:05
+ * @Url
+ **/
+
+// Pinyin 函数用于按照汉语拼音顺序比较两个字符串, 适用于对中文姓名等内容进行排序.
+// 比较分为三个层次:
+//  1. 汉字转换为不带声调的拼音(ü 记为 v), 拉丁字母忽略大小写, 然后逐字母比较. 因此中英文混排时能够自然地穿插排列,
+//     例如 "李四" < "Zhang" < "张三". 没有收录读音的汉字排在所有字母之后, 并按照笔画数、码点的顺序排列.
+//  2. 拼音相同时, 拉丁字母排在汉字之前, 汉字之间按照声调排列, 声调相同时按照 CLDR 拼音排序规则排列.
+//  3. 以上均相同时按照字节比较原始字符串.
+//
+// 多音字只使用其默认读音, 例如 "长" 读作 chang2, "重" 读作 zhong4. 对中文姓名排序时请使用 PinyinName.
+//
+// Example:
+// Pinyin("张三", "李四") 返回 1
+// Pinyin("妈", "马") 返回 -1
+func Pinyin(x, y interface{}) int {
+	return comparePinyin(x.(string), y.(string), false)
+}
+
+// PinyinName 函数用于按照汉语拼音顺序比较两个中文姓名, 比较规则与 Pinyin 相同,
+// 区别在于姓名的第一个汉字为多音字时使用其作为姓氏的读音, 例如 "单" 读作 shan4, "曾" 读作 zeng1.
+//
+// Example:
+// PinyinName("单雄信", "丁一") 返回 1
+// PinyinName("曾国藩", "张三") 返回 -1
+func PinyinName(x, y interface{}) int {
+	return comparePinyin(x.(string), y.(string), true)
+}
+
+func comparePinyin(a, b string, name bool) int {
+	if a == b {
+		return 0
+	}
+	ka, kb := pinyinKeyOf(a, name), pinyinKeyOf(b, name)
+	if r := compareInt32s(ka.primary, kb.primary); r != 0 {
+		return r
+	}
+	if r := compareInt32s(ka.secondary, kb.secondary); r != 0 {
+		return r
+	}
+	return String(a, b)
+}
+
+// pinyinKey 为字符串的拼音排序键, primary 为逐字母展开的拼音, secondary 为每个字符在拼音相同时的排序权重.
+type pinyinKey struct {
+	primary, secondary []int32
+}
+
+// unreadHan 为没有收录读音的汉字在 primary 中的起始权重, 大于任何 Unicode 码点.
+const unreadHan = unicode.MaxRune + 1
+
+// pinyinKeyOf 函数用于获取字符串的拼音排序键, name 为 true 时第一个汉字使用其作为姓氏的读音.
+func pinyinKeyOf(s string, name bool) pinyinKey {
+	loadPinyin()
+	k := pinyinKey{primary: make([]int32, 0, len(s)), secondary: make([]int32, 0, len(s))}
+	for i, r := range s {
+		e, ok := pinyinIndex[r]
+		if name && i == 0 {
+			if se, found := pinyinSurnameIndex[r]; found {
+				e, ok = se, true
+			}
+		}
+		if ok {
+			for _, c := range pinyinTable[e.group].reading {
+				if c < '0' || c > '9' {
+					k.primary = append(k.primary, c)
+				}
+			}
+			k.secondary = append(k.secondary, e.rank+1)
+			continue
+		}
+		if unicode.Is(unicode.Han, r) {
+			k.primary = append(k.primary, unreadHan+int32(hanStroke(r))*(unicode.MaxRune+1)+r)
+		} else {
+			k.primary = append(k.primary, unicode.ToLower(r))
+		}
+		k.secondary = append(k.secondary, 0)
+	}
+	return k
+}
+
+// hanStroke 函数用于获取汉字的笔画数, 未收录笔画数的汉字排在最后.
+func hanStroke(r rune) int {
+	if r >= 0x4E00 && r-0x4E00 < rune(len(hanStrokes)) {
+		if n := int(hanStrokes[r-0x4E00] - '0'); n > 0 {
+			return n
+		}
+	}
+	return 64
+}
+
+type pinyinEntry struct {
+	group uint16 // 读音在 pinyinTable 中的下标
+	rank  int32  // 汉字在整个拼音表中的位置
+}
+
+// pinyinSurnames 记录了作为姓氏时读音与默认读音不同的多音字.
+var pinyinSurnames = map[rune]string{
+	'单': "shan4", '曾': "zeng1", '解': "xie4", '仇': "qiu2", '朴': "piao2", '区': "ou1",
+	'查': "zha1", '盖': "ge3", '葛': "ge3", '华': "hua4", '任': "ren2", '缪': "miao4",
+	'种': "chong2", '秘': "bi4", '乐': "yue4", '折': "she2", '尉': "yu4", '覃': "qin2",
+	'员': "yun4", '句': "gou1", '繁': "po2", '燕': "yan1", '翟': "zhai2", '召': "shao4",
+	'阙': "que1", '乜': "nie4",
+}
+
+var (
+	pinyinOnce         sync.Once
+	pinyinIndex        map[rune]pinyinEntry
+	pinyinSurnameIndex map[rune]pinyinEntry
+)
+
+// loadPinyin 函数用于在第一次使用时根据 pinyinTable 构建汉字到读音的索引.
+// 姓氏读音的排序权重为该读音中第一个汉字的权重.
+func loadPinyin() {
+	pinyinOnce.Do(func() {
+		pinyinIndex = make(map[rune]pinyinEntry, 9000)
+		groups := make(map[string]pinyinEntry, len(pinyinTable))
+		var rank int32
+		for i, g := range pinyinTable {
+			groups[g.reading] = pinyinEntry{group: uint16(i), rank: rank}
+			for _, c := range g.chars {
+				pinyinIndex[c] = pinyinEntry{group: uint16(i), rank: rank}
+				rank++
+			}
+		}
+		pinyinSurnameIndex = make(map[rune]pinyinEntry, len(pinyinSurnames))
+		for c, reading := range pinyinSurnames {
+			if e, ok := groups[reading]; ok {
+				pinyinSurnameIndex[c] = e
+			}
+		}
+	})
+}
+
+func compareInt32s(a, b []int32) int {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if a[i] != b[i] {
+			if a[i] < b[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+	return typed.Compare(len(a), len(b))
+}
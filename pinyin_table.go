@@ -0,0 +1,1348 @@
+// Code generated by go run ./internal/gen/pinyin. DO NOT EDIT.
+
+package comparator
+
+// 本文件中的数据来源于 CLDR 以及 Unicode::Collate::CJK, 许可参见 internal/gen/pinyin/NOTICE.
+
+// pinyinTable 按照音节、声调的顺序列出汉字的默认读音, 声调 5 表示轻声, ü 记为 v.
+// 同一读音的汉字按照 CLDR 拼音排序规则中的先后顺序排列.
+var pinyinTable = [...]struct{ reading, chars string }{
+	{"a1", "阿呵锕嗄啊"},
+	{"ai1", "哎哀唉埃娭挨"},
+	{"ai2", "皑溰嘊敱敳皚癌"},
+	{"ai3", "矮蔼躷濭藹霭"},
+	{"ai4", "艾伌爱砹硋隘嗌塧嫒愛碍叆暧"},
+	{"an1", "安侒峖桉氨庵菴谙媕萻葊痷腤鹌蓭誝鞌鞍"},
+	{"an3", "俺"},
+	{"an4", "岸按洝荌案胺豻堓婩晻暗"},
+	{"ang1", "肮"},
+	{"ang2", "昂"},
+	{"ang4", "盎"},
+	{"ao1", "凹"},
+	{"ao2", "敖厫隞嗷嗸嶅廒滶獓蔜遨摮熬獒璈磝翱聱螯謷謸翺鳌"},
+	{"ao3", "拗袄"},
+	{"ao4", "傲奡奥奧嫯慠骜隩墺嶴懊澳"},
+	{"ba1", "八仈扒朳玐夿岜芭峇柭疤哵巼捌巴叭笆"},
+	{"ba2", "拔茇炦癹胈菝詙跋"},
+	{"ba3", "把钯鈀靶"},
+	{"ba4", "坝弝爸垻耙跁鲅鲌鮊覇矲霸壩灞欛紦罢"},
+	{"ba5", "吧"},
+	{"bai2", "白"},
+	{"bai3", "百佰柏栢捭瓸粨絔摆"},
+	{"bai4", "败拜敗猈稗"},
+	{"ban1", "扳攽班般颁斑搬"},
+	{"ban3", "阪坂岅昄板版"},
+	{"ban4", "办半伴坢姅怑拌绊柈秚湴絆鉡靽辦瓣扮"},
+	{"bang1", "邦垹帮捠梆"},
+	{"bang3", "绑綁榜牓膀"},
+	{"bang4", "蚌傍棒棓谤塝搒稖蒡蜯磅镑"},
+	{"bao1", "包孢苞枹胞笣煲龅蕔褒"},
+	{"bao2", "雹薄"},
+	{"bao3", "宝怉饱保鸨宲珤堡"},
+	{"bao4", "报抱豹趵铇菢蚫袌報鉋鲍靤骲暴髱虣鮑儤曓爆"},
+	{"bei1", "卑杯盃桮悲揹椑禆碑背"},
+	{"bei3", "北"},
+	{"bei4", "贝孛狈貝邶备昁牬苝郥钡俻倍悖狽被偝偹梖珼鄁備僃惫焙琲軰辈"},
+	{"ben1", "奔"},
+	{"ben3", "本苯"},
+	{"ben4", "笨"},
+	{"beng1", "崩絣閍傰嵭痭嘣綳甭埄埲绷"},
+	{"beng4", "泵迸逬塴甏镚蹦"},
+	{"bi1", "逼"},
+	{"bi2", "鼻"},
+	{"bi3", "比夶朼佊吡妣沘疕彼柀秕俾笔粃舭啚筆鄙"},
+	{"bi4", "币必毕闭佖坒庇诐邲妼怭怶枈畀苾哔柲毖珌疪荜陛毙狴畢笓粊袐铋婢庳敝梐萆閇閉堛弻弼愊愎湢皕筚詖貱賁赑嗶彃滗滭煏痹痺睤腷蓖蓽蜌裨跸鉍閟飶幣弊熚獙碧箅箆綼蔽鄪馝潷獘罼駜髲壁嬖廦篦篳縪薜觱避鮅斃濞臂"},
+	{"bian1", "边辺砭笾揙猵编煸牑甂箯編蝙邉鍽鳊邊鞭"},
+	{"bian3", "贬扁"},
+	{"bian4", "卞弁匥忭抃汳汴苄釆变玣便変昪覍徧缏遍閞辡緶艑辧辨辩辫"},
+	{"biao1", "标飑骉髟淲彪猋脿颩墂幖摽滮蔈颮骠標熛膘"},
+	{"biao3", "表"},
+	{"bie1", "憋蟞鳖"},
+	{"bie2", "别"},
+	{"bie3", "瘪"},
+	{"bin1", "宾彬梹傧斌椕滨缤槟瑸豩賓賔镔儐濒"},
+	{"bin4", "摈殡膑髩擯鬂殯臏髌鬓"},
+	{"bing1", "冰兵"},
+	{"bing3", "丙邴陃怲抦秉苪昞昺柄炳饼"},
+	{"bing4", "并並併幷庰倂栤病"},
+	{"bo1", "拨波癷玻剝剥哱盋砵袚钵饽紴缽菠袰碆鉢僠嶓撥播"},
+	{"bo2", "伯犻肑驳帛狛瓝苩侼勃胉郣亳挬浡瓟秡袯钹铂脖舶袹博渤葧鹁愽搏猼鈸鉑馎僰煿牔箔泊"},
+	{"bo3", "跛"},
+	{"bu3", "卜啵萡膊峬庯逋晡鈽誧鳪轐醭卟补哺捕"},
+	{"bu4", "不布佈吥步咘怖抪歨歩柨钚勏埔埗悑捗荹部钸埠瓿蔀踄郶餔篰餢簿"},
+	{"ca1", "擦"},
+	{"cai1", "猜"},
+	{"cai2", "才犲材财財裁"},
+	{"cai3", "采倸啋寀彩採睬跴綵踩"},
+	{"cai4", "菜棌蔡"},
+	{"can1", "参參叄飡骖叅喰湌傪嬠餐"},
+	{"can2", "残蚕惭"},
+	{"can3", "惨"},
+	{"can4", "灿"},
+	{"cang1", "仓仺伧沧苍鸧倉舱"},
+	{"cang2", "藏"},
+	{"cao1", "操糙"},
+	{"cao2", "曹嘈嶆漕蓸槽"},
+	{"cao3", "草"},
+	{"ce4", "册侧厕恻拺测敇畟側厠笧粣萗廁惻測策"},
+	{"cen2", "岑"},
+	{"ceng2", "层曾"},
+	{"ceng4", "蹭"},
+	{"cha1", "叉扠杈肞臿挿偛嗏插差"},
+	{"cha2", "查茬茶嵖搽猹靫槎詧察碴"},
+	{"cha4", "岔侘诧"},
+	{"chai1", "拆"},
+	{"chai2", "柴豺"},
+	{"chan1", "掺搀"},
+	{"chan2", "谗棎湹禅馋煘缠僝獑蝉"},
+	{"chan3", "产刬旵丳斺浐剗谄啴產産铲阐"},
+	{"chan4", "颤"},
+	{"chang1", "昌倀娼淐猖"},
+	{"chang2", "肠苌镸尝偿常长"},
+	{"chang3", "厂场敞"},
+	{"chang4", "畅倡鬯唱"},
+	{"chao1", "抄弨怊欩钞訬焯超"},
+	{"chao2", "晁巢巣朝鄛鼌漅嘲樔潮"},
+	{"chao3", "吵炒"},
+	{"che1", "车"},
+	{"che3", "扯"},
+	{"che4", "彻坼迠烢聅掣硩頙徹撤澈"},
+	{"chen1", "郴捵琛"},
+	{"chen2", "尘臣忱沉辰陈晨"},
+	{"chen4", "衬疢龀趁"},
+	{"cheng1", "称偁蛏湞牚赪僜憆摚稱靗撐撑"},
+	{"cheng2", "成朾呈承枨诚郕乗城娍宬峸洆荿乘埕挰晟珹脀掁珵碀窚脭铖堘惩棖椉程筬絾裎塍塖溗誠畻酲鋮憕澂澄橙"},
+	{"cheng3", "逞骋"},
+	{"cheng4", "秤"},
+	{"chi1", "吃侙哧彨胵蚩鸱瓻眵笞喫訵嗤媸摛痴"},
+	{"chi2", "弛池驰迟坻岻茌持匙"},
+	{"chi3", "尺叺呎侈卶齿垑胣恥粎耻"},
+	{"chi4", "斥杘灻赤饬抶勅恜炽勑翄翅"},
+	{"chong1", "充冲"},
+	{"chong2", "虫崇"},
+	{"chong3", "宠"},
+	{"chou1", "抽"},
+	{"chou2", "仇怞俦帱栦惆紬绸菗椆畴絒愁皗稠筹裯酧綢踌酬"},
+	{"chou3", "丑丒吜杻杽侴偢瞅"},
+	{"chou4", "臭"},
+	{"chu1", "出岀初"},
+	{"chu2", "除芻厨滁蒢豠锄媰耡蒭蜍趎鉏雏犓蕏廚篨鋤橱幮櫉藸躇"},
+	{"chu3", "础椘储楮褚楚"},
+	{"chu4", "处竌怵拀绌豖柷欪竐俶敊畜埱珿絀處傗琡鄐搐滀蓫触踀閦儊嘼諔憷斶歜臅黜觸矗"},
+	{"chuai3", "揣"},
+	{"chuan1", "川氚穿"},
+	{"chuan2", "传舡舩船圌遄傳椽"},
+	{"chuan3", "喘"},
+	{"chuan4", "串"},
+	{"chuang1", "疮窓窗"},
+	{"chuang2", "床牀噇幢"},
+	{"chuang3", "闯"},
+	{"chuang4", "创"},
+	{"chui1", "吹炊"},
+	{"chui2", "垂倕埀陲捶菙搥棰椎腄槌锤"},
+	{"chun1", "春萅堾媋暙椿"},
+	{"chun2", "纯陙唇浱純莼淳脣湻犉滣蒓漘蓴醇"},
+	{"chun3", "蠢"},
+	{"chuo1", "戳"},
+	{"chuo4", "绰"},
+	{"ci1", "疵"},
+	{"ci2", "词珁垐柌祠茈茨堲瓷詞辝慈甆辞磁雌"},
+	{"ci3", "此"},
+	{"ci4", "次伺佽刺刾庛茦栨莿絘蛓赐"},
+	{"cong1", "匆囪囱苁忩枞怱悤棇焧葱漗聡蓯蔥骢暰樅樬熜瑽璁緫聦聪"},
+	{"cong2", "从丛"},
+	{"cou4", "凑"},
+	{"cu1", "粗"},
+	{"cu4", "促猝脨酢瘄蔟誎趗噈憱踧醋瘯簇"},
+	{"cuan1", "蹿"},
+	{"cuan4", "窜殩熶篡"},
+	{"cui1", "崔催凗缞墔嶉慛摧"},
+	{"cui4", "脆啐啛悴淬萃毳焠脺瘁粹綷翠"},
+	{"cun1", "村"},
+	{"cun2", "存"},
+	{"cun4", "寸"},
+	{"cuo1", "搓瑳遳磋撮"},
+	{"cuo4", "挫莝莡措逪斮棤锉蓌错"},
+	{"da1", "搭"},
+	{"da2", "达迖呾妲怛沓炟羍荙畗剳匒畣笪逹答"},
+	{"da3", "打"},
+	{"da4", "大"},
+	{"da5", "瘩"},
+	{"dai1", "呆"},
+	{"dai3", "歹"},
+	{"dai4", "逮傣代轪垈岱帒甙绐迨骀带待怠柋殆玳贷帯軑埭帶紿袋軚貸軩瑇廗叇曃緿鴏戴"},
+	{"dan1", "丹妉单担単眈砃耼耽郸"},
+	{"dan3", "胆衴疸紞掸"},
+	{"dan4", "旦但帎沊狚诞柦疍啖啗弹惮淡萏蛋啿弾氮"},
+	{"dang1", "当"},
+	{"dang3", "挡党"},
+	{"dang4", "荡档"},
+	{"dao1", "刀"},
+	{"dao3", "导岛島捣祷禂搗隝嶋嶌導隯壔嶹擣蹈禱倒"},
+	{"dao4", "到悼焘盗菿盜道稲箌翢噵稻"},
+	{"de2", "德鍀得"},
+	{"de5", "的"},
+	{"deng1", "灯登豋噔嬁燈璒竳簦覴蹬"},
+	{"deng3", "等"},
+	{"deng4", "邓凳鄧隥墱嶝瞪"},
+	{"di1", "低奃彽袛羝隄堤趆滴"},
+	{"di2", "狄籴苖迪唙敌涤荻梑笛觌靮滌馰髢嘀嫡翟"},
+	{"di3", "底弤抵"},
+	{"di4", "地弟旳杕玓怟俤帝埊娣递逓偙啇啲梊焍珶眱祶第菂谛釱媂棣渧睇缔蒂"},
+	{"dian1", "掂傎厧嵮滇槇槙瘨颠"},
+	{"dian3", "典奌点婰猠敟跕碘"},
+	{"dian4", "甸电佃阽坫店垫扂玷钿婝惦淀奠琔殿蜔電墊壂橂橝澱靛"},
+	{"diao1", "刁叼汈虭凋奝弴彫蛁琱貂碉鳭殦瞗雕"},
+	{"diao4", "吊钓窎訋调掉"},
+	{"die1", "爹跌"},
+	{"die2", "迭垤峌恎挕昳绖胅瓞眣戜谍喋堞惵揲畳絰耋臷詄趃镻叠殜牃牒嵽碟蜨褋艓蝶"},
+	{"ding1", "丁仃叮帄玎疔盯钉"},
+	{"ding3", "顶頂鼎"},
+	{"ding4", "订忊饤矴定訂飣啶铤椗腚碇锭"},
+	{"diu1", "丢"},
+	{"dong1", "东冬"},
+	{"dong3", "董墥嬞懂"},
+	{"dong4", "动冻侗垌姛峒恫挏栋洞"},
+	{"dou1", "都兜"},
+	{"dou3", "抖枓枡陡唞蚪鈄斗"},
+	{"dou4", "豆郖浢荳逗饾鬥梪毭脰酘痘閗窦"},
+	{"du1", "督"},
+	{"du2", "毒独涜读渎椟牍犊"},
+	{"du3", "堵帾琽赌睹"},
+	{"du4", "妒杜肚妬度荰秺渡靯镀"},
+	{"duan1", "端"},
+	{"duan3", "短"},
+	{"duan4", "段断塅缎葮椴煅瑖腶碫锻"},
+	{"dui1", "堆"},
+	{"dui4", "队对兊兌兑"},
+	{"dun1", "吨惇敦蜳墩墪撴獤噸撉橔犜礅蹲"},
+	{"dun4", "囤庉沌炖盾砘逇钝顿遁"},
+	{"duo1", "多夛咄哆畓剟崜掇"},
+	{"duo2", "夺"},
+	{"duo3", "躲朵"},
+	{"duo4", "垛剁陊陏饳尮柁柮炨桗堕舵惰跢跥跺"},
+	{"e2", "讹吪囮迗俄娥峨峩涐莪珴訛皒睋鈋锇鹅蛾磀誐頟额"},
+	{"e3", "恶"},
+	{"e4", "厄屵戹歺岋阨呃扼苊阸呝砐轭咢咹垩姶峉匎砨蚅饿偔卾堊悪硆谔軛鄂阏堮崿惡愕湂萼豟軶遌遏"},
+	{"en1", "恩"},
+	{"er2", "儿而"},
+	{"er3", "尔耳迩洱饵"},
+	{"er4", "二弍弐佴刵咡贰"},
+	{"fa1", "发"},
+	{"fa2", "乏伐姂垡浌疺罚茷阀栰砝筏"},
+	{"fa3", "法"},
+	{"fa4", "珐"},
+	{"fan1", "帆訉番勫噃嬏幡憣蕃旙旛繙翻藩"},
+	{"fan2", "凡凢凣忛杋柉矾籵钒烦舧笲棥渢煩緐墦樊橎燔璠膰薠繁"},
+	{"fan3", "反払返"},
+	{"fan4", "犯奿汎泛饭范贩"},
+	{"fang1", "方邡汸芳坊"},
+	{"fang2", "防妨房肪"},
+	{"fang3", "仿访彷纺"},
+	{"fang4", "放"},
+	{"fei1", "飞妃非飛啡婓渄绯菲"},
+	{"fei2", "肥"},
+	{"fei3", "匪诽"},
+	{"fei4", "吠芾废杮沸狒肺昲胇费"},
+	{"fen1", "分吩帉纷芬昐氛哛衯兺紛翂兝棻訜酚"},
+	{"fen2", "坟妢岎汾朌枌炃肦羒蚠蚡梤棼焚"},
+	{"fen3", "粉"},
+	{"fen4", "份弅奋忿秎偾愤粪"},
+	{"feng1", "丰风仹凨凬妦沣沨凮枫封疯盽砜風峯峰偑桻烽崶猦葑锋楓犎蜂瘋碸僼篈鄷鋒檒闏豐鏠酆"},
+	{"feng2", "冯夆捀浲逢缝"},
+	{"feng3", "讽"},
+	{"feng4", "凤奉"},
+	{"fo2", "佛"},
+	{"fou3", "否"},
+	{"fu1", "肤怤柎砆荂衭垺娐尃荴旉紨趺麸痡稃跗鈇筟綒鄜孵豧敷夫"},
+	{"fu2", "弗伏凫甶冹刜孚扶芙芣咈岪彿怫拂服枎泭绂绋苻茀俘垘柫氟洑炥玸畉畐祓罘茯郛韨哹栿浮砩莩蚨匐桴涪烰琈符笰紱紼翇艴菔虙幅棴絥罦葍福粰綍艀蜉辐"},
+	{"fu3", "抚乶府弣拊斧俌俛胕郙鳬俯釜釡捬辅焤盙腑滏蜅腐"},
+	{"fu4", "父讣付妇负附坿竎阜驸复峊祔訃負赴蚥袝陚偩冨副婦蚹媍富復秿萯蛗詂赋圑椱缚腹鲋複褔赙緮蕧蝜蝮賦駙嬔縛輹鮒賻鍑鍢鳆覆馥鰒甫咐袱酜傅"},
+	{"ga1", "嘎"},
+	{"ga2", "噶"},
+	{"gai1", "该"},
+	{"gai3", "改"},
+	{"gai4", "钙盖摡溉葢鈣隑戤概"},
+	{"gan1", "甘忓芉迀攼杆玕肝坩泔矸苷乹柑竿干"},
+	{"gan3", "秆衦赶敢桿笴稈感"},
+	{"gan4", "赣"},
+	{"gang1", "冈罓冮刚纲肛岡牨疘矼缸钢"},
+	{"gang3", "岗崗港"},
+	{"gang4", "杠"},
+	{"gao1", "皋羔羙高皐髙臯滜槔睾膏槹橰篙糕"},
+	{"gao3", "搞缟暠槀槁稾稿镐"},
+	{"gao4", "告勂叝诰郜"},
+	{"ge1", "戈仡圪犵纥戓肐牫疙咯牱哥胳袼鸽割搁滒戨歌"},
+	{"ge2", "阁革敋格鬲愅臵葛蛒裓隔蛤"},
+	{"ge3", "舸"},
+	{"ge4", "个各虼個硌铬"},
+	{"gei3", "给"},
+	{"gen1", "根跟"},
+	{"geng1", "庚畊浭耕菮搄焿絚赓鹒緪縆羮賡羹更"},
+	{"geng3", "埂峺挭绠耿莄梗"},
+	{"gong1", "工弓公厷功攻杛供玜糼肱宫宮恭躬龚"},
+	{"gong3", "巩汞拱"},
+	{"gong4", "共贡"},
+	{"gou1", "勾佝沟钩"},
+	{"gou3", "狗苟"},
+	{"gou4", "构诟购垢姤茩冓够"},
+	{"gu1", "估呱姑孤沽泒苽柧轱唂罛鸪笟菰蛄觚軱軲辜酤鈲箍咕菇"},
+	{"gu3", "古扢汩诂谷股牯骨唃罟羖钴啒淈脵蛊蛌尳愲蓇詁馉鹄榾毂鈷鼓"},
+	{"gu4", "固故凅顾堌崓崮梏牿棝祻雇"},
+	{"gua1", "瓜刮"},
+	{"gua3", "剐剮寡"},
+	{"gua4", "挂啩掛罣絓罫褂"},
+	{"guai1", "乖"},
+	{"guai3", "拐"},
+	{"guai4", "怪"},
+	{"guan1", "关观官冠覌倌棺"},
+	{"guan3", "馆琯痯筦管"},
+	{"guan4", "贯泴悺惯掼涫貫悹祼慣摜潅遦樌盥罆雚鏆灌爟瓘矔礶鹳罐"},
+	{"guang1", "光"},
+	{"guang3", "广"},
+	{"guang4", "逛"},
+	{"gui1", "归圭妫龟规邽皈茥闺帰珪胿亀傀硅窐袿規媯廆椝瑰"},
+	{"gui3", "轨庋佹匦诡陒垝姽恑攱癸軌鬼"},
+	{"gui4", "刽刿昋柜炔贵桂桧猤筀貴蓕跪"},
+	{"gun3", "辊滚"},
+	{"gun4", "棍"},
+	{"guo1", "郭堝崞鈛锅"},
+	{"guo2", "国"},
+	{"guo3", "果惈淉猓菓馃椁槨粿綶蜾裹"},
+	{"guo4", "过"},
+	{"ha1", "哈"},
+	{"hai2", "还孩頦骸"},
+	{"hai3", "海"},
+	{"hai4", "亥妎骇害氦"},
+	{"han1", "酣頇嫨谽憨"},
+	{"han2", "含邯函咁肣凾虷唅圅娢浛崡晗梒涵焓琀寒嵅韩"},
+	{"han3", "罕浫喊"},
+	{"han4", "汉屽汗闬旱岾哻垾悍捍涆猂莟晘晥焊菡釬閈皔睅傼蛿颔馯撖漢蜭貋暵熯銲鋎憾撼翰"},
+	{"hang1", "夯"},
+	{"hang2", "杭绗珩笐航"},
+	{"hao2", "毫椃嗥獆貉噑獔豪嘷獋諕儫嚎壕"},
+	{"hao3", "好郝"},
+	{"hao4", "号昊昦秏哠峼恏悎浩耗"},
+	{"he1", "喝"},
+	{"he2", "禾合何劾厒咊和姀河郃峆曷柇狢盇籺紇阂饸哬敆核盉盍荷啝涸渮盒秴菏"},
+	{"he4", "贺袔焃賀嗃煂碋熇褐赫鹤"},
+	{"hei1", "黑嘿"},
+	{"hen2", "痕"},
+	{"hen3", "很狠"},
+	{"hen4", "恨"},
+	{"heng1", "亨哼"},
+	{"heng2", "恒桁烆胻鸻横橫衡"},
+	{"hong1", "轰哄訇烘"},
+	{"hong2", "弘妅红吰宏汯玒纮闳宖泓苰垬娂洪竑紅荭虹峵浤紘翃耾硔紭谹鸿"},
+	{"hou2", "侯矦鄇喉帿猴"},
+	{"hou3", "吼"},
+	{"hou4", "后郈厚垕後洉逅堠豞鲎鲘鮜鱟候"},
+	{"hu1", "呼垀忽乎"},
+	{"hu2", "弧狐瓳胡壶隺壷斛焀喖壺媩搰湖猢絗葫楜煳瑚嘝蔛鹕槲箶蝴糊"},
+	{"hu3", "虎唬"},
+	{"hu4", "互弖戶户戸冱冴芐帍护沍沪岵怙戽昈枑怘祜笏婟扈"},
+	{"hua1", "花芲哗"},
+	{"hua2", "华姡骅華釪釫铧滑猾"},
+	{"hua4", "化划夻杹画话"},
+	{"huai2", "怀徊淮槐"},
+	{"huai4", "坏"},
+	{"huan1", "欢"},
+	{"huan2", "环郇峘洹狟荁桓"},
+	{"huan3", "缓"},
+	{"huan4", "幻奂肒奐宦唤换浣涣烉患梙焕逭喚喛嵈愌換渙痪睆煥瑍豢"},
+	{"huang1", "荒衁朚塃慌"},
+	{"huang2", "皇偟凰隍黄喤堭媓崲徨惶湟葟遑黃楻煌瑝墴潢獚锽熿璜篁篊艎蝗癀磺穔諻簧"},
+	{"huang3", "恍炾宺晄奛谎幌詤熀謊櫎愰滉榥曂皝鎤皩晃"},
+	{"hui1", "灰诙咴恢拻挥洃虺袆晖烣珲豗婎媈揮翚辉隓暉楎煇禈詼幑睳褘噅撝噕翬輝麾徽"},
+	{"hui2", "回囬佪廻廽恛洄茴迴烠蚘逥痐蛔"},
+	{"hui3", "悔毀毁"},
+	{"hui4", "卉汇会讳泋哕浍绘芔荟诲恚恵烩贿彗晦秽喙惠湏絵缋翙阓匯彙彚會滙詯賄颒僡嘒瘣蔧誨圚寭慧"},
+	{"hun1", "昏昬荤婚"},
+	{"hun2", "浑梡馄堚渾琿魂"},
+	{"hun4", "混"},
+	{"huo1", "豁"},
+	{"huo2", "活"},
+	{"huo3", "火伙"},
+	{"huo4", "或货咟砉俰捇眓获閄掝祸貨惑旤楇湱禍蒦奯濩獲霍"},
+	{"ji1", "讥击刉叽饥乩刏圾机玑肌芨矶鸡枅咭姫迹剞唧姬屐积笄飢基绩喞嵆嵇敧朞犄筓缉赍勣嗘畸稘跡跻鳮僟毄箕銈嘰槣畿稽緝觭賫躸齑墼機激"},
+	{"ji2", "及伋吉岌彶忣汲级即极皀亟佶诘郆钑卽姞急狤皍笈級揤疾觙偮卙庴焏谻戢棘極殛湒集塉嫉愱楫蒺趌槉禝耤膌銡嶯撃潗濈瘠箿蕀蕺踖鹡橶檝螏擮藉襋蹐鍓艥籍辑"},
+	{"ji3", "脊几己丮妀犱泲虮挤"},
+	{"ji4", "计记伎纪坖妓忌技芰际剂季哜垍峜既洎济紀茍茤荠計剤紒继觊記偈寂寄徛悸旣梞済祭塈惎臮葪蔇兾痵継蓟裚褀際鬾暨漃漈稩穊誋跽霁鲚暩稷諅鲫冀"},
+	{"jia1", "加乫夹伽夾抸佳拁泇茄迦枷毠浃珈埉家浹痂梜笳耞袈傢猳葭跏犌腵鉫嘉"},
+	{"jia2", "郏荚郟唊恝莢戛袷铗戞蛱裌颊"},
+	{"jia3", "甲仮岬叚玾胛斚贾钾"},
+	{"jia4", "假婽徦斝椵賈鉀榎槚瘕檟价驾架嫁幏榢價駕稼"},
+	{"jian1", "奸尖幵坚歼间冿戔玪肩艰姦姧兼监偂堅惤猏笺菅菺豜湔牋犍缄葌間搛椷椾煎"},
+	{"jian3", "拣枧俭柬茧倹挸捡笕减剪梘检湕趼堿揀揃検減睑硷裥詃锏弿暕瑐筧简絸谫戩戬碱"},
+	{"jian4", "见件見建饯剑洊牮荐贱俴健剣栫涧珔舰剱徤渐袸谏釼寋旔楗毽溅腱臶葥践賎鉴键僭榗漸蔪劍劎澗箭槛"},
+	{"jiang1", "江姜将茳浆畕豇將葁畺摪翞僵漿螀壃缰薑橿殭螿鳉疅礓疆"},
+	{"jiang3", "讲奖桨傋蒋"},
+	{"jiang4", "降洚绛弶袶絳酱勥滰嵹摾彊犟糡醤糨醬謽匠"},
+	{"jiao1", "交郊姣娇峧浇茭茮骄胶椒焦蛟跤僬嘄虠鲛嬌嶕嶣憍澆膠蕉燋膲礁"},
+	{"jiao3", "角佼侥恔挢狡绞饺捁晈烄皎矫脚铰搅湫絞剿敫湬煍腳賋僥摷暞踋鉸餃儌劋徺撟撹隦徼憿敽敿燞缴"},
+	{"jiao4", "叫呌峤挍訆珓窌轿较敎教窖滘較嘂嘦斠漖酵"},
+	{"jie1", "阶疖皆接掲痎秸菨階喈嗟堦媘嫅揭椄湝脻街"},
+	{"jie2", "节讦刦刧劫岊昅刼劼杰疌衱拮洁结迼倢桀莭訐偼婕崨捷袺傑喼結絜颉嵥楬楶滐睫節蜐蝍詰鉣魝截榤碣竭"},
+	{"jie3", "解姐"},
+	{"jie4", "介吤岕庎戒芥屆届玠界畍疥砎衸诫借"},
+	{"jin1", "巾今斤钅兓金津矜荕衿觔埐珒紟惍堻筋釿嶜鹶黅襟"},
+	{"jin3", "仅侭卺巹紧堇菫僅厪谨锦"},
+	{"jin4", "尽劲妗近进枃勁浕荩晉晋浸烬赆唫琎祲進寖搢溍禁缙靳"},
+	{"jing1", "京泾经茎亰秔荆荊涇莖婛惊旌旍猄経菁晶稉腈葏粳經兢精聙鲸睛"},
+	{"jing3", "井丼阱刭坓宑汫汬肼剄穽颈景儆頚幜憬憼暻燛璟璥頸蟼警"},
+	{"jing4", "净弪径迳俓婙浄胫倞凈弳徑痉竞逕婧桱梷淨竫脛竟敬痙竧靓傹靖境獍誩踁静靚曔镜"},
+	{"jiong3", "炯逈浻烱煚窘"},
+	{"jiu1", "纠朻牞究糺鸠糾赳阄萛啾揂揪"},
+	{"jiu3", "九久乆乣奺灸玖舏韭紤酒"},
+	{"jiu4", "旧臼咎疚柩柾倃捄桕匓厩救媨就廄廐舅"},
+	{"ju1", "居拘泃狙苴驹挶疽痀眗砠罝陱娵婮崌掬梮涺菹椐琚腒趄跔锔裾雎艍蜛踘踙鋦駒鮈鴡鞠"},
+	{"ju2", "局泦侷狊桔毩啹婅淗焗菊郹椈毱湨犑輂僪粷跼閰諊趜躹橘"},
+	{"ju3", "咀弆沮举矩"},
+	{"ju4", "巨句乬巪讵姖岠怇拒洰苣邭具怐怚拠昛歫炬秬钜俱倨倶冣剧粔耟蚷袓埧埾惧据詎距犋跙鉅飓虡豦锯寠愳窭聚駏劇勮屦踞"},
+	{"juan1", "娟捐涓焆瓹脧裐鹃"},
+	{"juan3", "卷"},
+	{"juan4", "倦勌桊狷绢隽淃眷"},
+	{"jue1", "撅"},
+	{"jue2", "决刔氒诀弡抉決芵泬玦玨挗珏疦砄绝虳觉倔捔欮蚗崛掘斍桷殌覐觖訣赽趹逫傕厥焳絕絶覚趉鈌劂勪瑴谲駃嶥憰熦爴獗瘚蕝蕨鴂鴃噱憠橛橜爵臄镢蟨蟩屫爑譎蹶蹷鶌匷嚼矍覺鐍鐝爝觼彏戄攫"},
+	{"jun1", "军君均汮姰袀軍钧莙蚐桾皲菌"},
+	{"jun4", "俊郡陖埈峻捃浚馂骏晙焌珺棞畯竣"},
+	{"ka1", "咖喀"},
+	{"ka3", "卡"},
+	{"kai1", "开奒揩"},
+	{"kai3", "凯剀垲恺闿铠凱剴嘅慨蒈塏嵦愷楷"},
+	{"kan1", "刊栞勘龛堪"},
+	{"kan3", "坎侃砍"},
+	{"kan4", "阚看"},
+	{"kang1", "康嫝嵻慷漮槺穅糠"},
+	{"kang2", "扛"},
+	{"kang4", "亢伉匟邟囥抗犺炕"},
+	{"kao3", "考拷洘栲烤"},
+	{"kao4", "靠"},
+	{"ke1", "苛柯牁珂科胢轲疴砢趷棵萪軻颏嗑搕犐稞窠鈳榼薖颗樖瞌磕"},
+	{"ke2", "咳壳"},
+	{"ke3", "可坷岢炣渇嵑敤渴"},
+	{"ke4", "克刻剋勀勊客恪娔尅课"},
+	{"ken3", "肯肻垦恳啃"},
+	{"keng1", "吭坑"},
+	{"kong1", "空"},
+	{"kong3", "孔恐"},
+	{"kong4", "控"},
+	{"kou1", "抠"},
+	{"kou3", "口"},
+	{"kou4", "扣敂冦宼寇"},
+	{"ku1", "枯胐哭桍堀崫圐跍窟"},
+	{"ku3", "苦"},
+	{"ku4", "库俈绔庫秙趶焅袴喾絝裤瘔酷"},
+	{"kua1", "夸"},
+	{"kua3", "垮"},
+	{"kua4", "挎胯跨"},
+	{"kuai3", "蒯"},
+	{"kuai4", "块快侩郐哙狯脍塊筷"},
+	{"kuan1", "宽"},
+	{"kuan3", "款"},
+	{"kuang1", "匡劻诓邼匩哐恇洭硄筐"},
+	{"kuang2", "狂"},
+	{"kuang4", "框况旷岲況矿昿贶眖眶"},
+	{"kui1", "亏刲岿悝盔窥"},
+	{"kui2", "奎晆逵鄈頄馗喹揆葵骙戣暌楏楑魁睽蝰頯櫆藈鍨鍷騤夔"},
+	{"kui4", "愧溃腃蒉馈"},
+	{"kun1", "坤昆"},
+	{"kun3", "捆"},
+	{"kun4", "困"},
+	{"kuo4", "扩拡括挄桰筈萿葀蛞阔廓"},
+	{"la1", "垃拉"},
+	{"la3", "喇"},
+	{"la4", "腊揧楋瘌蜡蝋辢辣"},
+	{"la5", "啦"},
+	{"lai2", "来來俫倈崃徕涞莱"},
+	{"lai4", "赖"},
+	{"lan2", "兰岚拦栏婪惏嵐葻阑蓝谰厱澜褴儖斓篮"},
+	{"lan3", "览浨揽缆榄漤罱醂壈懒"},
+	{"lan4", "烂滥"},
+	{"lang2", "郎郞欴狼阆嫏廊斏桹琅蓈榔"},
+	{"lang3", "朗"},
+	{"lang4", "浪"},
+	{"lao1", "捞"},
+	{"lao2", "劳労牢"},
+	{"lao3", "老佬咾姥"},
+	{"lao4", "涝烙耢酪"},
+	{"le4", "乐叻忇扐氻艻玏泐竻砳楽韷樂簕鳓鰳饹餎勒"},
+	{"le5", "了"},
+	{"lei2", "雷嫘缧蔂畾擂檑縲礌镭"},
+	{"lei3", "垒絫腂傫誄樏磊蕌磥蕾儡"},
+	{"lei4", "肋泪洡类涙淚累"},
+	{"leng2", "棱楞"},
+	{"leng3", "冷"},
+	{"li2", "厘剓离荲骊悡梨梩梸犁琍粚菞喱棃犂鹂剺漓睝筣缡艃蓠蜊嫠孷樆璃盠貍糎蔾褵鋫鲡黎篱狸"},
+	{"li3", "礼里俚峛峢娌峲浬逦理锂粴裏豊鋰鲤李"},
+	{"li4", "力历厉屴立吏朸丽利励呖坜沥苈例岦戾枥沴疠苙隶俐俪栎疬砅茘荔赲轹郦唎悧栗栛涖猁珕砺砾秝莅莉唳婯笠粒粝脷蚸蛎傈凓厤棙痢"},
+	{"li5", "哩"},
+	{"lia3", "俩"},
+	{"lian2", "连帘怜涟莲連梿联裢亷嗹廉慩溓漣蓮匲奩槤熑覝劆匳噒嫾憐磏聫褳鲢濂濓縺翴聮薕螊櫣燫聯臁謰蹥鎌镰"},
+	{"lian3", "敛琏脸"},
+	{"lian4", "练炼恋浰殓僆堜媡湅萰链"},
+	{"liang2", "良俍凉梁涼椋辌粮粱"},
+	{"liang3", "两"},
+	{"liang4", "亮哴悢谅辆喨晾湸量"},
+	{"liao2", "潦撩蹽辽疗聊僚寥嵺憀漻膋嘹嫽寮嶚嶛敹獠缭遼暸燎"},
+	{"liao4", "料尞廖撂窷镣"},
+	{"lie4", "列劣冽劽姴挒洌茢迾哷埒埓栵浖烈捩猎脟蛚裂"},
+	{"lin1", "拎"},
+	{"lin2", "邻林临冧矝啉崊淋晽琳粦痳碄箖粼鄰隣嶙潾獜遴斴暽燐璘辚霖瞵磷臨繗翷麐轔壣瀶鏻鳞"},
+	{"lin3", "凛"},
+	{"lin4", "吝恡悋赁焛賃僯蔺"},
+	{"ling2", "灵囹坽夌姈岺彾泠狑苓昤朎柃玲瓴凌皊砱秢竛铃陵鸰婈掕棂淩琌笭紷绫羚翎聆舲菱蛉衑祾詅跉軨裬鈴閝零龄綾蔆霊駖澪蕶錂魿鲮鴒鹷燯霛霝齢酃鯪孁蘦齡櫺醽靈欞爧麢龗阾岭伶"},
+	{"ling3", "领"},
+	{"ling4", "令另"},
+	{"liu1", "溜"},
+	{"liu2", "刘沠畄浏流留旈琉畱硫裗媹嵧旒蒥蓅遛馏骝榴瑠飗劉瑬瘤"},
+	{"liu3", "柳"},
+	{"liu4", "六"},
+	{"long2", "龙屸咙泷茏昽栊珑胧眬砻竜笼聋隆窿"},
+	{"long3", "陇垄垅拢"},
+	{"lou2", "娄偻婁溇蒌僂楼"},
+	{"lou3", "搂塿嶁摟甊篓"},
+	{"lou4", "陋屚漏"},
+	{"lu2", "卢庐芦垆泸炉栌胪轳鸬玈舻颅"},
+	{"lu3", "卤虏掳鹵硵鲁"},
+	{"lu4", "陆侓坴彔录峍勎赂辂陸娽淕淥渌硉菉逯鹿椂琭禄祿僇剹勠盝睩碌稑賂路塶廘摝漉箓粶蔍戮樚熝膔觮趢踛辘醁潞穋蕗錄録錴璐簏螰簶蹗轆騄鹭簬鏕鯥鵦鵱麓鏴露"},
+	{"luan2", "孪峦挛栾鸾脔滦"},
+	{"luan3", "卵"},
+	{"luan4", "乱"},
+	{"lun1", "抡"},
+	{"lun2", "仑伦囵沦纶侖轮"},
+	{"lun4", "论"},
+	{"luo2", "罗啰頱囉罖猡脶萝逻椤腡覙锣箩骡镙螺"},
+	{"luo3", "裸"},
+	{"luo4", "洛络荦骆洜珞硦笿絡落"},
+	{"lv2", "驴"},
+	{"lv3", "吕呂侣侶挔捛捋旅梠祣稆铝屡絽缕屢膂褛鋁履"},
+	{"lv4", "律虑率绿嵂氯葎滤"},
+	{"lve4", "掠略"},
+	{"ma1", "妈"},
+	{"ma2", "麻"},
+	{"ma3", "马玛码蚂"},
+	{"ma4", "骂"},
+	{"ma5", "吗嗎遤嘛"},
+	{"mai2", "埋"},
+	{"mai3", "买"},
+	{"mai4", "迈佅売麦卖脉"},
+	{"man2", "蛮僈谩慲馒樠瞒"},
+	{"man3", "满"},
+	{"man4", "曼鄤墁幔慢摱漫獌缦蔄蔓"},
+	{"mang2", "忙汒芒尨杗杧氓盲恾笀茫"},
+	{"mang3", "莽"},
+	{"mao1", "猫"},
+	{"mao2", "毛矛枆牦茅茆旄罞兞渵軞酕堥锚"},
+	{"mao3", "卯夘乮戼峁泖昴铆"},
+	{"mao4", "茂冒柕眊贸耄袤覒媢帽萺貿鄚愗暓楙毷瑁瞀貌"},
+	{"me5", "么"},
+	{"mei2", "没枚玫苺栂眉娒脄莓梅珻脢郿堳媒嵋湄湈猸睂葿楣楳煤瑂禖塺槑酶镅鹛鋂霉"},
+	{"mei3", "每凂美挴浼媄嵄渼媺腜镁"},
+	{"mei4", "妹抺沬旀昧祙袂眛媚寐"},
+	{"men2", "门"},
+	{"men4", "闷"},
+	{"men5", "们"},
+	{"meng2", "萌萠盟"},
+	{"meng3", "蒙甍儚橗瞢蕄蝱鄳鄸幪懞濛曚朦檬氋矇礞鯍鹲艨蘉矒霿靀饛顭鼆鸏勐猛瓾锰"},
+	{"meng4", "孟梦"},
+	{"mi1", "眯"},
+	{"mi2", "弥罙祢迷猕谜蒾詸謎醚彌擟糜"},
+	{"mi3", "靡瀰獼麛镾戂攠瓕蘼爢醾醿鸍釄米"},
+	{"mi4", "宓泌觅峚祕宻秘密淧淿覓覔幂谧塓幎覛嘧榓滵漞熐蔤蜜"},
+	{"mian2", "眠婂绵媔棉"},
+	{"mian3", "免沔黾勉眄娩偭冕勔渑喕愐湎缅"},
+	{"mian4", "面"},
+	{"miao2", "苗媌描瞄"},
+	{"miao3", "秒淼渺缈篎緲藐"},
+	{"miao4", "妙庙"},
+	{"mie1", "乜"},
+	{"mie4", "灭烕覕搣滅蔑"},
+	{"min2", "民"},
+	{"min3", "皿冺刡闵抿泯勄敃闽悯敏"},
+	{"ming2", "名明鸣洺眀茗冥朙眳铭鄍嫇溟猽蓂暝榠銘鳴瞑螟"},
+	{"ming4", "命"},
+	{"miu4", "谬缪"},
+	{"mo1", "摸"},
+	{"mo2", "摹模膜麽摩橅磨糢謨嚤擵饃嚩嚰蘑髍魔"},
+	{"mo3", "抹"},
+	{"mo4", "末劰圽妺帓歾歿殁沫茉陌帞昩枺唜皌眜眿砞秣莈莫眽粖絈湐蛨貃嗼塻寞漠獏蓦貊暯銆靺嫼黙瘼瞐瞙镆魩墨默"},
+	{"mou2", "牟侔劺恈洠眸谋"},
+	{"mou3", "某"},
+	{"mu3", "母亩牡坶姆拇"},
+	{"mu4", "木仫朰目沐狇炑牧苜毣莯蚞钼募雮墓幕幙慔楘睦鉬慕暮艒霂穆"},
+	{"na2", "拿"},
+	{"na3", "哪"},
+	{"na4", "那妠纳肭娜衲钠呐"},
+	{"nai3", "乃奶艿氖"},
+	{"nai4", "奈柰耏耐"},
+	{"nan2", "男枏枬侽南柟娚畘莮难"},
+	{"nang2", "囊"},
+	{"nao2", "挠"},
+	{"nao3", "恼悩脑"},
+	{"nao4", "闹婥淖"},
+	{"ne4", "讷"},
+	{"ne5", "呢"},
+	{"nei3", "馁"},
+	{"nei4", "内"},
+	{"nen4", "嫩"},
+	{"neng2", "能"},
+	{"ni1", "妮"},
+	{"ni2", "尼坭怩泥籾倪屔秜郳铌埿婗淣猊蚭棿跜腝聣蜺觬貎輗霓"},
+	{"ni3", "你拟"},
+	{"ni4", "逆匿眤堄惄嫟愵溺睨腻"},
+	{"nian1", "拈蔫"},
+	{"nian2", "年"},
+	{"nian3", "捻淰焾跈辇辗撚撵碾"},
+	{"nian4", "念"},
+	{"niang2", "娘"},
+	{"niang4", "酿"},
+	{"niao3", "鸟"},
+	{"niao4", "尿"},
+	{"nie1", "捏"},
+	{"nie4", "涅痆聂臬啮惗菍隉喦敜湼嗫嵲踂噛摰槷踗镊镍嶭篞臲錜颞蹑嚙聶鎳闑孼孽"},
+	{"nin2", "您"},
+	{"ning2", "宁咛拧狞苧柠聍寍寕甯寗寜寧儜凝"},
+	{"ning4", "泞"},
+	{"niu2", "牛"},
+	{"niu3", "扭狃纽炄钮"},
+	{"nong2", "农侬哝浓脓"},
+	{"nong4", "弄"},
+	{"nu2", "奴"},
+	{"nu3", "努"},
+	{"nu4", "怒"},
+	{"nuan3", "暖"},
+	{"nuo2", "挪"},
+	{"nuo4", "诺喏掿逽愞搦锘搻榒稬諾蹃糑懦懧糥穤糯"},
+	{"nv3", "女"},
+	{"nve4", "疟虐"},
+	{"o1", "噢"},
+	{"o2", "哦"},
+	{"ou1", "欧殴瓯鸥"},
+	{"ou3", "呕偶腢嘔耦蕅藕"},
+	{"ou4", "沤"},
+	{"pa1", "趴舥啪"},
+	{"pa2", "爬掱琶"},
+	{"pa4", "帕怕"},
+	{"pai1", "拍"},
+	{"pai2", "徘排猅棑牌"},
+	{"pai4", "派湃"},
+	{"pan1", "潘攀"},
+	{"pan2", "盘跘媻幋蒰搫槃盤磐"},
+	{"pan4", "判沜拚泮炍叛牉盼畔"},
+	{"pang1", "乓"},
+	{"pang2", "庞厖逄旁"},
+	{"pang3", "耪"},
+	{"pang4", "胖"},
+	{"pao1", "抛"},
+	{"pao2", "刨咆垉庖狍炰爮袍"},
+	{"pao3", "跑"},
+	{"pao4", "泡炮"},
+	{"pei1", "呸怌肧柸胚"},
+	{"pei2", "陪培毰赔锫裴"},
+	{"pei4", "沛佩帔姵斾旆浿珮配"},
+	{"pen1", "喷"},
+	{"pen2", "盆"},
+	{"peng1", "抨恲砰梈烹"},
+	{"peng2", "澎磞芃朋挷竼倗莑堋弸彭棚椖塳硼稝蓬鹏槰樥熢憉輣篣膨篷"},
+	{"peng3", "捧"},
+	{"peng4", "碰"},
+	{"pi1", "批纰邳坯披抷炋狉砒悂秛秠紕铍旇翍耚豾鈈鈚鈹鉟銔劈磇駓髬噼錍魾鮍憵礔礕霹"},
+	{"pi2", "皮阰芘岯枇毞狓肶毗毘疲蚍郫陴啤埤崥蚽蚾豼焷琵脾"},
+	{"pi3", "匹庀疋仳圮苉脴痞"},
+	{"pi4", "屁淠渒揊釽媲嫓睥辟潎稫僻澼嚊甓疈譬"},
+	{"pian1", "偏媥犏篇"},
+	{"pian4", "片骗"},
+	{"piao1", "飘漂"},
+	{"piao2", "嫖瓢"},
+	{"piao4", "票"},
+	{"pie1", "撇撆暼瞥"},
+	{"pin1", "拼"},
+	{"pin2", "贫娦貧琕嫔频"},
+	{"pin3", "品"},
+	{"pin4", "聘"},
+	{"ping1", "乒"},
+	{"ping2", "平评凭呯坪泙苹郱屏帡枰洴玶胓荓瓶屛帲淜萍"},
+	{"po1", "坡岥颇泼"},
+	{"po2", "婆"},
+	{"po4", "迫敀昢洦珀烞破砶釙粕蒪魄"},
+	{"pou1", "剖"},
+	{"pu1", "扑铺"},
+	{"pu2", "仆攴陠噗撲潽擈鯆匍莆脯菩菐葡蒱蒲僕酺墣獛璞濮"},
+	{"pu3", "朴圃浦烳普溥谱"},
+	{"pu4", "瀑曝"},
+	{"qi1", "七迉沏妻柒倛凄栖桤郪娸悽桼淒萋攲期棲欺蛣僛嘁慽榿漆戚"},
+	{"qi2", "祁齐圻岐岓忯芪亝其奇斉歧畁祇祈肵俟疧竒剘斊旂耆脐蚑蚔蚚颀埼崎帺掑淇猉畦萁萕跂軝釮骐骑棊棋琦琪祺蛴愭碁碕锜頎鬿旗"},
+	{"qi3", "乞邔企屺岂芑启呇杞玘盀唘豈起"},
+	{"qi4", "气讫忔気汔迄弃汽矵芞呮泣炁盵咠契砌栔氣訖唭欫夡棄湆湇葺碛摖暣甈碶噐憇器"},
+	{"qia1", "掐"},
+	{"qia4", "恰洽"},
+	{"qian1", "千仟阡圱圲奷扦汘芊迁佥岍杄汧瓩茾欦臤钎拪牵粁兛悭蚈谸铅婜孯牽釺掔谦鈆雃僉愆签"},
+	{"qian2", "乾前钤歬虔钱钳掮揵軡媊鈐靬鉗墘榩箝銭潛潜羬蕁橬錢黔"},
+	{"qian3", "浅肷淺脥嗛嵰遣槏膁蜸谴"},
+	{"qian4", "欠刋芡俔茜倩悓堑傔嵌棈椠慊皘蒨塹歉"},
+	{"qiang1", "呛羌戕戗斨枪玱羗猐跄椌溬腔"},
+	{"qiang2", "强墙嫱蔷"},
+	{"qiang3", "抢"},
+	{"qiao1", "悄硗郻嵪跷鄡鄥劁敲毃踍锹墝頝骹墽幧橇"},
+	{"qiao2", "乔侨荍荞桥硚菬喬僑谯嘺嫶憔蕎鞒樵橋癄瞧"},
+	{"qiao3", "巧"},
+	{"qiao4", "俏诮陗峭帩窍殻翘誚髚僺撬撽鞘"},
+	{"qie1", "切"},
+	{"qie3", "且"},
+	{"qie4", "怯郄匧窃"},
+	{"qin1", "亲侵钦"},
+	{"qin2", "芹埁珡秦耹菦蚙捦菳琴琹禽鈙雂勤嗪嫀溱靲慬噙擒"},
+	{"qin3", "寝"},
+	{"qin4", "沁"},
+	{"qing1", "青氢轻倾卿郬圊埥寈氫淸清"},
+	{"qing2", "情殑晴棾氰葝暒擏樈擎"},
+	{"qing3", "顷请"},
+	{"qing4", "庆"},
+	{"qiong2", "穷穹茕桏笻筇赹惸焪焭琼"},
+	{"qiu1", "丘丠邱坵恘秋"},
+	{"qiu2", "囚扏犰玌汓肍求虬泅虯俅觓訄訅酋釓唒浗紌莍逎逑釚梂殏毬球赇崷巯渞湭皳盚遒煪絿蛷裘"},
+	{"qu1", "区曲伹佉匤岖诎阹驱坥屈岨岴抾浀祛胠袪區紶蛆躯筁粬蛐詘趋"},
+	{"qu2", "渠絇翑葋軥蕖璖磲螶鴝璩蟝瞿"},
+	{"qu3", "取竘娶詓竬蝺龋"},
+	{"qu4", "去刞呿唟耝阒觑趣"},
+	{"quan1", "圈"},
+	{"quan2", "全权佺诠姾泉洤荃拳牷辁啳埢婘惓痊硂铨湶犈筌絟葲搼瑔觠詮跧輇蜷銓権踡縓醛鳈鬈騡孉巏鰁權齤蠸颧"},
+	{"quan3", "犬"},
+	{"quan4", "劝券"},
+	{"que1", "缺"},
+	{"que2", "瘸"},
+	{"que4", "阙却卻埆崅寉悫琷雀硞确阕塙搉皵碏愨榷墧慤確碻趞燩闋礐闕灍礭鹊"},
+	{"qun2", "裙羣群"},
+	{"ran2", "然髥嘫髯燃"},
+	{"ran3", "冉姌苒染"},
+	{"rang2", "瓤"},
+	{"rang3", "嚷壤攘"},
+	{"rang4", "让"},
+	{"rao2", "饶"},
+	{"rao3", "扰"},
+	{"rao4", "绕"},
+	{"re3", "惹"},
+	{"re4", "热"},
+	{"ren2", "人亻仁壬"},
+	{"ren3", "忍"},
+	{"ren4", "刃刄认仞仭讱任屻岃扨纫妊杒牣纴肕轫韧"},
+	{"reng1", "扔"},
+	{"reng2", "仍"},
+	{"ri4", "日"},
+	{"rong2", "茸戎肜栄狨绒茙荣容毧烿媶嵘搑絨羢嫆嵤搈榵溶蓉榕榮熔瑢穁縙蝾褣镕融"},
+	{"rong3", "冗"},
+	{"rou2", "柔媃揉"},
+	{"rou4", "肉"},
+	{"ru2", "如侞帤茹桇袽铷渪筎蒘銣蕠蝡儒鴑嚅嬬孺濡薷鴽曘燸襦蠕"},
+	{"ru3", "汝肗乳辱"},
+	{"ru4", "入洳嗕媷溽缛蓐褥"},
+	{"ruan3", "阮朊软"},
+	{"rui3", "蕊"},
+	{"rui4", "芮枘蚋锐瑞"},
+	{"run4", "闰润"},
+	{"ruo4", "若偌弱"},
+	{"sa1", "撒"},
+	{"sa3", "洒"},
+	{"sa4", "萨"},
+	{"sai1", "塞毸腮噻鳃"},
+	{"sai4", "赛"},
+	{"san1", "三弎叁"},
+	{"san3", "伞傘糁糂馓糝糣糤繖鏒鏾霰饊俕帴悷散"},
+	{"sang1", "桑"},
+	{"sang3", "嗓"},
+	{"sang4", "丧"},
+	{"sao1", "搔溞骚"},
+	{"sao3", "扫掃嫂"},
+	{"se4", "色洓栜涩啬铯雭歮琗嗇瑟"},
+	{"sen1", "森"},
+	{"seng1", "僧"},
+	{"sha1", "杀沙纱乷刹剎砂唦殺猀粆紗莎"},
+	{"sha2", "啥"},
+	{"sha3", "傻"},
+	{"sha4", "煞厦"},
+	{"shai1", "筛"},
+	{"shai4", "晒"},
+	{"shan1", "山彡邖删刪杉芟姍姗苫衫钐埏挻柵狦珊舢痁脠軕笘跚剼搧嘇幓煽"},
+	{"shan3", "闪陕"},
+	{"shan4", "汕疝剡扇訕赸掞釤傓善銏骟僐鄯墠墡潬缮嬗擅樿歚膳磰謆赡"},
+	{"shang1", "伤殇商觞傷墒"},
+	{"shang3", "晌赏"},
+	{"shang4", "上尙尚"},
+	{"shang5", "裳"},
+	{"shao1", "捎烧莦梢焼稍"},
+	{"shao2", "勺芍苕柖玿竰韶"},
+	{"shao3", "少"},
+	{"shao4", "邵绍哨"},
+	{"she1", "奢猞赊"},
+	{"she2", "舌佘虵蛇"},
+	{"she3", "舍"},
+	{"she4", "厍设社厙射涉涻渉設赦弽慑摂摄"},
+	{"shei2", "谁"},
+	{"shen1", "申屾扟伸身侁呻妽籶绅诜姺柛氠珅穼籸娠峷甡眒砷莘敒深"},
+	{"shen2", "什神"},
+	{"shen3", "沈审矤哂矧宷谂谉婶"},
+	{"shen4", "甚肾侺昚胂涁眘渗祳脤腎愼慎"},
+	{"sheng1", "升生阩呏声斘昇泩狌苼栍殅牲珄陞陹笙湦焺甥"},
+	{"sheng2", "绳"},
+	{"sheng3", "省"},
+	{"sheng4", "圣胜晠剰盛剩"},
+	{"shi1", "尸失师呞虱诗邿鸤屍施浉狮師絁釶湤湿"},
+	{"shi2", "十饣石辻乭时实実旹飠姼峕炻祏蚀食识拾"},
+	{"shi3", "史矢乨豕使始驶兘宩屎"},
+	{"shi4", "士氏礻丗世仕市示卋式忕亊叓戺事侍势呩柹视试饰冟室恀恃拭是昰枾柿眂贳适栻烒眎眡舐轼逝铈視豉釈媞崼弑徥揓谥貰释勢嗜弒睗筮觢試軾鈰鉃飾舓誓適鉽奭銴餙餝噬"},
+	{"shou1", "收"},
+	{"shou3", "手守垨首"},
+	{"shou4", "寿受狩兽售授涭绶痩壽夀瘦"},
+	{"shu1", "书殳尗抒纾叔杸枢陎姝倏倐書殊紓掓梳淑焂菽軗鄃疎疏舒摅毹綀输瑹跾踈樞蔬"},
+	{"shu2", "孰赎塾熟"},
+	{"shu3", "属暑暏黍署蜀鼠潻薥薯曙"},
+	{"shu4", "术戍束沭述侸凁咰怷树竖荗恕捒庶庻絉蒁術隃尌裋数竪腧鉥墅漱"},
+	{"shua1", "刷"},
+	{"shua3", "耍"},
+	{"shuai1", "衰摔"},
+	{"shuai3", "甩"},
+	{"shuai4", "帅"},
+	{"shuan1", "拴閂栓"},
+	{"shuang1", "双霜"},
+	{"shuang3", "爽"},
+	{"shui3", "水"},
+	{"shui4", "税裞睡"},
+	{"shun3", "吮"},
+	{"shun4", "顺舜順蕣橓瞚瞬"},
+	{"shuo1", "说"},
+	{"shuo4", "烁朔铄欶硕"},
+	{"si1", "丝司糹私咝泀思虒鸶媤斯絲缌蛳楒禗鉰飔凘厮榹禠罳蜤锶嘶噝廝撕"},
+	{"si3", "死"},
+	{"si4", "似巳亖四寺汜佀兕姒泤祀価孠杫泗饲驷娰柶牭洍涘肂飤笥耜釲竢覗嗣肆"},
+	{"song1", "松"},
+	{"song3", "怂悚耸"},
+	{"song4", "讼宋诵送颂"},
+	{"sou1", "搜溲獀蒐蓃馊摉飕摗锼艘"},
+	{"sou3", "擞"},
+	{"sou4", "嗽"},
+	{"su1", "苏甦酥"},
+	{"su2", "俗"},
+	{"su4", "肃洬涑珟素莤速宿梀殐粛骕傃粟谡嗉塐塑嫊愫溯溸肅遡鹔僳愬榡膆蔌觫趚遬憟樎樕潥碿鋉餗潚縤橚璛簌藗謖蹜驌鱐鷫诉"},
+	{"suan1", "酸"},
+	{"suan4", "蒜算"},
+	{"sui1", "虽"},
+	{"sui2", "绥隋随"},
+	{"sui3", "髓"},
+	{"sui4", "岁砕祟谇埣嵗遂歲歳煫睟碎隧嬘澻穂誶賥檖燧璲禭檅穗"},
+	{"sun1", "孙"},
+	{"sun3", "损笋"},
+	{"suo1", "唆娑莏傞桫梭睃嗍羧蓑摍缩"},
+	{"suo3", "所乺唢索琐惢锁"},
+	{"ta1", "他它她牠祂趿铊塌"},
+	{"ta3", "塔溚墖獭"},
+	{"ta4", "挞狧闼崉涾搨跶遝遢榻毾禢撻澾誻踏橽錔濌蹋"},
+	{"tai1", "胎"},
+	{"tai2", "台旲邰坮抬苔"},
+	{"tai4", "太夳忲汰态肽钛泰舦酞"},
+	{"tan1", "坍抩贪怹痑舑貪摊滩瘫"},
+	{"tan2", "坛昙倓谈郯婒惔覃榃痰锬谭墰墵憛潭談醈壇曇燂錟餤檀"},
+	{"tan3", "坦袒钽菼毯"},
+	{"tan4", "叹炭埮探傝湠僋嘆碳"},
+	{"tang1", "汤"},
+	{"tang2", "唐堂傏啺棠鄌塘搪溏蓎隚榶漟煻瑭禟膅樘磄糃膛橖篖糖"},
+	{"tang3", "倘偒淌傥躺"},
+	{"tang4", "烫摥趟"},
+	{"tao1", "涛绦掏絛詜嫍幍慆搯滔"},
+	{"tao2", "逃桃陶啕梼淘绹萄"},
+	{"tao3", "讨"},
+	{"tao4", "套"},
+	{"te4", "特"},
+	{"teng2", "疼痋幐腾誊漛滕邆縢駦謄儯藤"},
+	{"ti1", "剔梯锑踢"},
+	{"ti2", "啼崹惿提稊缇罤遆鹈嗁瑅綈碮褆徲漽緹蕛蝭銻题趧蹄"},
+	{"ti3", "体"},
+	{"ti4", "剃朑洟倜悌涕逖悐惕掦逷惖揥替楴裼褅歒殢髰薙嚏鬀嚔瓋籊趯屉"},
+	{"tian1", "天兲婖添"},
+	{"tian2", "田屇沺恬畋畑盷胋畠甛甜菾湉塡填"},
+	{"tian3", "腆觍痶睓舔"},
+	{"tiao1", "挑"},
+	{"tiao2", "条岧岹迢"},
+	{"tiao4", "眺粜絩覜跳"},
+	{"tie1", "帖怗贴"},
+	{"tie3", "铁"},
+	{"ting1", "厅庁汀艼听町耓厛烃"},
+	{"ting2", "廷亭庭莛停"},
+	{"ting3", "挺涏梃烶珽脡艇"},
+	{"tong1", "通"},
+	{"tong2", "同佟彤峂庝哃峝狪茼晍桐浵烔砼蚒眮秱铜童粡筩詷赨酮鉖僮勭鉵銅餇鲖潼獞曈朣橦氃燑犝膧瞳"},
+	{"tong3", "统捅桶筒"},
+	{"tong4", "痛"},
+	{"tou1", "偷"},
+	{"tou2", "头投"},
+	{"tou4", "透"},
+	{"tu1", "凸宊禿秃怢突"},
+	{"tu2", "图凃峹庩徒悇捈荼途屠涂"},
+	{"tu3", "土圡吐"},
+	{"tu4", "兔"},
+	{"tuan1", "湍"},
+	{"tuan2", "团"},
+	{"tui1", "推"},
+	{"tui2", "颓"},
+	{"tui3", "腿"},
+	{"tui4", "退娧煺蛻蜕褪"},
+	{"tun1", "吞"},
+	{"tun2", "屯坉忳芚饨豘豚軘飩鲀魨霕臀"},
+	{"tuo1", "托扡汑饦杔侂咃拕拖沰挩捝莌袥託涶脫脱"},
+	{"tuo2", "驮佗陀陁坨岮沱沲狏迱砣砤袉鸵驼"},
+	{"tuo3", "妥庹媠椭"},
+	{"tuo4", "拓唾"},
+	{"wa1", "挖洼娲畖窊媧嗗蛙哇"},
+	{"wa2", "娃"},
+	{"wa3", "瓦"},
+	{"wa4", "袜"},
+	{"wai1", "歪"},
+	{"wai4", "外"},
+	{"wan1", "弯剜婠帵塆湾蜿潫豌"},
+	{"wan2", "丸刓汍纨芄完岏抏玩紈捖顽烷"},
+	{"wan3", "宛倇唍挽盌埦婉惋晚梚绾脘菀萖晩晼椀琬皖畹睕碗"},
+	{"wan4", "万卍卐妧忨捥脕貦萬腕"},
+	{"wang1", "汪"},
+	{"wang2", "亡亾兦王"},
+	{"wang3", "网往枉"},
+	{"wang4", "妄忘迋旺盳望"},
+	{"wei1", "危威烓偎萎逶隇隈喴媙愄揋揻渨葨葳微椳楲溦煨詴蜲蝛覣薇燰鳂巍"},
+	{"wei2", "韦圩围帏沩违闱峗峞洈韋桅涠唯帷惟硙维喡圍媁嵬幃湋溈琟違潍为"},
+	{"wei3", "隗伟伪尾纬芛苇委"},
+	{"wei4", "卫未位味苿為畏胃叞軎尉菋谓喂媦渭爲煟碨蔚蜼慰熭犚緭衛懀璏罻衞謂餧鮇螱褽餵魏"},
+	{"wen1", "温榅殟溫瑥辒瘟"},
+	{"wen2", "文彣纹芠炆玟闻紋蚉蚊"},
+	{"wen3", "吻忟抆呡肳紊桽脗稳"},
+	{"wen4", "问"},
+	{"weng1", "翁嗡"},
+	{"weng4", "瓮"},
+	{"wo1", "挝倭涡莴唩涹渦猧萵窝窩蜗"},
+	{"wo3", "我"},
+	{"wo4", "沃肟卧枂臥偓捾涴媉幄握渥焥硪楃腛斡"},
+	{"wu1", "乌圬弙汙汚污邬呜巫杇屋洿诬钨"},
+	{"wu2", "无毋吳吴吾呉芜郚唔娪洖浯茣莁梧"},
+	{"wu3", "五午仵妩庑忤怃旿武玝侮俉倵捂啎娬牾珷摀碔鹉熓瑦舞伍"},
+	{"wu4", "勿戊阢伆屼扤坞岉杌芴迕忢物矹卼敄误悞悟悮粅逜晤焐婺嵍痦隖靰骛塢奦嵨溩雺雾寤熃誤鹜遻鋈窹霚鼿霧齀蘁騖鶩乄务"},
+	{"xi1", "夕兮吸忚扱汐覀希扸卥昔析穸肸肹俙徆怸恓郗饻唏奚屖悕氥浠牺狶莃唽悉惜捿晞桸欷淅烯焁焈琋硒菥赥釸傒惁晰晳焟焬犀睎稀粞翕舾鄎厀嵠徯溪皙蒠锡僖榽煕熄熈熙緆蜥豨餏嘻噏嬆嬉嶲潝瘜磎膝西息"},
+	{"xi2", "习郋席習袭觋媳椺蒵蓆嶍漝覡趘槢薂隰檄"},
+	{"xi3", "洗玺徙铣喜"},
+	{"xi4", "戏屃系饩呬忥怬矽细係咥恄盻郤欯绤細釳阋喺椞翖舃舄趇隙"},
+	{"xia1", "虾谺傄閕煆煵颬瞎"},
+	{"xia2", "匣侠狎俠峡柙炠狭陜峽烚狹珨祫硖翈舺陿硤遐敮暇瑕筪舝碬辖磍縀蕸縖赮魻轄鍜霞"},
+	{"xia4", "下乤吓疜夏"},
+	{"xian1", "先奾纤佡忺氙杴祆秈苮枮籼珗莶掀訮铦跹酰锨僊嘕銛鲜仙"},
+	{"xian2", "闲妶弦贤咸唌挦涎胘娴娹婱絃舷蚿衔啣痫蛝閑閒鹇嫌"},
+	{"xian3", "冼狝显险"},
+	{"xian4", "县咞岘苋现线臽限姭宪県陥哯垷娊娨峴涀莧陷晛現硍馅睍絤缐羡献粯羨腺"},
+	{"xiang1", "乡芗相香郷厢啌鄉鄊廂湘缃葙鄕稥薌箱緗膷襄忀骧麘欀瓖镶"},
+	{"xiang2", "详庠栙祥絴翔"},
+	{"xiang3", "享亯响饷晑飨想"},
+	{"xiang4", "向姠巷蚃项珦象塂缿萫衖項像勨嶑銗橡"},
+	{"xiao1", "宵庨消绡虓逍鸮婋梟焇猇萧痚痟硝硣窙翛萷销揱綃嘋嘐歊潇箫踃嘵憢獢銷霄彇膮蕭魈鴞穘簘藃蟂蟏鴵嚣削"},
+	{"xiao2", "淆"},
+	{"xiao3", "小晓"},
+	{"xiao4", "哮孝肖効咲俲效校涍笑啸"},
+	{"xie1", "些揳猲楔歇蝎"},
+	{"xie2", "协旪邪協胁垥奊峫恊拹挟挾脅脇衺偕斜谐翓嗋愶携瑎綊熁膎勰撷擕緳缬蝢鞋"},
+	{"xie3", "写"},
+	{"xie4", "泄泻祄绁缷卸洩炧卨娎屑屓偞偰徢械烲焎禼紲亵媟屟渫絏絬谢僁塮榍榭褉噧屧暬緤嶰廨懈澥獬糏薢薤邂韰燮褻謝駴瀉鞢瀣爕繲蟹"},
+	{"xin1", "心邤妡忻芯辛昕杺欣炘盺俽惞訢鈊锌新歆廞鋅嬜薪"},
+	{"xin4", "信軐脪衅"},
+	{"xing1", "星垶骍惺猩煋瑆腥兴"},
+	{"xing2", "刑行邢形陉侀郉型"},
+	{"xing3", "醒"},
+	{"xing4", "杏姓幸性"},
+	{"xiong1", "凶兄兇匈讻忷汹哅恟洶胷胸"},
+	{"xiong2", "雄熊"},
+	{"xiu1", "休俢修咻庥烋烌羞"},
+	{"xiu3", "朽"},
+	{"xiu4", "秀岫峀珛绣袖琇锈嗅"},
+	{"xu1", "戌旴疞盱欨胥须晇訏顼虗虚谞媭幁揟湑虛裇須楈窢頊嘘墟需"},
+	{"xu2", "徐"},
+	{"xu3", "许"},
+	{"xu4", "旭伵序汿芧侐卹怴沀叙恤昫洫垿欰殈烅珬勖敍敘勗烼绪续酗喣壻婿朂溆絮訹慉煦蓄"},
+	{"xuan1", "轩昍宣弲軒梋谖喧"},
+	{"xuan2", "玄玹痃悬旋"},
+	{"xuan3", "选晅烜選顈癣"},
+	{"xuan4", "绚眩"},
+	{"xue1", "靴薛"},
+	{"xue2", "穴斈乴学"},
+	{"xue3", "雪"},
+	{"xue4", "血"},
+	{"xun1", "勋埙焄勛塤熏"},
+	{"xun2", "寻旬巡驯杊畃询峋恂洵浔紃荀荨栒桪毥珣偱尋循"},
+	{"xun4", "讯伨汛迅侚巺徇狥迿逊殉訊訙奞巽殾稄遜愻賐噀潠蕈鵕爋顨鑂训"},
+	{"ya1", "丫圧压吖庘押枒垭鸦桠鸭呀"},
+	{"ya2", "牙伢厑岈芽厓玡琊笌蚜堐崕崖涯猚瑘睚衙"},
+	{"ya3", "哑唖啞痖雅"},
+	{"ya4", "亚襾讶"},
+	{"yan1", "烟珚胭偣啱崦淊淹焉焑菸阉咽"},
+	{"yan2", "延严妍芫言岩昖沿炎郔姸娫狿研莚娮盐琂硏閆阎嵒嵓湺筵綖蜒塩揅楌詽碞蔅颜"},
+	{"yan3", "奄俨兗匽弇衍偃厣掩眼萒郾酓嵃愝扊揜棪渰渷琰遃隒椼罨裺演"},
+	{"yan4", "厌闫妟觃牪姲彥彦砚唁宴晏烻艳覎验偐焔谚隁喭堰敥焰焱硯葕雁傿椻溎滟鳫厭墕暥酽嬊谳餍鴈燄燕"},
+	{"yang1", "央咉姎抰泱殃胦眏秧鸯"},
+	{"yang2", "扬羊阦阳旸杨炀飏佯劷氜疡钖垟徉昜洋"},
+	{"yang3", "仰佒坱岟养柍炴氧痒"},
+	{"yang4", "样羕詇様漾"},
+	{"yao1", "妖枖殀祅訞喓葽楆腰鴁邀"},
+	{"yao2", "尧尭肴垚姚峣轺倄烑珧窑傜堯揺谣軺嗂媱徭愮搖摇猺遙遥暚榣瑤瑶"},
+	{"yao3", "咬柼眑窅窈舀"},
+	{"yao4", "药要钥袎窔筄葯詏熎覞靿獟鹞薬曜燿艞藥矅耀"},
+	{"ye1", "椰暍噎潱蠮耶"},
+	{"ye2", "爷"},
+	{"ye3", "也吔冶埜野"},
+	{"ye4", "掖业叶曳页曵邺夜抴亱枼頁晔枽烨啘液谒堨殗腋"},
+	{"yi1", "一乊弌伊衣医吚壱依祎咿洢悘猗郼铱壹揖"},
+	{"yi2", "仪匜圯夷迆冝宐沂诒侇怡沶狋衪迤饴咦姨峓恞拸柂珆瓵贻迻宧巸弬扅栘桋眙胰袘訑貤痍移耛萓凒羠蛦詑詒貽遗媐暆椸誃跠頉颐飴疑儀熪箷遺嶬彛彜螔頤寲嶷簃顊彝宜"},
+	{"yi3", "乙已以钇佁攺矣肔苡苢庡舣蚁釔倚扆笖逘酏偯崺旑椅"},
+	{"yi4", "义亿弋刈忆艺肊议亦伇屹异芅伿佚劮呓坄役抑杙耴苅译邑佾呭呹峄怈怿易枍欥泆炈秇绎诣驿俋奕帟帠弈枻洂浂玴疫羿衵轶唈垼悒挹捙栧栺欭浥浳益袣谊陭勚埶埸悥掜殹異硛羛翊翌訲訳豙豛逸釴隿幆敡晹棭殔湙焲蛡詍跇軼鈠骮亄兿意溢獈痬睪竩缢義肄裔裛詣勩嫕廙榏潩瘗膉蓺蜴靾駅億撎槸毅熠熤熼瘞誼镒鹝鹢黓劓圛墿嬑嬟嶧憶懌曀殪澺燚瘱瞖穓縊艗薏螠褹寱斁曎檍歝燡燱翳翼臆"},
+	{"yin1", "因阥阴侌垔姻洇茵荫音骃栶殷"},
+	{"yin2", "吟犾苂斦烎垠泿圁峾狺珢荶訔訚婬寅崟崯淫訡银"},
+	{"yin3", "尹引吲饮蚓赺隐"},
+	{"yin4", "印"},
+	{"ying1", "应応英偀桜莺啨婴媖渶绬朠煐瑛嫈碤锳嘤撄甇緓缨罂蝧賏樱璎罃褮鍈霙鴬鹦嬰應膺韺甖鹰"},
+	{"ying2", "迎茔盈荧莹営萤营萦蛍溁溋萾僌塋楹滢蓥潆熒瑩蝿嬴營縈螢濙濚濴藀覮謍赢蝇"},
+	{"ying3", "颖摬影"},
+	{"ying4", "映暎硬"},
+	{"yo1", "哟"},
+	{"yong1", "佣拥痈邕庸傭嗈鄘雍墉嫞慵滽槦噰壅擁澭郺镛臃"},
+	{"yong3", "永甬咏泳俑勇勈栐埇悀柡涌恿傛惥愑湧硧詠塎嵱彮愹蛹慂踊"},
+	{"yong4", "用"},
+	{"you1", "优忧攸呦怮泑幽逌悠"},
+	{"you2", "尤由沋犹邮油肬怣斿疣峳浟秞莜莸郵铀偤蚰訧逰游"},
+	{"you3", "有丣卣苃酉友"},
+	{"you4", "又右幼佑侑狖糿哊囿姷宥峟柚牰祐诱迶唀蚴亴貁釉"},
+	{"yu1", "迂迃穻陓紆虶唹淤"},
+	{"yu2", "于邘伃余妤扵杅欤玗玙於盂臾衧鱼乻俞兪禺竽舁茰娛娯娱桙狳谀酑馀渔萸隅雩魚堣堬崳嵎嵛愉揄楰渝湡畭硢腴萮逾骬愚旕楡榆歈牏瑜艅虞觎漁睮窬舆"},
+	{"yu3", "与予伛宇屿羽雨俁俣禹语圄峿祤偊匬圉庾"},
+	{"yu4", "吁玉驭圫聿芋芌妪忬饫育郁昱狱秗茟俼峪彧浴砡钰预喐域堉悆惐欲淢淯谕逳阈喅喩喻媀寓庽御棛棜棫焴琙矞硲裕遇飫馭鹆愈滪煜稢罭艈蒮蓣誉鈺預嫗嶎戫毓獄瘉緎蜟蜮輍銉噊慾潏稶蓹薁豫"},
+	{"yuan1", "冤悁眢鸳寃渁渆渊"},
+	{"yuan2", "元円贠邧员园沅杬垣爰貟原員圆笎蚖袁厡圎援湲猨缘茒鼋園圓塬媴嫄源溒猿獂蒝榞榬辕"},
+	{"yuan3", "远"},
+	{"yuan4", "苑怨院垸衏傆媛掾瑗禐愿"},
+	{"yue1", "曰曱约"},
+	{"yue4", "月戉刖妜岄抈礿岳玥恱悅悦蚎蚏軏钺阅捳跀跃粤越"},
+	{"yun1", "晕"},
+	{"yun2", "云勻匀囩妘沄纭芸昀畇眃秐郧涢紜耘"},
+	{"yun3", "允阭夽抎狁陨"},
+	{"yun4", "孕运枟郓恽鄆酝傊惲愠運慍腪韫韵熅熨緷緼蕴"},
+	{"za1", "匝"},
+	{"za2", "杂砸"},
+	{"zai1", "灾甾哉栽"},
+	{"zai3", "宰载"},
+	{"zai4", "再在"},
+	{"zan2", "咱"},
+	{"zan3", "昝沯桚寁揝噆撍儧攅攒"},
+	{"zan4", "暂暫賛赞"},
+	{"zang1", "赃賍臧脏"},
+	{"zang3", "驵"},
+	{"zang4", "奘弉塟葬"},
+	{"zao1", "遭糟"},
+	{"zao2", "凿"},
+	{"zao3", "早枣蚤棗澡璪薻繰藻"},
+	{"zao4", "灶皁皂唕唣造梍喿慥艁噪簉燥竃譟趮躁"},
+	{"ze2", "则択沢择泎泽责"},
+	{"zei2", "贼"},
+	{"zen3", "怎"},
+	{"zeng1", "增憎"},
+	{"zeng4", "赠"},
+	{"zha1", "咋扎吒抯奓挓柤査哳偧喳揸渣"},
+	{"zha2", "轧札甴闸蚻铡"},
+	{"zha3", "眨"},
+	{"zha4", "乍灹诈咤柞栅炸宱痄蚱溠詐搾榨"},
+	{"zhai1", "斋斎摘"},
+	{"zhai2", "宅"},
+	{"zhai3", "窄"},
+	{"zhai4", "债砦債寨"},
+	{"zhan1", "沾毡旃栴粘蛅飦惉詀趈詹閚谵噡嶦薝邅霑氈氊瞻"},
+	{"zhan3", "斩飐展盏崭"},
+	{"zhan4", "占佔战栈桟站偡绽菚棧湛戦綻嶘輚戰虥虦覱轏譧蘸"},
+	{"zhang1", "张張章傽鄣墇嫜彰慞漳獐粻蔁遧暲樟"},
+	{"zhang3", "涨掌"},
+	{"zhang4", "丈仗扙帐杖胀账帳涱脹痮障嶂幛賬瘬瘴"},
+	{"zhao1", "招昭"},
+	{"zhao3", "找沼"},
+	{"zhao4", "召兆诏枛垗炤狣赵笊肁旐棹詔照罩肇"},
+	{"zhe1", "遮"},
+	{"zhe2", "折歽矺砓籷虴哲埑粍袩啠悊晢晣辄喆蛰詟谪馲摺輒磔輙銸辙"},
+	{"zhe3", "者乽啫禇锗"},
+	{"zhe4", "这柘浙這淛樜潪鹧蟅鷓蔗"},
+	{"zhe5", "着"},
+	{"zhen1", "贞针侦浈珍珎胗貞帪栕桢眞真砧祯針偵桭酙寊葴遉嫃搸斟楨獉甄禎蒖蓁鉁靕榛殝瑧碪禛潧箴樼澵臻"},
+	{"zhen3", "诊抮枕弫昣轸屒畛疹"},
+	{"zhen4", "阵纼甽侲挋陣鸩振朕栚紖眹赈酖塦揕敶瑱誫賑镇震"},
+	{"zheng1", "争佂姃征怔爭诤埩峥挣炡狰烝眐钲崝崢掙猙睁聇铮媜揁筝徰蒸"},
+	{"zheng3", "拯掟晸愸撜整"},
+	{"zheng4", "正证郑帧政症"},
+	{"zhi1", "之支卮汁芝吱巵汥坧枝泜知织肢栀祗秓秖胑胝衼倁疷祬秪脂隻梔戠椥臸搘禔稙綕榰蜘"},
+	{"zhi2", "执侄妷直姪値值聀釞埴執淔职貭植殖"},
+	{"zhi3", "止只劧旨阯址坁帋扺汦沚纸芷怾抧祉咫恉指枳洔砋衹轵淽疻紙訨趾"},
+	{"zhi4", "至芖志忮扻豸制厔垁帙帜治炙质迣郅峙庢庤挃柣栉洷祑陟娡徏挚晊桎狾秩致袟贽轾乿偫徝掷梽楖猘畤痔秲秷窒紩翐袠觗铚鸷傂崻彘智滞痣蛭軽骘寘廌搱滍稚筫置"},
+	{"zhong1", "中伀汷刣妐彸忠泈炂终柊盅衳钟舯衷"},
+	{"zhong3", "肿种"},
+	{"zhong4", "仲众妕狆祌茽衶重"},
+	{"zhou1", "州舟诌侜周洲诪烐珘辀郮徟掫淍矪週鸼喌粥"},
+	{"zhou2", "轴"},
+	{"zhou3", "肘帚"},
+	{"zhou4", "咒宙绉冑咮昼紂胄荮皱酎晝粙葤詋甃詶僽皺駎噣縐骤"},
+	{"zhu1", "朱劯侏诛邾洙茱株珠诸猪硃秼袾铢絑蛛"},
+	{"zhu2", "竹泏竺炢笁茿烛窋逐"},
+	{"zhu3", "主宔拄罜陼渚煮煑詝嘱濐麈瞩"},
+	{"zhu4", "著住助纻苎坾杼注贮迬驻壴柱殶炷祝疰眝砫祩竚莇紵紸羜蛀嵀筑註貯跓軴铸"},
+	{"zhua1", "抓"},
+	{"zhua3", "爪"},
+	{"zhuai4", "拽"},
+	{"zhuan1", "专叀専砖"},
+	{"zhuan3", "转"},
+	{"zhuan4", "赚撰篆"},
+	{"zhuang1", "妆庄妝荘娤桩莊梉湷粧装"},
+	{"zhuang4", "壮壯状狀壵焋漴撞"},
+	{"zhui1", "追骓锥"},
+	{"zhui4", "坠桘笍娷惴甀缒畷硾膇墜赘縋諈醊錣餟礈贅譵轛鑆缀"},
+	{"zhun1", "谆"},
+	{"zhun3", "准"},
+	{"zhuo1", "拙炪倬捉桌"},
+	{"zhuo2", "卓灼叕妰茁斫浊丵浞烵诼酌啄琢"},
+	{"zi1", "孜茊兹咨姕姿茲栥玆紎赀资淄秶缁谘嗞孳嵫椔湽滋粢葘辎鄑孶禌觜訾"},
+	{"zi3", "仔吇姉姊杍矷秄胏呰秭籽耔虸笫梓釨啙紫滓子"},
+	{"zi4", "字自芓茡倳剚恣牸渍"},
+	{"zong1", "宗倧综骔堫嵏嵕惾棕猣腙葼朡椶嵸稯綜緃熧緵翪蝬踨踪磫鍐豵蹤騌鬃"},
+	{"zong3", "总"},
+	{"zong4", "纵"},
+	{"zou1", "邹"},
+	{"zou3", "走"},
+	{"zou4", "奏揍"},
+	{"zu1", "租"},
+	{"zu2", "足卒哫崒崪族"},
+	{"zu3", "诅阻组俎爼珇祖"},
+	{"zuan1", "钻"},
+	{"zuan3", "纂"},
+	{"zui3", "嘴"},
+	{"zui4", "最晬祽稡罪辠槜酻蕞醉"},
+	{"zun1", "尊墫壿嶟遵"},
+	{"zuo2", "昨"},
+	{"zuo3", "左佐"},
+	{"zuo4", "作坐阼岝岞怍侳祚胙唑座袏做"},
+}
+
+// hanStrokes 记录了 U+4E00 至 U+9FFF 范围内每个汉字的笔画数, 每个字节表示一个汉字, 取值为笔画数加上 '0'.
+const hanStrokes = "" +
+	"12222223333334444444555555555566666777881233444445789:1234455791112223333334455556666789:1112233" +
+	"33444445666666666778888889999:;;;<===1234678233334444445666878882344666677788999999:<<==@F223333" +
+	"444444444444444444445555555555555555555555555566666666666666666666666666666666666666666666666666" +
+	"666666666666677777777777777777777777777777778777777777777777777777776777788888888888888888888888" +
+	"888888888888888888888888888888888888888888888899999999999999999999999999999999999999999999999999" +
+	"99999999999999::::::::::::::::::::::::::::::::::::::::::::::::::::::::::::::::::::::::::::::::::" +
+	";;;;;;;;;;;;;;;;;;;;;;:;;;;;;;;;;;;;;;;;<;;;;;;;;;;;;;;;;;;;;;;;<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<" +
+	"<<<<<<<<<<====================================>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>??>????????" +
+	"????????????????@@@@@@@@@@@@@@@AAAAAAAAAAAA@ABBB?BACCCDEEEEFFGH234445566666777777788888999::;;;<" +
+	"<=>@E234689244445666678889:9:;==@B24444445555667899::;24455667899:::::::;>@255556666677777778888" +
+	"8999:::::::::::;;;<<<<<=>????@@A2333455566667888;<<>>2455555689<22233444455555666666666666667777" +
+	"77777777777777888888888888888888888999899999999999::::::::::::::::::::::;<;;;;;;;;<<<<;=========" +
+	">>>>>???????????@@@@@ACEEGG2344555566666677777777777788888888888999999899999::::::;;;;;;;;;<<<<=" +
+	"========>>>>>>?????@AAACC234444444455555678889:;;<<;?245;;2555566667778999::;;;=>>>?@ACD244789;;" +
+	";233344445565666688888899;<E244555577888;233455566777788888999;:==24444555666677778888999999::::" +
+	";;;;<<<<<;==>>>=>??ACN2444555667888;;<>?2344444555678888899999::=@B35555555555555555555555555556" +
+	"666666666666666666666666777777777777777777777777777777777777777777777777777777777777777777777888" +
+	"888888888888888898988888888888888888888888888888888888888888889999999999999999999999999999999999" +
+	"99999999999999999999999999999999::::::::::::::::::::::::::::::::::::::::::::;:::::::::::::::::::" +
+	"::::::::;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;<;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;<<<<<<" +
+	"<<<<<<<<<<<<<=<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<;<<<<<<<<===============================<=" +
+	"======================>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>?>>>>>>>>???????????????????????" +
+	"?????<???????????>????????????@@@@@@@@@@@@@@@@@@@@@@?@@@@@@@@AAAAAAAAAAAAAAAAAAAAAAABBBBBBBBBBBB" +
+	"BBBBBCCCCCCCCCBCCDDDDDDDDDEEDEEEEEEEEFFFFFFFGEFGGHHHIIL35555566666667777777777777777778888888889" +
+	"9::::::;;;;;<<<;<=====>>>>?@@FJ34455555566666666666666666667777777777777777777777777777777777777" +
+	"78888888888888888888888888888888888888888889999999999999999999999999999999999999999999::::::::::" +
+	"::::::::::::::9:::::::::::::;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;<<<<<<<<<<" +
+	"<<<<<<<<<<<<<<<<<<:<<;<<<<<<<=<<<<<=<<<<<=========================================>>>>>>>>>>>>>>" +
+	"?>>>>>>>>>>>>>>>>>>>>>>>>>>>?????????????????>????????????@@@@@@@@@@@@@@@@@@@AAAAAAAAAAABBCCCCCC" +
+	"CCCDDDEGGHI34567777799:;;<<<=>>??@345678993789::>BCBE355566688:;;<>>>>?3344444555555566666666777" +
+	"7778888888:888999999899::::;;;;;<<;<<<===>>>>?@@BFG355566666666666666666677779777777777777777777" +
+	"7777777777778888888888888888888888888888888888888888898899999999999999999999:9999999999999999999" +
+	"999999999::::9:::::::::::::::::::::::::::::;;::::::::;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;" +
+	";;;;;;;;;;;;;;;;;;;;;;;<<<<<<<<<<<<<<<<<<<<<<<;<=<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<================" +
+	"===============<=====>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>????????????????????????????@@@@@@@@@@@@@@@@" +
+	"@@@AAAAA@AAAAAAAAAAA@AAABCBBBCCCDDDDDDDEEEFFFGHH333345666677777888888888999:::;;;<;<=>=>@@AACDDF" +
+	"355556666677777777788888888888888899999:9999:::::::::::::::::::;;;;;;;;;;;<<<<<<<<<<<<========>>" +
+	">>>>>>>>>?<????@@AACCCDE35666777899:::9;;;<<<>?344555566688999<==>34466677777899<<===?AA34455677" +
+	"7777888888899999:::::::::;;;;;<<;<>>????ABBCEH34634555556666666666667777777777777777777777777888" +
+	"888888888888888888888888888888888888889999999999999999999999999999999999::::::::::::::::9:::::::" +
+	"::::::;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;<<<<<<<<<<<<<<<<<<<<<<<<<=<<<<<<<<<<<<<<<<<<<==" +
+	"===========<===========>>>>>>>>>>>>>>>>>>>>>>>>???????????????????????@@@@@@@@@@@@@@@@@AAAAAAAAA" +
+	"BBBCCCDDDDDEDEFEEFFFFFGFGHG3236677;;?355566799:<=33347899999:<3444555566677777777888888888888999" +
+	"99999999::::::::::;;;;;;;;;;;<;<<<<<<<<<<<=======>>>>>>>>>?>???????>??@@@?AAABB@CD3566688=3459<3" +
+	"555566677777777777777888888988889999999:::::::::9;;;;;;;;;;;;<;<<<<<<=====<==>>>>>>>>>>>>>??>???" +
+	"?????@@@@@@BCCDDDEFI368788999:34456777899:>345666<=34445566667777888888888899999::::;;;;;;<<<<<=" +
+	"===>>>>???@@ABFG3356889;<==@@BBCJ377799::;;;;<=>>?F366777777888888888999999999::::::9:;;;;<;;;;;" +
+	";;;;;<<<<<<<=====>?>>?>????@@AACDDE4354565567776777666677666788787877877787777777777787777777878" +
+	"78877778888888988898888898888988988999889888888889888889998998889:999999999:999::9999::99::99:99" +
+	"999:9:9:9:999:9:999:::::999999:;::::::;::;;:::::;:::::::;:::::::;;:;;;::;:;;;:::;;<<;;<;;<;;;;;;" +
+	";<;;<;;;;<;;<;<;;<<;;;<;;;;;;;;;<<<<;<;;;<<<;;;;<<<=<<<=<=<<<<<<<==<<<=<=<<<<=<=<<<<<<<=<===<<<=" +
+	"<<<<<<<=>===>======>====>==>===>=>>====>>==>====>=>>>?>?>?>>??>>>>>>?>??<>>?>??>?>>>>>?>??>>?>??" +
+	">>????>?>?@?@????@????@@?@???@??@????@??@@????????@?@A@@@@@@A@@@@@AA@@@A@A@A@@@@@A@@@BBABAAAAAAB" +
+	"AAABAAAAABBBCBBCBBCBBCCCDDDCEEEFGGFGGIIL45556666677788889:;;;;<<=======>>>>>>???@@ABAF4445777888" +
+	"8999::::;<<4334555555556666666666666666666666777777777777777777877777777777777777777777777777777" +
+	"7777778888888888888888888888888888888888888888898888888888889888888888888899999999::99999999999:" +
+	"99999999:9999999:99999999:::999999999999::::::::::;::::::::;::::::::::::::::::::::::::::::::::::" +
+	":::::;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;<;;;;;;;<;;;;;;;;;;;;;;<;;;;;;;;;;;;<<;;;;;;;;;;;;<<" +
+	"<<<<<=<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<:<<=<<<=<<<<<=<<<<<<<<<<<<<<<<<<<<==========================" +
+	"===========>========>======>===>===========>>>>>>><>>>>>>>>>>>>>><>>>>>>??>>>>???>>>>>>>>?>>>>>>" +
+	"?>>?>??>?@????????????????????????????>????????????????????@@@@@@@@@@@?@@@A@@@A@@@@@@@@@A@@@@@@A" +
+	"AAAAABABAABAAAAAAAB@BBBBBBBBBBBBCBBBBBBCCCCCCCCCCECDDDDDDDDEEEFFFFFGFGFFGGGGHHI469<@446677777889" +
+	"999999:::::::;;;;;;;;;;;;;;;<;<<<<<<<<<<<<<======>>>@???????@@@@AAAACDD478::<;;;<<=@BEG47::;;<<=" +
+	">>@A45888899;;<<=<>?@AABI48889999::::::;;;:;;;;;<<====>>@@ABCCCD449;=455666666667777777778888888" +
+	"8888888888888888888888888899999999999999999999999999999999999999:::::::::::::::::::::::;;;;;;;;:" +
+	":;;;;;;;;;<<<<<<<<<<<<<<=<<<<<<<<<<<=============<========>>>>>>>>>>>>>>>???????????????@@@@?@@@" +
+	"@@@@@@@@@@@@@@A@AAAAAAAABAABBCCCCCCDDDDDDEGGGHHI45667789:;::;;<<<<<=>>@E4688889999::::;:;;;;<<<<" +
+	"==>@@@BD4455555556666666666666666666777777777777777777777777777777777777778888888888888888888888" +
+	"8788888888888888888888888888888888888888888888999999999999999:9999999999999999999999999999999999" +
+	"9:999999999999999999:99998999999999999999999999999::::::::::::::::::::::::::::::::::::::::::::::" +
+	"::::::::::::::::::9::::::::::::::::::::::::;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;" +
+	";;;;;;;;;;;;;;;;;;;;<;;;;;;;;;;;;;;<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<" +
+	"<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<=======================================;=========" +
+	"==============<=<=========================>==>>>>>>>=>>>=>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>" +
+	">>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>?????????????????????????????????>????????????????????????????" +
+	"????????@?????>???@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@?@@@@@@@@@@@@@@@@@@@@@@@AAA" +
+	"AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAABBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBCCCCCCCCCCCCCCCCACCCC" +
+	"CBCCCCCCDDDCDBDDDDDDDDDFEDEEEEEEEEEEEEFFFFFFEFFGGGGHHHIIIIIIJILL46687888999:::::::;;:;;;;<<<<<<<" +
+	"=========>>>>>??????@@@@@@@AAABBCF4567888899::<<>===>??@B456788888999999::::;;;;;;;;<<<<<<<==>>>" +
+	">>?????@@@@@AAABCCE4899:;;;;<<<====>>??@@BG44567789=46999:A4778899:::::::::;;;;<<<<<<<<=======>?" +
+	"???@@@@@AAABCFJ45568456667788899999:::::::;;<<<<<=>>43555555665555755565556666666666667666666676" +
+	"666667777777877777777776777777778777777777877777777877797777787777777777777888898888888888888888" +
+	"888888888988888888888888889888888888888888888888:8889998888888899999999999999:99999999:99999:999" +
+	"999999999999999:8999999999999999999999:999999999999999::::::::::::::::::::::::::::::::::::::::::" +
+	"::::::::::::::;::::::::::;::::::::::::::::;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;" +
+	";;;;;;;;;;;;;;;;;;;;;;;;;;;;<;<;;;;<;;;;;;;;;;;;;;;;;;;;<<;<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<" +
+	"<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<=<<<<<<<;<<<<<<<<<<<<<<<<<<<<<<<<===================" +
+	"===========================================<>=>======?========<============>>>>>>>>>>>>>>>>>>>>>" +
+	"?>>>>>>>>>>?>>>>?>>=>>>>>>>>>>>>>>>>>>?>>>>>>>>>>>>>>>>>>>>>>?>?>?>>>>>>>>>>>>>???????????????@?" +
+	"???????????????????>????????????A??@?????????????????????@????@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@" +
+	"@@@@@@?@@@@@A@@@?@@@AA@AAAAAAAAAAAAAAAAAAAAAAAAAAAACAAABABBBBBBBBBBB@BBBBBBBBBBBBBBBBCCCCCCCCCCC" +
+	"CCCCCCBCCCDCCCCDDDDDDDDDDDDDDDDDDDDEEEEEEEEEEEJEEFFGFFFFFGGGGHHHHHIIJKJKKOP445666666777777777777" +
+	"78888888888888888888888888888889999999999999999999999999999999999999::::::::::::::::::::::::::::" +
+	":::::::::::::::;;;;;;;;;;;;<;;;;;;;;;;;;;;;;;;;;;;;;;;;;;<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<" +
+	"<====================>=====>==================<==========>=>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>??????" +
+	"??????????????????????@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@AAAAAAAAAAAAAAAAAAAAAAAAABBBBBBBB" +
+	"BBCBCCCCCCCDCCCDDDDDDCDDDEEEFEFFFGGHIIILMQ4488999:<>?A468:=49;>489:=>?A4899<<<=====>>???C4<44666" +
+	"7777788888888999999999:::::;;;;;<;<<<<<<<<<<======>>>>>>????@@@@ACCCCCCDDFIK43555666667667777777" +
+	"877777777898888888888888888888899999999999999999999::::::::::::;::::;;<;;;;<<;;;;;<;;;;;;;;;;;;;" +
+	";;<<<<<<<<;<<<=<<<<<<<<=<<=========>>======?>>?>>>>>>>?????????????@?@@@@@@@@@AAAAAAABBBCCCDDDEF" +
+	"FGGG59:;;5545566667777777777888888888888888888888899999999:9:999999999999999999999::::::::::::::" +
+	":::::::::::::::::::;;;;<;:;;;;;;;;;;;;;;;:;;;;;;;;;;<<<<<<<<<<=<<<<<<<<=<<<<<<<<<<<<<<<<<<<<<===" +
+	"================================>>>>>>>>>?>>?>>=>>>>>>>>>??????@?????????????@?@A@@?@@@A@@@@@@@@" +
+	"@@@@@AABAAAAAAAAAAAAABBCBBDBBCBBBBBCCCCCCCCCDDDDDDDEEFEFFFGH58::;=@CFH5788999999999:::;;;<<<<===" +
+	">=>>>>>???@@@@@AAAABBBCE589;;==59:;;<<<>5567799<555555677778788888888899999999999999::::::::::::" +
+	":;;;;;;;;;<<<<<<;<<<<======>>>??@@@AABCCDDF58:<<>>577777888888888899999999999999:::::;::::9:::::" +
+	"::::::::::;;;;;;;;;;;;;<<<<<<<<<<<<<<<<<<<<<===========================>>>>>>>>==>>>>>>>>???????" +
+	"???????>????@@@@@@@@@@@@@@@@@@@@AAAAAAAAAA@AAAABBBBBBBBBBBBBBBBCCCDCBDDEEEEEFFFGGHHJLN58999<<566" +
+	"777789999;::::;;;;<<<<<===?????@@@AAAABBBCDDEF58::;<<=>>>>?@ABD5778999999:::::::::;;;;;;;<<;<===" +
+	">>>>?@@@AAABBD57888888999999999999999999999999:::::::::::::::::::::::;;::::::;;;;;;;;;;;;;;;;;;:" +
+	";;<<<<<<<<<<<<<<<<============================>>>>>>>>>>>=>>>>>>>>>>>>>?????????????@@@@@@@@@@@@" +
+	"@@@@A@AAAAAAAAAAAAAAAAAABBBBBBBBBBBCCCCCCCCDDDDDEEEEGHHHIIJ59:<<=I5788999:;;<<=AACD5777888888888" +
+	"88999999999999999999999999999:::::::::;:::::::::::::::::::::::::::;;;;;;;;;;;;;;;;;;;;;;;;;;<<<<" +
+	"<<<<<<<<<<<<<<<<<<<<<<<<======>==============================>>>>>>>>>>>>>>>>>>>=>>>>>>>>>??????" +
+	"?>????????????????????@???@@@@@@@@@@?@@@@@@@@@@AAAAAAAAAAAAAAAAAAAAAAABBBBBBBBBBBBBBBBBCCCCCCCCC" +
+	"CCDDDDDDDDDDDEEEEEEEEFGGHI546788888899999999999::::::::::::::::::;:;;;<;;;;;;;;;<<<<<<<<<=======" +
+	"====<==>>>>>>>>>>>>>>>>>>>??????????@@@AA@AABBBBCCDFFGHH599;<=57777788888889999999999999::::::::" +
+	":::::::::::::::::;;;;;;;;;;;;;;<<<<<<<;<<<<<<<<=======================>>>>>>>>>>>>>>?>??????????" +
+	"????@@@@@A>@@@@@@@@@AAAAAAAAAAABBBBBCCCCCCCCDDDDFFHH5677888899999999:::::::::::;;;;;;;<<<<;<<<==" +
+	"========>>>>>>???????@@@@@@@@@AAAAAEBBCDEEF5778899999999:::::::;;;<<<<<<====>>>>>@AAABDDF6889999" +
+	"9999::::::::::::::::::;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<==" +
+	"============<========>===========>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>????????????>???????" +
+	"=????????@??????????@@@@@@@@@@@@@@@@@@@@@@@@@@@@AAAAAAAAA@AAAAAAAAAAAAAAAAAAAAAAA@AAAAAAABBBBBBB" +
+	"BBBBBBBBBBBBCBBBBBBCCCCCCCCCCCCCCCCDDDDDDDDDDDDDEEEEEDEFFFFFFFGFFFGGGGGGGIHIIIIJJNP6888999999999" +
+	"999:::::::::::::::;;;;;;;;;;;;<<<<<;<<<<<<<<<<========>>>>>>>>>>=>?????????????@@@@@@@@@@AAAAAAA" +
+	"AAABBBBBACCCCCDDDFEJFGIK667788889999999999::::::::::::::::::::::::::::::;;<<;;;;;;;;;;;;;;;;;;;;" +
+	";;;;;;;;;;;;;<<<<<<<<<<<<<<=<<<<<<<<<<<<<<<<<<<<<<<<<<<<======<=======================>=====>>>>" +
+	">>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>?>>??????????????????????????????????????????" +
+	"????????@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAACAABBBBBBBBBBBBBBBBBB" +
+	"BBBBBBCBCCCCCCCCCBCCCCCCCCCDDDDDDDDDEEEEEEEEEEEEEFFGGGGHIIIIKKM356666666666677777777777777777778" +
+	"88888888888888888999999999999999:::::::::;;;;;;;;;;;;;;;;;;;;;;;;;<<<<<<<<<<<<<<<<<<<<<<<=======" +
+	"=====>>>>>>>????@@@@@C689::;:;<<<>>@AAABBBCCDEFFH6548788989::::::::;<<<========>>>=>>????@@@@AAA" +
+	"BABCCCCFHH688999:9:::;::::;;;;;;<<<========>?????@@BBBCCCCDEE699:::::::;;;;;;;;;;;;<<<<<<<<===>>" +
+	">>>>>>????????@@@@AAAAABBBBBACCDD646:8:9;;:<699999689:::::;;;;;;<==>>>??@@@AABBDEFF67899::::::::" +
+	":::;;;;;;;;;;;<<<<<<<====>>>>>>><>>>????????@@@AAAAAABBBCDDDDFF64::8===>>65666687777777777777777" +
+	"7888888888888888888888888888888888999999999999999999<99999999999999999999999;:::::::::::::::::<:" +
+	":::::::::9::::::::::<;;;;;;;;;;;<;;;;;;;;;;;;;;;;;;;;;;;;<<<<<<<<<<<<<<<<<<<<<<<><<<<<<<<<<=====" +
+	"====================<==========>>>>>>>>>>>>>>>>>@>???????????????????A@@@@@@@@@@@@@@@@@@A@AAAAAA" +
+	"AAAAAAAAAAACABBBBBBBCCDCCDDDDEFFIIG688<>AA67::<<<?@69<<<<<>@6889::;<<=@>@@BC6888:;<>>>??@BC6<=>6" +
+	"89999:::::::::::::;;;;;;;;;;<<<<==========>>>>???????@@@@@AAAABBBCCCCCDDDDEFGH678A6:;<CH63566666" +
+	"66677777777777777777977788888888888888888888888888888888888:888888888888888888889999999999999999" +
+	"9999999999999999999999999999999999999999:999999999<999:::::::;:::::;::::::::::::::::::::::9<::8:" +
+	"::::::<::::::::::::::::::;:::::::::::::::::;::::;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;<;;;;;" +
+	";;;;;;;;;;;;;;;;;;;;;;;;;;;=;;<<<<<<<<<<<<<<<<<<<<<<<<<<<=<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<" +
+	"<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<=<<================<==============================<========" +
+	"==========================================?=====>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>" +
+	">>>>>=>>=>>>>>>>>>>>>>>>>>>>>>@>?>>>>>>??????????=?????????????????????=????????????????????????" +
+	"??????????????????????????????@@@@@@@@@@@@@@@@@?@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@AAAAAAAAAA" +
+	"AAAAAAAAAAAA@AAAAAAAAAAAAAAAAAAAAAAAAAAAABAAAAAABBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBBCBBECCCCCCCCCCC" +
+	"CCCCCCCCCCCCCCDCCCCCCCDCCDDDDDDDDDDDDDDDDDDDDDDDDDDDDEEEEEEDEEEEEEDEDEEEEEEEFEEEDGFFFFFFGGGGGGGG" +
+	"GGIHJHIIIIKML6889::::;;;;;;<=<==>>?@@@@AABJ6788888899999999999999999::::<:::::::::::::::::::::::" +
+	":::::::::::;:;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;<<<<<<=<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<===========" +
+	"=================>>=====>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>>=>>>>>?=???????>??????????" +
+	"???????????>?????????????@???????@?@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@AAAAAAAAAAAAAAAAAAAAAA" +
+	"AAAAAAAAAAAAAAAAAAABBCBBBBBBBBABB?BBBBBBBBBBBBBBBBBBBBCCCCCCCCCCCCCCCCCCCCCCCCBCDDDDDDDDDDEEEECE" +
+	"EEEEEFFFFEFEFGGGGGGGGHHHHHHIJKKK699::;<<<<EH699:;;;;;<<<==????@@@@H6589989999:::::::::::::::::::" +
+	"::::::::;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;;<<<<<<<<<<<<<<<<<<<<<<<<<=============<========" +
+	"==>===?>>>>>>>>>>>>>>>>>>>>>>>>>><>>>?????????????????????>???@@@@@@@@@@@@@@@@@@@@@A@AAAAAAAAAAA" +
+	"AAAAAABBBBBBBBBBBBABABCCCCCCCCCCCCCDDDDDDEEEEEEFFFFGGHGHIIIIIK6669;<<=BCCGI79::;;;;;;<;<<<<==>>>" +
+	">>????@@@?@AAAAABBBBCCCCCDDDEEFFI467889999:;<<=>??799;;;;;<<=<<=========>>>>?????@AABCCDDDEFFGGI" +
+	"779999999::::::::::::::::::;;;;;;;;;;;;;;;;;;;;;;;;;<<<<<<<<<<==<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<" +
+	"<==========================================>>>>>>>>>>?>>>>>>>>>>=>>>>>>>>>>>>>>?????????????????" +
+	"????????????????????????????@@@@@@@@@@@@@?@@@@@@@@@@@@@@?@@@@@@@@@@@AAAAAAAAAAAAAAAA@AAAAAAAAAAA" +
+	"AAABBBBBBBBBBBBBBBBBBBBBBBBBBBBCCCCCCECBCCCCCDCCCCCCCCCCCCCCCDDDDDDDDDDDDDDDDDDDDDDEEEEEEEEEEEFF" +
+	"FFFFFFFGGGGGGGGGGHHHHHHIIIJJKKKM2444445555555555556666666666666667777777777777777778888888888888" +
+	"88888888889999999999999::::::::::::::::::::;;;;;;;;;;;;;;;;;;;;<<<<<<<<<=====>>>>>>???C7:;;;=>?A" +
+	"AABCFG7::;==???ABDIKL78:;;;;;;<<<<=====>>>>@?@@AAAAABBCC7:::;;<<<<<<=======>>>??@@@@AAABBBCIK799" +
+	"9::::;;;;;;;;;;<<<=<<<<<<<<<<<<<<<=============>>>>>>>>>>?????????????????????@@@@@@@@AAAAAAAABB" +
+	"BBBBBBCCCCCCCDDDEEEEEFFFFGGHI4666778888888888888999999999999:::::::::::;;;;<<<<<<<<<<<==>>>>?@@@" +
+	"@AAE7;;;===>@?@A769999:::;;;;;;;<<<<<<<<<<<<=========>>>>>>??????????@@@ABCCCCDEEGJ79::::;;;=;;;" +
+	";;;;;<<<<<<<<<<<=<<<<<<<<<<<<<<======================<======>>>>>>>>>>>>>>>>>>>?????????????????" +
+	"????????????????@@@@@@@?@@?@@@@@@@@@@@AAAAAAAAAAAAAABBBBBBBBBBBBBBBBBBBBCBCCCCBCCCCCCCCCCCCCCCCC" +
+	"BDDDDDDCDDEEEEEEFFFFFFFFGGFGGHHHHIIIIIJJLKK7:;;;<==>>>???????@@BBBCCCCDEHK7899::::::::;;;;;;;;;;" +
+	";;;;<<<<<<<<<=<<<<<<<<<<<<<<<<===============>>>>>>>>>???????????????????????@@@@@@@@@@@@@@@@AAA" +
+	"AAAAAABBBBBBBCCCCCCCCDDDDDDEEEEEFFFGGK4567778888899999999999::::::::;;;<<<<<<<<======>>>?@@7<<==" +
+	"=>>>?@@@@@@ABCDE7:=?C745666666777777777777888888888888888888888899999999999989999999::::::::::::" +
+	":::::::::::;;;;;;;;;;;;;;;;;;;;;;;;;;;;;<<<<<<<<<<<<<<<<<<<<============================>>>>>>>>" +
+	">>>>>>????????????@?@@@?@@@@@AAAAAAAAA@BBACCCDEGG7556:666666667777777777777;78888888888888889999" +
+	"999999999999=99999>9;9::::::::::::::::::;;;;;;;;;9;;;<?;;<@<<;<<<<<<<<<<<<<=============>>>>>>>>" +
+	">>>>>>??B???<??????@@@@@AABBBBBCCDCDEEEFF799:::::::;;;;;;;;;;;;<<<<<<<============>>>>>>>>>>>>>>" +
+	"?????????????@@@@@@@@@@@AAAAAAAAAAAAABBBBBBBBCCCCCDDDDDDDEEEGHHHHIHJJK78;<<D79;<B8899:::::::::::" +
+	":::;;;;;;;;;;;;;;;;;;;;;;;;;;<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<>==================" +
+	"=====================================================>>>>>>>>>>>>>>>>>>>>>>>>>>=>>>>>>>>>>>>>>>>" +
+	">>>>>>>>>>>>>>>>>>?????????????????????????@??????????????????????????????????>????????@@@@@@@@@" +
+	"@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@@@@@@@@@@@@@A@AAAAAAAAAAAAAAAAAAAAAAA" +
+	"AAAAAAAAAAAAAAAAAAAAAAAAAA@AAAAAAAAAAAAABBBBBBBBBBBBBBBBBBBBBBBBBABBBBBBBCBBBBBABBBBBBBBBBBBBBBB" +
+	"CCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCDEDDDDCDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDDD" +
+	"DDDDDDDDDEEEEEEDEEEEEEEEEEEEDEEEEEFFFFFFFFFFFFFFFFFFFGGGGGGGGGGGGGGGGGGFHHHHHIIIIIIIJJJJJJJKKKKL" +
+	"LLLMM5667777788888888888999999999999999999999999:::::::::::::::::::::::::::::::;;;;;;;;;;;;;;;;;" +
+	";;;;;;;;;;;;;;;;;;;;;;;;<<<<<<<<<<<<<<<<<<<<<<<<<<<<<<============================>>>>>>>>>>>>>>" +
+	">>>>>>????????????????@@@@@@@@@@@AAAAAAAAAAABBBBBBCDDFF88;;<?CF5899:::;;;;;<<<<<<<<<<<<<========" +
+	"=>>>>>>>>>>??????????@@A@@@@@@@@A@@AAAAAAAAAA@AABBBBBBBBBCCCCCDDDDEEEEEF345666667777777788999999" +
+	"999::::;;;;;;;;;;<<<<<====>@8355666667777777777777777778888888888888888888999999999999::::::::::" +
+	"::::::::::;;;;;;;;<;;;;;;;;<;<<<<<<<<<<<<<<<<<<=<========>>>>>>>>>????@@@@@@AAAAAAABCD8@A8:::::;" +
+	";<<<<<<<<===>===>>>?@@ABBBBBBBBBBCBCEHHL8;;;<<<<<<<<===========>>>?????????@@@@@@@@@@@@@AAAAAAAA" +
+	"AABBBBCCCCCCCCDDDDEDDEEEEEEEFFFFFGHHHHHHHIJKKKKMW88<<==>>>?@@B8<?C98>?@EG9;<<<<<<<========>>>>>>" +
+	">>>>>>>????????????@@@@@@@AAAAAAAABBBBBBBBBBBBBBBCCCCCCCDDDDEEEEFFFFGGHHHJN9<>>???@AAABBBBBDCCCC" +
+	"DEEFHH479<==>9=?@AC9==>>@BBCCDDDG9;;;<<<<===========>>>>>>>?????????@@???????@@@@@@@@@@@@@@@@@BB" +
+	"AAAAAAAAAAABBBBBBBBBBBCCCCCCCCCDDDDDEEEEEFFFGGGGHIIKK6889999:::::::::;;;;<<<<<<<=======>??????@@" +
+	"@@BBCDEG9<<==>>>>>??@@AABBBCCCCCCDDDDEEEEEEFKK47999<=>>>?@@9EK399;:::;;<<<<<=<=<<=<=====>=?=====" +
+	">>>>>>>>?>??>?>>@?????????@@@@@@@@@@@A@@@@AAAABAAAAA@ABABBBBBBBBBBACCCACCCDDDDDEDDDDFFEEEDFFGHII" +
+	"KKN35566777777788888899999999::::::;;;;<<=<<=====>>??I9;A9=>>>@@@AAABBCCDEGK:<<=======>>>>>>>>>>" +
+	">>>>>>>>?????????????????????????@@@@@@@@@@@@@@@@@@AAAAAAAAAAAAAAAAABBBBBBBBBBBBBBBBCCCCCCCCCCCC" +
+	"CCCCCCCCCDDDDDDDDDDDDDDDDEEEEEEEEEEEEEEEFFFFFFFFFFFFFFGGGGGGGHHHJJJJKJKKLLMN35666777788888888888" +
+	"8999999999::::::;;;;;;;<<<<<====>>>?ACDD:<=====>>>??????@@@@@AA@BBCCDCDDDDDDDEEEFGFGGHIJ:;>?BFG:" +
+	"<==>>>>>>>>??@???????@@@@@@@AAAAAAABBBB@BCCCCCCCDDDDEEEEEFFFFFGGGHHIK:>?@BDFHHK:KM:@ABBCDEEF:=>>" +
+	">>>????@AABBBBBBDEEEEFFHHH;====>>>>???????????????????????@@@@@@@@@@@@A@@@@@@@@@@@@@@@@@@AAAAAAA" +
+	"AAA@AAAAAAAAAAAAAAAAABBBBBABBBBBBBBBBCBBBBBBBBABBBBBCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCCDDDDCDDBDD" +
+	"DDDDDDDDDDDDDDDDDDDDDDDDDDDDEEEEDEEEEEEEEEEEEEEEEFFFFFFFFFFFFFFFDFFFFFFFFFGGGGGGGGGGGGGGGGGGHHHH" +
+	"HHHHHHHHIHGHIIIIHJJJJJJKKMNQ8:;<<<<<=============>>>>>>>>>>>>>>>?????????????@@@@@@@@@@@@@@@AAAA" +
+	"AAAAAAAAAAAABBBBBBBCCCCCCCCCDDDDEEEEF;<=====9====>>>>>>???????????????????@????@@@@@@@@@@@@@A@@@" +
+	"@@@@@@@@@@@@@AAAAAAAAAAAAAAAAAAAAAAAAAAAAABBBBBBBBBBBBBBBBBBBBBBBCBBBBCACCCCCCCCCCCCCCCCCCCCCCCC" +
+	"CCCCCCCCCCCCCCCCDCDDDDDDDDDDDDDDDDDDDDDDDDDDEEEEEEEEEEEEEEEEEEEDEEEEEEEEEEEFEEEEFFFFFFFFFFFFFFFF" +
+	"FGGGGGGGGFGGGGGGGGGGGFGGGHHGGHHHHHHHHHHHHHHIIIIIIIIJJKKKLLLMMNN57788899999:::::::::::::;;;;;;;;;" +
+	"<<<<<<<<<============>>>?>>>>????????@@@@AAAABBBBCCFF;?@CDEEHH@;===??@@@@AAABBBBBCCCCCCCDDDDEEFG" +
+	"HILOQ;7>????@@@AABBCCDFM;?@;>>?BCDG<<@@AAABBI<?AF<;?@@@@@?AAAAABBBCCDDDDDDDEEEEEFFGGGIJKL<@AC=8A" +
+	"BBBCCEGGIIJ<=D=???@==BBCEEEEGIHI=8AAABBBBBBBBCDDDEFFFFGGGGL>@@AACCFGGHHIKT>ABCEG6??@ABCCCDDDEDDD" +
+	"DDADEEEEEEFFFGGGGGHHHHHHIIIILLS8:;<====>>??AA@BCCDEFFFPQ`5;;@EL7AEFIJJ00000000000000000000000000" +
+	"0000000000000000000000000000000000000000000000000000000000000000"
@@ -0,0 +1,191 @@
+package comparator
+
+import (
+	"os"
+	"sort"
+	"strings"
+	"testing"
+)
+
+/**
+ *
+ * @Author AiTao
+ * @Date 2026-10-18 10:05
+ * @Url
+ **/
+
+func TestPinyin(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"张三", "张三", 0},
+		{"张三", "李四", 1},
+		{"李四", "Zhang", -1},
+		{"Zhang", "张三", -1},
+		{"zhang", "张", -1},
+		{"Zhang", "zhang", -1},
+		{"妈", "麻", -1},
+		{"麻", "马", -1},
+		{"马", "骂", -1},
+		{"西安", "先", -1},
+		{"长城", "重庆", -1},
+		{"王五", "王", 1},
+		{"阿", "爸", -1},
+		{"吕", "鲁", 1},
+		{"123", "阿", -1},
+		{"我们", "zz", -1},
+		{"几个", "zz", -1},
+		{"哦", "ou", -1},
+	}
+	for _, tt := range tests {
+		if got := Pinyin(tt.a, tt.b); got != tt.want {
+			t.Errorf("Pinyin(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+		if got := Pinyin(tt.b, tt.a); got != -tt.want {
+			t.Errorf("Pinyin(%q, %q) = %d, want %d", tt.b, tt.a, got, -tt.want)
+		}
+	}
+}
+
+func TestPinyinSort(t *testing.T) {
+	names := []string{"张三", "Zhang", "李四", "王五", "alice", "Bob", "陈七", "赵六"}
+	sort.Slice(names, func(i, j int) bool { return Pinyin(names[i], names[j]) < 0 })
+	want := []string{"alice", "Bob", "陈七", "李四", "王五", "Zhang", "张三", "赵六"}
+	for i := range want {
+		if names[i] != want[i] {
+			t.Fatalf("sorted = %v, want %v", names, want)
+		}
+	}
+}
+
+func TestPinyinStrokeFallback(t *testing.T) {
+	// 丂(U+4E02)、丞(U+4E1E)、丨(U+4E28) 与 𠀀(U+20000) 均未收录读音, 排在所有拼音之后, 并按照笔画数排列
+	if got := Pinyin("丂", "zzz"); got != 1 {
+		t.Errorf("Pinyin(%q, %q) = %d, want 1", "丂", "zzz", got)
+	}
+	if got := Pinyin("丂", "𠀀"); got != -1 {
+		t.Errorf("Pinyin(%q, %q) = %d, want -1", "丂", "𠀀", got)
+	}
+	if got := Pinyin("丨", "丞"); got != -1 {
+		t.Errorf("Pinyin(%q, %q) = %d, want -1", "丨", "丞", got)
+	}
+}
+
+func TestPinyinReadings(t *testing.T) {
+	tests := []struct {
+		s, reading string
+	}{
+		{"沈", "shen3"},
+		{"褚", "chu3"},
+		{"笆", "ba1"},
+		{"吧", "ba5"},
+		{"单", "dan1"},
+		{"曾", "ceng2"},
+		{"邵", "shao4"},
+		{"薛", "xue1"},
+	}
+	loadPinyin()
+	for _, tt := range tests {
+		r := []rune(tt.s)[0]
+		if e, ok := pinyinIndex[r]; !ok || pinyinTable[e.group].reading != tt.reading {
+			t.Errorf("reading of %s = %q, want %q", tt.s, pinyinTable[e.group].reading, tt.reading)
+		}
+	}
+	// 百家姓中的单姓均应收录读音
+	for _, r := range "赵钱孙李周吴郑王冯陈褚卫蒋沈韩杨朱秦尤许何吕施张孔曹严华金魏陶姜戚谢邹喻柏水窦章云苏潘葛奚范彭郎" +
+		"鲁韦昌马苗凤花方俞任袁柳酆鲍史唐费廉岑薛雷贺倪汤滕殷罗毕郝邬安常乐于时傅皮卞齐康伍余元卜顾孟平黄和穆萧尹" +
+		"姚邵湛汪祁毛禹狄米贝明臧计伏成戴谈宋茅庞熊纪舒屈项祝董梁杜阮蓝闵席季麻强贾路娄危江童颜郭梅盛林刁钟徐邱骆" +
+		"高夏蔡田樊胡凌霍虞万支柯昝管卢莫经房裘缪干解应宗丁宣邓郁单杭洪包诸左石崔吉钮龚程嵇邢滑裴陆荣翁荀羊於惠甄" +
+		"曲家封芮羿储靳汲邴糜松井段富巫乌焦巴弓牧隗山谷车侯宓蓬全郗班仰秋仲伊宫宁仇栾暴甘厉戎祖武符刘景詹束龙叶幸" +
+		"司韶郜黎蓟薄印宿白怀蒲邰从鄂索咸籍赖卓蔺屠蒙池乔阴胥能苍双闻莘党翟谭贡劳逄姬申扶堵冉宰郦雍却璩桑桂濮牛寿" +
+		"通边扈燕冀郏浦尚农温别庄晏柴瞿阎充慕连茹习宦艾鱼容向古易慎戈廖庾终暨居衡步都耿满弘匡国文寇广禄阙东欧殳沃" +
+		"利蔚越夔隆师巩厍聂晁勾敖融冷訾辛阚那简饶空曾毋沙乜养鞠须丰巢关蒯相查后荆红游竺权逯盖益桓公" {
+		if _, ok := pinyinIndex[r]; !ok {
+			t.Errorf("surname %c has no reading", r)
+		}
+	}
+	// 姓氏读音必须在 pinyinTable 中存在
+	for c, reading := range pinyinSurnames {
+		if _, ok := pinyinSurnameIndex[c]; !ok {
+			t.Errorf("surname reading %s of %c is not in pinyinTable", reading, c)
+		}
+	}
+}
+
+func TestPinyinCoverage(t *testing.T) {
+	data, err := os.ReadFile("testdata/gb2312_level1.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	loadPinyin()
+	n := 0
+	for _, line := range strings.Split(string(data), "\n") {
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		for _, r := range line {
+			n++
+			if _, ok := pinyinIndex[r]; !ok {
+				t.Errorf("%c (U+%04X) has no reading", r, r)
+			}
+		}
+	}
+	if n != 3755 {
+		t.Errorf("GB 2312 level-1 characters = %d, want 3755", n)
+	}
+}
+
+func TestPinyinName(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"沈万三", "邵雍", 1},  // shen > shao
+		{"褚遂良", "程咬金", 1}, // chu > cheng
+		{"褚遂良", "崔颢", -1}, // chu < cui
+		{"单雄信", "丁一", 1},  // shan > ding
+		{"单雄信", "尚可喜", 1}, // shan > shang
+		{"曾国藩", "张三", -1}, // zeng < zhang
+		{"曾国藩", "邓小平", 1}, // zeng > deng
+		{"解缙", "金庸", 1},   // xie > jin
+		{"仇英", "钱谦益", 1},  // qiu > qian
+		{"朴正", "蒲松龄", -1}, // piao < pu
+		{"区寄", "潘岳", -1},  // ou < pan
+	}
+	for _, tt := range tests {
+		if got := PinyinName(tt.a, tt.b); got != tt.want {
+			t.Errorf("PinyinName(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+		if got := PinyinName(tt.b, tt.a); got != -tt.want {
+			t.Errorf("PinyinName(%q, %q) = %d, want %d", tt.b, tt.a, got, -tt.want)
+		}
+	}
+	// 只有第一个汉字使用姓氏读音
+	if got := PinyinName("王单", "王丹"); got != 1 {
+		t.Errorf("PinyinName(%q, %q) = %d, want 1", "王单", "王丹", got)
+	}
+	if got := Pinyin("单雄信", "丁一"); got != -1 {
+		t.Errorf("Pinyin(%q, %q) = %d, want -1", "单雄信", "丁一", got)
+	}
+}
+
+func TestPinyinSurnameSort(t *testing.T) {
+	names := []string{"赵钱", "孙李", "周吴", "郑王", "冯陈", "褚卫", "蒋沈", "韩杨", "沈阳", "单田芳", "曾巩", "陈平"}
+	sort.Slice(names, func(i, j int) bool { return PinyinName(names[i], names[j]) < 0 })
+	want := []string{"陈平", "褚卫", "冯陈", "韩杨", "蒋沈", "单田芳", "沈阳", "孙李", "曾巩", "赵钱", "郑王", "周吴"}
+	for i := range want {
+		if names[i] != want[i] {
+			t.Fatalf("sorted = %v, want %v", names, want)
+		}
+	}
+}
+
+func TestPinyinTag(t *testing.T) {
+	type customer struct {
+		Name string `compare:"using=pinyin"`
+	}
+	if got := Compare(customer{"李四"}, customer{"张三"}); got != -1 {
+		t.Errorf("Compare = %d, want -1", got)
+	}
+}
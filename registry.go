@@ -0,0 +1,94 @@
+package comparator
+
+import (
+	"reflect"
+	"sync"
+	"sync/atomic"
+)
+
+/**
+ *
+ * @Author AiTao
+ * @Date 2026-10-17 11:20
+ * @Url
+ **/
+
+// Registry 保存了指定类型的比较器, 深度比较过程中遇到已注册的类型时, 会使用注册的比较器比较两个值, 而不是按照字段逐一比较.
+// Registry 是并发安全的, 零值不可用, 请使用 NewRegistry 函数创建.
+//
+// 深度比较时首先查找通过 WithRegistry 选项指定的 Registry, 然后查找 DefaultRegistry.
+type Registry struct {
+	mu    sync.RWMutex
+	size  atomic.Int32
+	funcs map[reflect.Type]Type
+}
+
+// DefaultRegistry 是包级别的默认 Registry, 通过 Register、RegisterFunc 函数注册的比较器均保存在 DefaultRegistry 中.
+var DefaultRegistry = NewRegistry()
+
+// NewRegistry 函数用于创建一个空的 Registry.
+func NewRegistry() *Registry {
+	return &Registry{funcs: make(map[reflect.Type]Type)}
+}
+
+// Register 函数用于为类型 t 注册比较器 cmp, 重复注册会覆盖之前注册的比较器. cmp 的参数均为 t 类型的值.
+func (r *Registry) Register(t reflect.Type, cmp Type) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.funcs[t] = cmp
+	r.size.Store(int32(len(r.funcs)))
+}
+
+// Unregister 函数用于移除类型 t 的比较器.
+func (r *Registry) Unregister(t reflect.Type) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.funcs, t)
+	r.size.Store(int32(len(r.funcs)))
+}
+
+// Lookup 函数用于查找类型 t 的比较器.
+func (r *Registry) Lookup(t reflect.Type) (Type, bool) {
+	if r.size.Load() == 0 {
+		return nil, false
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	cmp, ok := r.funcs[t]
+	return cmp, ok
+}
+
+// Register 函数用于在 DefaultRegistry 中为类型 t 注册比较器 cmp.
+//
+// Example:
+// Register(reflect.TypeOf(netip.Addr{}), func(a, b any) int { return a.(netip.Addr).Compare(b.(netip.Addr)) })
+func Register(t reflect.Type, cmp Type) {
+	DefaultRegistry.Register(t, cmp)
+}
+
+// RegisterFunc 函数用于在 r 中为类型 T 注册类型安全的比较器 cmp, 当 r 为 nil 时注册到 DefaultRegistry 中.
+//
+// Example:
+// RegisterFunc(nil, func(a, b netip.Addr) int { return a.Compare(b) })
+func RegisterFunc[T any](r *Registry, cmp func(a, b T) int) {
+	if r == nil {
+		r = DefaultRegistry
+	}
+	r.Register(reflect.TypeOf((*T)(nil)).Elem(), func(a, b any) int { return cmp(a.(T), b.(T)) })
+}
+
+// WithRegistry 返回一个指定 Registry 的选项, 比较时优先使用 r 中注册的比较器, 其次使用 DefaultRegistry 中注册的比较器.
+// 该选项适用于测试等需要避免修改全局状态的场景.
+func WithRegistry(r *Registry) Option {
+	return func(o *options) { o.registry = r }
+}
+
+// lookup 函数用于查找类型 t 的比较器.
+func (o *options) lookup(t reflect.Type) (Type, bool) {
+	if o.registry != nil {
+		if cmp, ok := o.registry.Lookup(t); ok {
+			return cmp, true
+		}
+	}
+	return DefaultRegistry.Lookup(t)
+}
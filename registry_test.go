@@ -0,0 +1,93 @@
+package comparator
+
+import (
+	"net"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+/**
+ *
+ * @Author AiTao
+ * @Date 2026-10-17 11:58
+ * @Url
+ **/
+
+// orderID 的格式为"前缀-序号", 按照序号的数值大小排序.
+type orderID string
+
+func compareOrderID(a, b orderID) int {
+	x, _ := strconv.Atoi(string(a[3:]))
+	y, _ := strconv.Atoi(string(b[3:]))
+	return Int(x, y)
+}
+
+type invoice struct {
+	ID    orderID
+	Items []orderID
+	Refs  map[string]orderID
+}
+
+func TestRegistry(t *testing.T) {
+	r := NewRegistry()
+	RegisterFunc(r, compareOrderID)
+	opt := WithRegistry(r)
+
+	if Compare(orderID("NO-10"), orderID("NO-9")) != -1 {
+		t.Error("Compare() without registry should compare strings byte-wise")
+	}
+	if CompareWith(orderID("NO-10"), orderID("NO-9"), opt) != 1 {
+		t.Error("CompareWith(registry) should use the registered comparator")
+	}
+	i1 := invoice{ID: "NO-10", Items: []orderID{"NO-2"}, Refs: map[string]orderID{"a": "NO-03"}}
+	i2 := invoice{ID: "NO-10", Items: []orderID{"NO-02"}, Refs: map[string]orderID{"a": "NO-3"}}
+	if Equals(i1, i2) || !EqualsWith(i1, i2, opt) {
+		t.Error("EqualsWith(registry) should use the registered comparator in nested values")
+	}
+	if _, ok := DefaultRegistry.Lookup(reflect.TypeOf(orderID(""))); ok {
+		t.Error("scoped registry leaked into DefaultRegistry")
+	}
+}
+
+func TestDefaultRegistry(t *testing.T) {
+	typ := reflect.TypeOf(orderID(""))
+	Register(typ, func(a, b any) int { return compareOrderID(a.(orderID), b.(orderID)) })
+	defer DefaultRegistry.Unregister(typ)
+	if !Greater(orderID("NO-10"), orderID("NO-9")) || !Less([]orderID{"NO-9"}, []orderID{"NO-10"}) {
+		t.Error("Greater()/Less() should use the comparator registered in DefaultRegistry")
+	}
+}
+
+func TestDefaultRegistryEmpty(t *testing.T) {
+	// 库本身不会向 DefaultRegistry 中注册比较器, 内置类型的比较不依赖 DefaultRegistry
+	if n := DefaultRegistry.size.Load(); n != 0 {
+		t.Errorf("DefaultRegistry has %d comparators, want 0", n)
+	}
+	if !Equals(net.IPv4(10, 0, 0, 1), net.IP{10, 0, 0, 1}) {
+		t.Error("Equals(net.IP) = false, want true")
+	}
+}
+
+func TestRegistryBuiltinType(t *testing.T) {
+	// 为 string 注册忽略大小写的比较器, 切片、map 以及结构体字段中的 string 均应使用该比较器
+	r := NewRegistry()
+	RegisterFunc(r, func(a, b string) int { return String(strings.ToLower(a), strings.ToLower(b)) })
+	opt := WithRegistry(r)
+	type user struct{ Name string }
+	tests := []struct {
+		a, b interface{}
+	}{
+		{"Go", "GO"},
+		{[]string{"a", "B"}, []string{"A", "b"}},
+		{map[string]string{"k": "v"}, map[string]string{"k": "V"}},
+		{map[string]interface{}{"k": "v"}, map[string]interface{}{"k": "V"}},
+		{[]user{{"Tom"}}, []user{{"TOM"}}},
+	}
+	for _, tt := range tests {
+		if Equals(tt.a, tt.b) || !EqualsWith(tt.a, tt.b, opt) {
+			t.Errorf("EqualsWith(%v, %v) should use the comparator registered for string", tt.a, tt.b)
+		}
+	}
+}
@@ -0,0 +1,221 @@
+package comparator
+
+/**
+ *
+ * @Author AiTao
+ * @Date 2026-10-16 16:05
+ * @Url
+ **/
+
+// EditKind 表示序列差异中编辑操作的类别.
+type EditKind int
+
+const (
+	Insert EditKind = iota + 1 // 在新序列中插入元素
+	Delete                     // 从旧序列中删除元素
+	Move                       // 元素从旧序列中的位置移动到新序列中的位置
+)
+
+// String 返回编辑操作类别的字符串表示形式.
+func (k EditKind) String() string {
+	switch k {
+	case Insert:
+		return "Insert"
+	case Delete:
+		return "Delete"
+	case Move:
+		return "Move"
+	default:
+		return "Unknown"
+	}
+}
+
+// Edit 描述了将旧序列转换为新序列的一个编辑操作, OldIndex 为元素在旧序列中的下标, NewIndex 为元素在新序列中的下标,
+// 对于 Insert 操作 OldIndex 为-1, 对于 Delete 操作 NewIndex 为-1.
+type Edit struct {
+	Kind     EditKind
+	OldIndex int
+	NewIndex int
+}
+
+// DiffSlices 函数基于 Myers O(ND) 差异算法计算将 a 转换为 b 所需的最少编辑操作, 相等的元素不会出现在结果中.
+// 被删除后又在其它位置插入的相等元素会被合并为 Move 操作, 为了避免比较次数过多, 只在前 256 个删除操作与前 256 个插入操作之间
+// 检测移动. eq 用于判断两个元素是否相等, 当 eq 为 nil 时使用 Equals 函数.
+//
+// Example:
+// DiffSlices([]int{1, 2, 3}, []int{0, 1, 2, 3}, nil) 返回 [{Insert -1 0}]
+// DiffSlices([]int{1, 2, 3}, []int{2, 3, 1}, nil) 返回 [{Move 0 2}]
+func DiffSlices[T any](a, b []T, eq func(x, y T) bool) []Edit {
+	if eq == nil {
+		eq = func(x, y T) bool { return Equals(x, y) }
+	}
+	return diffIndices(len(a), len(b), func(i, j int) bool { return eq(a[i], b[j]) })
+}
+
+// diffIndices 函数用于计算长度分别为 n、m 的两个序列之间的编辑操作, eq(i, j) 判断旧序列中下标为 i 的元素与新序列中下标为 j 的元素是否相等.
+func diffIndices(n, m int, eq func(i, j int) bool) []Edit {
+	md := &myers{eq: eq}
+	md.compare(0, n, 0, m)
+	return detectMoves(normalizeEdits(md.edits), eq)
+}
+
+// myers 实现了线性空间的 Myers 差异算法, 通过查找中间蛇形(middle snake)将问题递归地拆分为两个子问题.
+type myers struct {
+	eq    func(i, j int) bool
+	edits []Edit
+}
+
+func (md *myers) compare(aLo, aHi, bLo, bHi int) {
+	// 跳过公共前缀与公共后缀
+	for aLo < aHi && bLo < bHi && md.eq(aLo, bLo) {
+		aLo, bLo = aLo+1, bLo+1
+	}
+	for aLo < aHi && bLo < bHi && md.eq(aHi-1, bHi-1) {
+		aHi, bHi = aHi-1, bHi-1
+	}
+	switch {
+	case aLo == aHi:
+		for j := bLo; j < bHi; j++ {
+			md.edits = append(md.edits, Edit{Kind: Insert, OldIndex: -1, NewIndex: j})
+		}
+	case bLo == bHi:
+		for i := aLo; i < aHi; i++ {
+			md.edits = append(md.edits, Edit{Kind: Delete, OldIndex: i, NewIndex: -1})
+		}
+	default:
+		xs, ys, xe, ye := md.middleSnake(aLo, aHi, bLo, bHi)
+		md.compare(aLo, aLo+xs, bLo, bLo+ys)
+		md.compare(aLo+xe, aHi, bLo+ye, bHi)
+	}
+}
+
+// middleSnake 函数用于同时从两个序列的头部与尾部开始搜索, 返回最优编辑路径中间蛇形的起点(xs, ys)与终点(xe, ye),
+// 坐标均相对于 aLo、bLo.
+func (md *myers) middleSnake(aLo, aHi, bLo, bHi int) (xs, ys, xe, ye int) {
+	n, m := aHi-aLo, bHi-bLo
+	max := (n + m + 1) / 2
+	delta := n - m
+	odd := delta&1 != 0
+	off := max + 1
+	vf, vb := make([]int, 2*max+3), make([]int, 2*max+3)
+	for d := 0; d <= max; d++ {
+		// 正向搜索
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && vf[off+k-1] < vf[off+k+1]) {
+				x = vf[off+k+1]
+			} else {
+				x = vf[off+k-1] + 1
+			}
+			y := x - k
+			sx, sy := x, y
+			for x < n && y < m && md.eq(aLo+x, bLo+y) {
+				x, y = x+1, y+1
+			}
+			vf[off+k] = x
+			if kr := delta - k; odd && kr >= -(d-1) && kr <= d-1 && x+vb[off+kr] >= n {
+				return sx, sy, x, y
+			}
+		}
+		// 反向搜索, x、y 表示距离序列尾部的长度
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && vb[off+k-1] < vb[off+k+1]) {
+				x = vb[off+k+1]
+			} else {
+				x = vb[off+k-1] + 1
+			}
+			y := x - k
+			sx, sy := x, y
+			for x < n && y < m && md.eq(aHi-1-x, bHi-1-y) {
+				x, y = x+1, y+1
+			}
+			vb[off+k] = x
+			if kf := delta - k; !odd && kf >= -d && kf <= d && x+vf[off+kf] >= n {
+				return n - x, m - y, n - sx, m - sy
+			}
+		}
+	}
+	return 0, 0, n, m
+}
+
+// normalizeEdits 函数用于调整编辑操作的顺序, 使每一段连续(中间没有相等元素)的编辑操作中删除操作均位于插入操作之前.
+func normalizeEdits(edits []Edit) []Edit {
+	x, y, start := 0, 0, 0
+	for i, e := range edits {
+		var kept int
+		if e.Kind == Delete {
+			kept = e.OldIndex - x
+			x, y = e.OldIndex+1, y+kept
+		} else {
+			kept = e.NewIndex - y
+			x, y = x+kept, e.NewIndex+1
+		}
+		if kept > 0 {
+			sortHunk(edits[start:i])
+			start = i
+		}
+	}
+	sortHunk(edits[start:])
+	return edits
+}
+
+// sortHunk 函数用于将一段连续的编辑操作稳定地调整为先删除后插入的顺序.
+func sortHunk(hunk []Edit) {
+	var deletes, inserts []Edit
+	for _, e := range hunk {
+		if e.Kind == Delete {
+			deletes = append(deletes, e)
+		} else {
+			inserts = append(inserts, e)
+		}
+	}
+	copy(hunk, deletes)
+	copy(hunk[len(deletes):], inserts)
+}
+
+// maxMoveEdits 为检测移动操作时参与配对的删除操作与插入操作的最大数量. 每一对删除操作与插入操作都需要调用一次 eq,
+// 限制数量可以避免两个序列差异较大时比较次数按照平方增长.
+const maxMoveEdits = 256
+
+// detectMoves 函数用于将相等元素的删除操作与插入操作合并为移动操作, 只有前 maxMoveEdits 个删除操作与前 maxMoveEdits 个
+// 插入操作参与配对.
+func detectMoves(edits []Edit, eq func(i, j int) bool) []Edit {
+	var deletes []int
+	for i, e := range edits {
+		if e.Kind == Delete && len(deletes) < maxMoveEdits {
+			deletes = append(deletes, i)
+		}
+	}
+	if len(deletes) == 0 {
+		return edits
+	}
+	moved := make(map[int]bool)
+	inserts := 0
+	for i, e := range edits {
+		if e.Kind != Insert {
+			continue
+		}
+		if inserts++; inserts > maxMoveEdits || len(deletes) == 0 {
+			break
+		}
+		for k, d := range deletes {
+			if eq(edits[d].OldIndex, e.NewIndex) {
+				moved[d] = true
+				edits[i] = Edit{Kind: Move, OldIndex: edits[d].OldIndex, NewIndex: e.NewIndex}
+				deletes = append(deletes[:k], deletes[k+1:]...)
+				break
+			}
+		}
+	}
+	if len(moved) == 0 {
+		return edits
+	}
+	ret := edits[:0]
+	for i, e := range edits {
+		if !moved[i] {
+			ret = append(ret, e)
+		}
+	}
+	return ret
+}
@@ -0,0 +1,150 @@
+package comparator
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+/**
+ *
+ * @Author AiTao
+ * @Date 2026-10-16 16:48
+ * @Url
+ **/
+
+// lcsDistance 使用动态规划计算仅包含插入与删除操作的最小编辑距离.
+func lcsDistance(a, b []int) int {
+	dp := make([][]int, len(a)+1)
+	for i := range dp {
+		dp[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				dp[i][j] = dp[i+1][j+1] + 1
+			} else if dp[i+1][j] > dp[i][j+1] {
+				dp[i][j] = dp[i+1][j]
+			} else {
+				dp[i][j] = dp[i][j+1]
+			}
+		}
+	}
+	return len(a) + len(b) - 2*dp[0][0]
+}
+
+func TestDiffIndices(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for n := 0; n < 500; n++ {
+		a, b := make([]int, rnd.Intn(20)), make([]int, rnd.Intn(20))
+		for i := range a {
+			a[i] = rnd.Intn(4)
+		}
+		for i := range b {
+			b[i] = rnd.Intn(4)
+		}
+		edits := diffIndices(len(a), len(b), func(i, j int) bool { return a[i] == b[j] })
+		// 回放编辑操作, 验证未被删除的元素依次与新序列中未被插入的元素相等, 移动操作等价于一次删除与一次插入
+		deleted, inserted := make(map[int]bool), make(map[int]bool)
+		for _, e := range edits {
+			if e.Kind == Delete || e.Kind == Move {
+				deleted[e.OldIndex] = true
+			}
+			if e.Kind == Insert || e.Kind == Move {
+				inserted[e.NewIndex] = true
+			}
+		}
+		if want := lcsDistance(a, b); len(deleted)+len(inserted) != want {
+			t.Fatalf("diffIndices(%v, %v) = %v, want %d edits", a, b, edits, want)
+		}
+		var kept, added []int
+		for i, v := range a {
+			if !deleted[i] {
+				kept = append(kept, v)
+			}
+		}
+		for j, v := range b {
+			if !inserted[j] {
+				added = append(added, v)
+			}
+		}
+		if !reflect.DeepEqual(kept, added) {
+			t.Fatalf("diffIndices(%v, %v) = %v, common %v != %v", a, b, edits, kept, added)
+		}
+	}
+}
+
+func TestDiffSlices(t *testing.T) {
+	a := make([]int, 10000)
+	for i := range a {
+		a[i] = i
+	}
+	b := append([]int{-1}, a...)
+	if got, want := DiffSlices(a, b, nil), []Edit{{Kind: Insert, OldIndex: -1, NewIndex: 0}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("DiffSlices(insert front) = %v, want %v", got, want)
+	}
+	if got, want := DiffSlices([]string{"a", "b", "c"}, []string{"b", "c", "a"}, nil), []Edit{{Kind: Move, OldIndex: 0, NewIndex: 2}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("DiffSlices(move) = %v, want %v", got, want)
+	}
+}
+
+func TestDetectMovesLimit(t *testing.T) {
+	// 两个序列没有公共元素时, 移动检测的比较次数不超过 maxMoveEdits^2
+	a, b := make([]int, 3000), make([]int, 3000)
+	for i := range a {
+		a[i], b[i] = i, i+len(a)
+	}
+	md := &myers{eq: func(i, j int) bool { return a[i] == b[j] }}
+	md.compare(0, len(a), 0, len(b))
+	calls := 0
+	edits := detectMoves(normalizeEdits(md.edits), func(i, j int) bool { calls++; return a[i] == b[j] })
+	if calls > maxMoveEdits*maxMoveEdits {
+		t.Errorf("detectMoves() called eq %d times, want at most %d", calls, maxMoveEdits*maxMoveEdits)
+	}
+	if len(edits) != len(a)+len(b) {
+		t.Errorf("detectMoves() = %d edits, want %d", len(edits), len(a)+len(b))
+	}
+
+	// 前 maxMoveEdits 个删除操作与插入操作之间的移动仍然会被检测
+	c := make([]int, maxMoveEdits*2)
+	for i := range c {
+		c[i] = i
+	}
+	d := append(append([]int{}, c[1:]...), c[0])
+	if got, want := DiffSlices(c, d, nil), []Edit{{Kind: Move, OldIndex: 0, NewIndex: len(c) - 1}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("DiffSlices(move) = %v, want %v", got, want)
+	}
+}
+
+func BenchmarkDiffSlicesDisjoint(b *testing.B) {
+	x, y := make([]int, 3000), make([]int, 3000)
+	for i := range x {
+		x[i], y[i] = i, i+len(x)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if edits := DiffSlices(x, y, nil); len(edits) != len(x)+len(y) {
+			b.Fatalf("DiffSlices() = %d edits, want %d", len(edits), len(x)+len(y))
+		}
+	}
+}
+
+func TestDiffSequence(t *testing.T) {
+	type line struct {
+		No   int
+		Text string
+	}
+	a := []line{{1, "a"}, {2, "b"}, {3, "c"}}
+	b := []line{{0, "z"}, {1, "a"}, {2, "B"}, {3, "c"}}
+	want := []Difference{
+		{Kind: Added, Path: "[0]", New: line{0, "z"}},
+		{Kind: Changed, Path: "[2].Text", Old: "b", New: "B"},
+	}
+	if got := DiffSequence(a, b); !reflect.DeepEqual(got, want) {
+		t.Errorf("DiffSequence() = %v\nwant %v", got, want)
+	}
+	if got := Diff(a, b); len(got) != 7 {
+		t.Errorf("Diff() = %v, want 7 differences", got)
+	}
+}
@@ -0,0 +1,145 @@
+package comparator
+
+//go:generate go run ./internal/gen/unicode -o unicode_tables.go
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+/**
+ *
+ * @Author AiTao
+ * @Date 2026-10-17 22:30
+ * @Url
+ **/
+
+// StringOptions 用于配置 StringWith 函数返回的字符串比较器.
+type StringOptions struct {
+	FoldCase      bool // 使用完全大小写折叠忽略大小写, 例如 "Straße" 与 "STRASSE" 相等
+	IgnoreAccents bool // 忽略重音等组合附加符号, 例如 "Émile" 与 "Emile" 相等
+	Natural       bool // 按照自然顺序比较, 参见 Natural 函数
+}
+
+// StringWith 函数用于获取一个按照 opts 比较字符串的比较器. 比较之前字符串会先进行大小写折叠,
+// 然后进行规范分解(NFD)并移除组合附加符号(Unicode 类别 Mn), 最后按照字节(或自然顺序)比较处理后的字符串.
+// 通过 StringComparator 选项可以将该比较器用于深度比较.
+//
+// Example:
+// StringWith(StringOptions{FoldCase: true, IgnoreAccents: true})("Émile", "EMILE") 返回 0
+// CompareWith(u1, u2, StringComparator(StringWith(StringOptions{FoldCase: true})))
+func StringWith(opts StringOptions) Type {
+	return func(x, y interface{}) int {
+		return compareStrings(x.(string), y.(string), opts)
+	}
+}
+
+// FoldCase 函数用于忽略大小写比较两个字符串, 与 strings.EqualFold 不同, FoldCase 使用完全大小写折叠,
+// 能够处理一个字符折叠为多个字符的情况, 例如 "ß" 折叠为 "ss".
+//
+// Example:
+// FoldCase("Straße", "STRASSE") 返回 0
+func FoldCase(x, y interface{}) int {
+	return compareStrings(x.(string), y.(string), StringOptions{FoldCase: true})
+}
+
+// IgnoreAccents 函数用于忽略重音等组合附加符号比较两个字符串.
+//
+// Example:
+// IgnoreAccents("Émile", "Emile") 返回 0
+func IgnoreAccents(x, y interface{}) int {
+	return compareStrings(x.(string), y.(string), StringOptions{IgnoreAccents: true})
+}
+
+func compareStrings(a, b string, opts StringOptions) int {
+	if opts.FoldCase || opts.IgnoreAccents {
+		a, b = normalizeString(a, opts), normalizeString(b, opts)
+	}
+	if opts.Natural {
+		return naturalCompare(a, b, false)
+	}
+	return String(a, b)
+}
+
+// normalizeString 函数用于按照 opts 对字符串进行大小写折叠以及移除组合附加符号.
+func normalizeString(s string, opts StringOptions) string {
+	if isPlainASCII(s, opts) {
+		return s
+	}
+	var sb strings.Builder
+	sb.Grow(len(s))
+	for _, r := range s {
+		if opts.FoldCase {
+			if f, ok := fullFolds[r]; ok {
+				for _, c := range f {
+					writeRune(&sb, c, opts.IgnoreAccents)
+				}
+				continue
+			}
+			r = foldRune(r)
+		}
+		writeRune(&sb, r, opts.IgnoreAccents)
+	}
+	return sb.String()
+}
+
+// isPlainASCII 函数用于判断字符串是否无需处理, 即仅包含 ASCII 字符, 并且在忽略大小写时不包含大写字母.
+func isPlainASCII(s string, opts StringOptions) bool {
+	for i := 0; i < len(s); i++ {
+		if c := s[i]; c >= utf8.RuneSelf || (opts.FoldCase && 'A' <= c && c <= 'Z') {
+			return false
+		}
+	}
+	return true
+}
+
+// foldRune 函数用于获取字符的简单大小写折叠结果, 同一折叠等价类中的字符得到相同的结果.
+func foldRune(r rune) rune {
+	return unicode.ToLower(unicode.ToUpper(r))
+}
+
+// writeRune 函数用于写入字符, 当 unaccent 为 true 时写入字符规范分解之后除组合附加符号之外的部分.
+func writeRune(sb *strings.Builder, r rune, unaccent bool) {
+	if !unaccent {
+		sb.WriteRune(r)
+		return
+	}
+	if d, ok := decompositions[r]; ok {
+		for _, c := range d {
+			if !unicode.Is(unicode.Mn, c) {
+				sb.WriteRune(c)
+			}
+		}
+		return
+	}
+	if decomposeHangul(sb, r) || unicode.Is(unicode.Mn, r) {
+		return
+	}
+	sb.WriteRune(r)
+}
+
+// 韩文音节的算法分解参数, 参见 Unicode 标准第3.12节.
+const (
+	hangulBase  = 0xAC00
+	jamoLBase   = 0x1100
+	jamoVBase   = 0x1161
+	jamoTBase   = 0x11A7
+	jamoVCount  = 21
+	jamoTCount  = 28
+	hangulCount = 19 * jamoVCount * jamoTCount
+)
+
+// decomposeHangul 函数用于写入韩文音节规范分解之后的字母, 当 r 不是韩文音节时返回 false.
+func decomposeHangul(sb *strings.Builder, r rune) bool {
+	s := r - hangulBase
+	if s < 0 || s >= hangulCount {
+		return false
+	}
+	sb.WriteRune(jamoLBase + s/(jamoVCount*jamoTCount))
+	sb.WriteRune(jamoVBase + s%(jamoVCount*jamoTCount)/jamoTCount)
+	if t := s % jamoTCount; t != 0 {
+		sb.WriteRune(jamoTBase + t)
+	}
+	return true
+}
@@ -0,0 +1,74 @@
+package comparator
+
+import (
+	"testing"
+)
+
+/**
+ *
+ * @Author AiTao
+ * @Date 2026-10-17 22:30
+ * @Url
+ **/
+
+func TestFoldCase(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"Straße", "STRASSE", 0},
+		{"straße", "strasse", 0},
+		{"ΣΊΣΥΦΟΣ", "σίσυφος", 0},
+		{"ſ", "S", 0},
+		{"ﬁle", "FILE", 0},
+		{"Émile", "émile", 0},
+		{"Émile", "emile", 1},
+		{"apple", "Banana", -1},
+		{"Go", "go", 0},
+	}
+	for _, tt := range tests {
+		if got := FoldCase(tt.a, tt.b); got != tt.want {
+			t.Errorf("FoldCase(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestIgnoreAccents(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"Émile", "Emile", 0},
+		{"Émile", "emile", -1},
+		{"émile", "émile", 0},
+		{"Ångström", "Angstrom", 0},
+		{"naïve café", "naive cafe", 0},
+		{"Ærøskøbing", "Aeroskobing", 1},
+		{"한국", "\u1112\u1161\u11AB\u1100\u116E\u11A8", 0},
+		{"ḍ", "d", 0},
+	}
+	for _, tt := range tests {
+		if got := IgnoreAccents(tt.a, tt.b); got != tt.want {
+			t.Errorf("IgnoreAccents(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestStringWith(t *testing.T) {
+	both := StringWith(StringOptions{FoldCase: true, IgnoreAccents: true})
+	if r := both("Émile", "EMILE"); r != 0 {
+		t.Errorf("StringWith() = %d, want 0", r)
+	}
+	if r := both("İstanbul", "istanbul"); r != 0 {
+		t.Errorf("StringWith() = %d, want 0", r)
+	}
+	natural := StringWith(StringOptions{FoldCase: true, IgnoreAccents: true, Natural: true})
+	if r := natural("Résumé10", "resume9"); r != 1 {
+		t.Errorf("StringWith(Natural) = %d, want 1", r)
+	}
+	type person struct{ Name string }
+	a, b := []person{{"José"}}, []person{{"JOSE"}}
+	if Equals(a, b) || !EqualsWith(a, b, StringComparator(both)) {
+		t.Errorf("EqualsWith(StringComparator) mismatch")
+	}
+}
@@ -0,0 +1,269 @@
+package comparator
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+/**
+ *
+ * @Author AiTao
+ * @Date 2026-10-17 09:15
+ * @Url
+ **/
+
+// tagName 为结构体字段上用于配置比较行为的标签名称, 标签的值由逗号分隔的若干配置项组成:
+//
+//	"-"          不参与比较
+//	order=N      比较的优先级, 值越小越先比较, 未指定优先级的字段按照声明的顺序排在指定了优先级的字段之后
+//	asc, desc    升序(默认)或降序
+//	nulls=first  值为 nil 的指针字段排在最前面
+//	nulls=last   值为 nil 的指针字段排在最后面
+//	using=name   使用通过 RegisterComparator 函数注册的比较器比较字段值
+//
+// Example:
+//
+//	type User struct {
+//		ID        int       `compare:"-"`
+//		Age       int       `compare:"order=2,desc"`
+//		Name      string    `compare:"order=1,using=natural"`
+//		Manager   *User     `compare:"nulls=last"`
+//	}
+const tagName = "compare"
+
+const (
+	nullsDefault = iota
+	nullsFirst
+	nullsLast
+)
+
+// fieldSpec 描述了结构体字段上的比较配置.
+type fieldSpec struct {
+	index int
+	name  string
+	order int
+	desc  bool
+	nulls int
+	using string
+}
+
+// structSpec 描述了结构体中参与比较的字段, 字段已按照比较的优先级排序.
+type structSpec struct {
+	fields []fieldSpec
+	err    error
+}
+
+// field 函数用于获取下标为 i 的字段的比较配置, 字段被 compare:"-" 标签忽略时返回 nil.
+func (s *structSpec) field(i int) *fieldSpec {
+	for j := range s.fields {
+		if s.fields[j].index == i {
+			return &s.fields[j]
+		}
+	}
+	return nil
+}
+
+// structSpecs 缓存了结构体类型的比较配置, 避免重复解析标签.
+var structSpecs sync.Map
+
+// structSpecOf 函数用于获取结构体类型 t 的比较配置.
+func structSpecOf(t reflect.Type) *structSpec {
+	if spec, ok := structSpecs.Load(t); ok {
+		return spec.(*structSpec)
+	}
+	spec, _ := structSpecs.LoadOrStore(t, parseStructSpec(t))
+	return spec.(*structSpec)
+}
+
+func parseStructSpec(t reflect.Type) *structSpec {
+	spec := &structSpec{}
+	var ordered, unordered []fieldSpec
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		f := fieldSpec{index: i, name: sf.Name}
+		tag, ok := sf.Tag.Lookup(tagName)
+		if !ok {
+			unordered = append(unordered, f)
+			continue
+		}
+		if tag == "-" {
+			continue
+		}
+		if err := parseFieldTag(&f, tag); err != nil {
+			spec.err = fmt.Errorf("%w: %s.%s: %v", ErrInvalidTag, t.Name(), sf.Name, err)
+			return spec
+		}
+		if f.nulls != nullsDefault && sf.Type.Kind() != reflect.Pointer {
+			spec.err = fmt.Errorf("%w: %s.%s: nulls requires a pointer field", ErrInvalidTag, t.Name(), sf.Name)
+			return spec
+		}
+		if f.using != "" && !sf.IsExported() {
+			spec.err = fmt.Errorf("%w: %s.%s: using requires an exported field", ErrInvalidTag, t.Name(), sf.Name)
+			return spec
+		}
+		if f.order > 0 {
+			ordered = append(ordered, f)
+		} else {
+			unordered = append(unordered, f)
+		}
+	}
+	sort.SliceStable(ordered, func(i, j int) bool { return ordered[i].order < ordered[j].order })
+	spec.fields = append(ordered, unordered...)
+	return spec
+}
+
+// parseFieldTag 函数用于解析字段标签中的配置项.
+func parseFieldTag(f *fieldSpec, tag string) error {
+	for _, item := range strings.Split(tag, ",") {
+		key, value, _ := strings.Cut(strings.TrimSpace(item), "=")
+		switch key {
+		case "":
+		case "asc":
+			f.desc = false
+		case "desc":
+			f.desc = true
+		case "order":
+			n, err := strconv.Atoi(value)
+			if err != nil || n <= 0 {
+				return fmt.Errorf("invalid order %q", value)
+			}
+			f.order = n
+		case "nulls":
+			switch value {
+			case "first":
+				f.nulls = nullsFirst
+			case "last":
+				f.nulls = nullsLast
+			default:
+				return fmt.Errorf("invalid nulls %q", value)
+			}
+		case "using":
+			if value == "" {
+				return fmt.Errorf("empty comparator name")
+			}
+			f.using = value
+		default:
+			return fmt.Errorf("unknown option %q", key)
+		}
+	}
+	return nil
+}
+
+// compareField 函数用于按照字段上的比较配置比较两个字段值.
+func compareField(a, b interface{}, f1, f2 reflect.Value, f *fieldSpec, o *options) (r int, e error) {
+	if f1.Kind() == reflect.Pointer && (f1.IsNil() || f2.IsNil()) && (f.nulls != nullsDefault || f.using != "") {
+		// nil 值的位置不受 desc 的影响
+		if x, y := f1.IsNil(), f2.IsNil(); x == y {
+			return equal, nil
+		} else if x == (f.nulls != nullsLast) {
+			return less, nil
+		}
+		return greater, nil
+	}
+	if f.using != "" {
+		cmp, ok := lookupComparator(f.using)
+		if !ok {
+			return invalid, fmt.Errorf("%w: unknown comparator %q", ErrInvalidTag, f.using)
+		}
+		if f1.Kind() == reflect.Pointer {
+			f1, f2 = f1.Elem(), f2.Elem()
+		}
+		// 通过不可导出字段访问到的值无法传递给比较器
+		if !f1.CanInterface() || !f2.CanInterface() {
+			return invalid, ErrIncomparable
+		}
+		r = fromResult(cmp(f1.Interface(), f2.Interface()))
+	} else if r, e = reflectCompareValue(a, b, f1, f2, true, o); r == invalid {
+		return r, e
+	}
+	if f.desc {
+		r = reverseResult(r)
+	}
+	return r, e
+}
+
+// fromResult 函数用于将比较器返回的-1、0、1转换为内部使用的比较标志位.
+func fromResult(ret int) int {
+	switch {
+	case ret < 0:
+		return less
+	case ret > 0:
+		return greater
+	default:
+		return equal
+	}
+}
+
+// reverseResult 函数用于反转内部使用的比较标志位.
+func reverseResult(r int) int {
+	switch r {
+	case less:
+		return greater
+	case greater:
+		return less
+	default:
+		return r
+	}
+}
+
+var (
+	comparatorsMu sync.RWMutex
+	comparators   = map[string]Type{
+		"string":  String,
+		"bool":    Bool,
+		"int":     Int,
+		"int8":    Int8,
+		"int16":   Int16,
+		"int32":   Int32,
+		"int64":   Int64,
+		"uint":    Uint,
+		"uint8":   Uint8,
+		"uint16":  Uint16,
+		"uint32":  Uint32,
+		"uint64":  Uint64,
+		"float32": Float32,
+		"float64": Float64,
+		"byte":    Byte,
+		"rune":    Rune,
+		"time":    Time,
+		"error":   Error,
+
+		"natural":       Natural,
+		"naturalfold":   NaturalFold,
+		"foldcase":      FoldCase,
+		"ignoreaccents": IgnoreAccents,
+		"pinyin":        Pinyin,
+		"pinyinname":    PinyinName,
+		"totalfloat32":  TotalFloat32,
+		"totalfloat64":  TotalFloat64,
+		"bigint":        BigInt,
+		"bigfloat":      BigFloat,
+		"bigrat":        BigRat,
+		"decimal":       Decimal,
+		"duration":      Duration,
+		"date":          Date,
+	}
+)
+
+// RegisterComparator 函数用于注册一个具名的比较器, 注册之后可以在结构体标签中通过 using=name 使用该比较器.
+// 重复注册同名的比较器会覆盖之前注册的比较器.
+//
+// Example:
+// RegisterComparator("version", func(a, b any) int { ... })
+func RegisterComparator(name string, cmp Type) {
+	comparatorsMu.Lock()
+	defer comparatorsMu.Unlock()
+	comparators[name] = cmp
+}
+
+// lookupComparator 函数用于查找具名的比较器.
+func lookupComparator(name string) (Type, bool) {
+	comparatorsMu.RLock()
+	defer comparatorsMu.RUnlock()
+	cmp, ok := comparators[name]
+	return cmp, ok
+}
@@ -0,0 +1,90 @@
+package comparator
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"testing"
+)
+
+/**
+ *
+ * @Author AiTao
+ * @Date 2026-10-17 10:02
+ * @Url
+ **/
+
+type employee struct {
+	ID      int       `compare:"-"`
+	Level   int       `compare:"order=2,desc"`
+	Dept    string    `compare:"order=1"`
+	Name    string    `compare:"using=fold"`
+	Manager *employee `compare:"nulls=last"`
+}
+
+// registerComparator 函数用于在测试期间注册具名的比较器, 测试结束后自动移除.
+func registerComparator(t *testing.T, name string, cmp Type) {
+	t.Helper()
+	RegisterComparator(name, cmp)
+	t.Cleanup(func() {
+		comparatorsMu.Lock()
+		defer comparatorsMu.Unlock()
+		delete(comparators, name)
+	})
+}
+
+func TestStructTag(t *testing.T) {
+	registerComparator(t, "fold", func(a, b any) int {
+		return strings.Compare(strings.ToLower(a.(string)), strings.ToLower(b.(string)))
+	})
+	boss := &employee{Name: "boss"}
+	es := []employee{
+		{ID: 1, Dept: "b", Level: 1, Name: "x"},
+		{ID: 2, Dept: "a", Level: 1, Name: "y", Manager: boss},
+		{ID: 3, Dept: "a", Level: 3, Name: "z"},
+		{ID: 4, Dept: "a", Level: 1, Name: "Y"},
+		{ID: 5, Dept: "a", Level: 1, Name: "y"},
+	}
+	sort.SliceStable(es, func(i, j int) bool { return Compare(es[i], es[j]) < 0 })
+	var ids []int
+	for _, e := range es {
+		ids = append(ids, e.ID)
+	}
+	if want := []int{3, 2, 4, 5, 1}; !Equals(ids, want) {
+		t.Errorf("sorted ids = %v, want %v", ids, want)
+	}
+	if !Equals(employee{ID: 1, Name: "A"}, employee{ID: 2, Name: "a"}) {
+		t.Error("Equals() with ignored ID and fold name = false, want true")
+	}
+}
+
+func TestStructTagInvalid(t *testing.T) {
+	type bad struct {
+		A int `compare:"order=x"`
+	}
+	if _, err := CompareE(bad{1}, bad{2}); !errors.Is(err, ErrInvalidTag) {
+		t.Errorf("CompareE() error = %v, want ErrInvalidTag", err)
+	}
+	type unknown struct {
+		A int `compare:"using=nope"`
+	}
+	if _, err := CompareE(unknown{1}, unknown{2}); !errors.Is(err, ErrInvalidTag) {
+		t.Errorf("CompareE() error = %v, want ErrInvalidTag", err)
+	}
+	// 不可导出字段无法访问, 不能传递给具名比较器
+	type hidden struct {
+		name string `compare:"using=natural"`
+	}
+	if _, err := CompareE(hidden{"a2"}, hidden{"a10"}); !errors.Is(err, ErrInvalidTag) {
+		t.Errorf("CompareE() error = %v, want ErrInvalidTag", err)
+	}
+	type file struct {
+		Name string `compare:"using=natural"`
+	}
+	type folder struct {
+		file file
+	}
+	if _, err := CompareE(folder{file{"a2"}}, folder{file{"a10"}}); !errors.Is(err, ErrIncomparable) {
+		t.Errorf("CompareE() error = %v, want ErrIncomparable", err)
+	}
+}
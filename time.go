@@ -0,0 +1,92 @@
+package comparator
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/lmlat/go-comparator/typed"
+)
+
+/**
+ *
+ * @Author AiTao
+ * @Date 2026-10-21 16:50
+ * @Url
+ **/
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// TimeWith 函数用于获取一个按照 opts 比较 time.Time 类型数据的比较器, 其中只有 WithinDuration、TruncateTo、
+// StrictLocation、IgnoreMonotonic 选项起作用. 与 Time 函数相同, 比较结果在 time.Time 的整个取值范围内都是正确的.
+//
+// Example:
+// TimeWith(TruncateTo(time.Millisecond))(t, t.Add(time.Microsecond)) 返回 0
+// TimeWith(StrictLocation())(t.UTC(), t.In(loc)) 按照时区名称比较
+func TimeWith(opts ...Option) Type {
+	o := newOptions(opts)
+	return func(x, y interface{}) int {
+		return int(toOrdering(o.compareTimes(x.(time.Time), y.(time.Time))))
+	}
+}
+
+// Duration 函数用于对 time.Duration 类型数据进行类型断言, 并实现基础比较功能.
+func Duration(x, y interface{}) int {
+	return typed.Compare(x.(time.Duration), y.(time.Duration))
+}
+
+// Date 函数用于比较两个 time.Time 类型数据的日期部分(即各自时区中的年、月、日), 忽略一天中的时间.
+//
+// Example:
+// Date(time.Date(2024, 1, 2, 23, 0, 0, 0, time.UTC), time.Date(2024, 1, 2, 1, 0, 0, 0, time.UTC)) 返回 0
+func Date(x, y interface{}) int {
+	y1, m1, d1 := x.(time.Time).Date()
+	y2, m2, d2 := y.(time.Time).Date()
+	if r := typed.Compare(y1, y2); r != 0 {
+		return r
+	}
+	if r := typed.Compare(m1, m2); r != 0 {
+		return r
+	}
+	return typed.Compare(d1, d2)
+}
+
+// compareTimes 函数用于按照选项比较两个时间, 返回内部使用的比较标志位.
+func (o *options) compareTimes(a, b time.Time) int {
+	if o.ignoreMonotonic {
+		a, b = a.Round(0), b.Round(0)
+	}
+	if o.timeTruncate > 0 {
+		a, b = a.Truncate(o.timeTruncate), b.Truncate(o.timeTruncate)
+	}
+	r := equal
+	// Sub 的结果在溢出时取 Duration 的最大(最小)值, 因此不会因为时间相差过大而误判为相等
+	if d := a.Sub(b); d < -o.timeTolerance || d > o.timeTolerance {
+		r = fromResult(a.Compare(b))
+	}
+	if r == equal && o.strictLocation {
+		r = fromResult(compareLocations(a, b))
+	}
+	return r
+}
+
+// compareLocations 函数用于比较两个时间的时区, 先比较时区名称, 再比较 UTC 偏移量.
+func compareLocations(a, b time.Time) int {
+	if r := typed.Compare(a.Location().String(), b.Location().String()); r != 0 {
+		return r
+	}
+	_, x := a.Zone()
+	_, y := b.Zone()
+	return typed.Compare(x, y)
+}
+
+// timeOf 函数用于获取 reflect.Value 中保存的时间, v 的类型必须是 time.Time, 不可导出字段中的时间同样可以获取(忽略单调时钟读数).
+func timeOf(v reflect.Value) (time.Time, bool) {
+	if v.CanInterface() {
+		return v.Interface().(time.Time), true
+	}
+	p, ok := pointerOf(v)
+	if !ok {
+		return time.Time{}, false
+	}
+	return *p.Interface().(*time.Time), true
+}
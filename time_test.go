@@ -0,0 +1,76 @@
+package comparator
+
+import (
+	"testing"
+	"time"
+)
+
+/**
+ *
+ * @Author AiTao
+ * @Date 2026-10-21 16:50
+ * @Url
+ **/
+
+func TestTimeWith(t *testing.T) {
+	base := time.Date(2024, 5, 6, 7, 8, 9, 500, time.UTC)
+	tokyo := time.FixedZone("JST", 9*3600)
+	tests := []struct {
+		name string
+		cmp  Type
+		x, y time.Time
+		want int
+	}{
+		{"default", TimeWith(), base, base.Add(1), -1},
+		{"location", TimeWith(), base, base.In(tokyo), 0},
+		{"strict location", TimeWith(StrictLocation()), base.In(tokyo), base, -1},
+		{"strict same location", TimeWith(StrictLocation()), base, base.Add(0).UTC(), 0},
+		{"truncate", TimeWith(TruncateTo(time.Second)), base, base.Add(time.Millisecond), 0},
+		{"truncate boundary", TimeWith(TruncateTo(time.Second)), base, base.Add(time.Second), -1},
+		{"within", TimeWith(WithinDuration(time.Minute)), base, base.Add(-time.Minute), 0},
+		{"within exceeded", TimeWith(WithinDuration(time.Minute)), base, base.Add(time.Minute + 1), -1},
+		{"within range", TimeWith(WithinDuration(time.Hour)), time.Time{}, time.Date(9999, 1, 1, 0, 0, 0, 0, time.UTC), -1},
+		{"negative within", TimeWith(WithinDuration(-time.Hour)), base, base.Add(time.Second), -1},
+	}
+	for _, tt := range tests {
+		if got := tt.cmp(tt.x, tt.y); got != tt.want {
+			t.Errorf("%s: got %d, want %d", tt.name, got, tt.want)
+		}
+		if got := tt.cmp(tt.y, tt.x); got != -tt.want {
+			t.Errorf("%s (reversed): got %d, want %d", tt.name, got, -tt.want)
+		}
+	}
+}
+
+func TestDurationAndDate(t *testing.T) {
+	if got := Duration(time.Second, time.Minute); got != -1 {
+		t.Errorf("Duration() = %d, want -1", got)
+	}
+	if got := Duration(time.Duration(-1<<63), time.Duration(1<<63-1)); got != -1 {
+		t.Errorf("Duration(min, max) = %d, want -1", got)
+	}
+	day := func(y int, m time.Month, d, h int) time.Time { return time.Date(y, m, d, h, 0, 0, 0, time.UTC) }
+	tests := []struct {
+		x, y time.Time
+		want int
+	}{
+		{day(2024, 1, 2, 23), day(2024, 1, 2, 1), 0},
+		{day(2024, 1, 2, 0), day(2024, 1, 3, 0), -1},
+		{day(2024, 2, 1, 0), day(2024, 1, 31, 0), 1},
+		{day(-5, 1, 1, 0), day(2024, 1, 1, 0), -1},
+		// 按照各自时区中的日期比较
+		{day(2024, 1, 2, 20).In(time.FixedZone("JST", 9*3600)), day(2024, 1, 3, 0), 0},
+	}
+	for _, tt := range tests {
+		if got := Date(tt.x, tt.y); got != tt.want {
+			t.Errorf("Date(%v, %v) = %d, want %d", tt.x, tt.y, got, tt.want)
+		}
+	}
+
+	type booking struct {
+		Day time.Time `compare:"using=date"`
+	}
+	if !Equals(booking{day(2024, 1, 2, 1)}, booking{day(2024, 1, 2, 22)}) {
+		t.Error("Equals(booking) = false, want true")
+	}
+}
@@ -0,0 +1,363 @@
+package comparator
+
+import (
+	"reflect"
+	"sort"
+
+	"github.com/lmlat/go-comparator/typed"
+)
+
+/**
+ *
+ * @Author AiTao
+ * @Date 2026-10-20 15:00
+ * @Url
+ **/
+
+// ValueClass 表示 TotalOrder 中值的类别, 不同类别的值按照类别的先后顺序排列.
+type ValueClass int
+
+const (
+	ClassNil    ValueClass = iota // nil 以及值为 nil 的指针、接口
+	ClassBool                     // 布尔值
+	ClassNumber                   // 整数、浮点数、json.Number 以及 math/big 中的数值类型
+	ClassString                   // 字符串
+	ClassTime                     // time.Time
+	ClassSlice                    // 切片与数组
+	ClassMap                      // map
+	ClassStruct                   // 结构体
+	ClassOther                    // 复数、通道、函数等其它类型
+)
+
+// String 返回值类别的字符串表示形式.
+func (c ValueClass) String() string {
+	switch c {
+	case ClassNil:
+		return "Nil"
+	case ClassBool:
+		return "Bool"
+	case ClassNumber:
+		return "Number"
+	case ClassString:
+		return "String"
+	case ClassTime:
+		return "Time"
+	case ClassSlice:
+		return "Slice"
+	case ClassMap:
+		return "Map"
+	case ClassStruct:
+		return "Struct"
+	default:
+		return "Other"
+	}
+}
+
+// totalOrder 记录了每个值类别的排列位置.
+type totalOrder [ClassOther + 1]int
+
+var defaultTotalOrder = newTotalOrder(nil)
+
+func newTotalOrder(classes []ValueClass) *totalOrder {
+	var t totalOrder
+	for i := range t {
+		t[i] = -1
+	}
+	n := 0
+	for _, c := range classes {
+		if c >= ClassNil && c <= ClassOther && t[c] < 0 {
+			t[c] = n
+			n++
+		}
+	}
+	for c := ClassNil; c <= ClassOther; c++ {
+		if t[c] < 0 {
+			t[c] = n
+			n++
+		}
+	}
+	return &t
+}
+
+// TotalOrder 函数用于比较任意两个值, 与 Compare 不同的是, TotalOrder 在任意两个值之间都能建立比较关系,
+// 适用于对来源不确定的 []interface{} 进行排序. 不同类别的值按照以下顺序排列:
+//
+//	nil < bool < 数值 < 字符串 < time.Time < 切片(数组) < map < 结构体 < 其它
+//
+// 同一类别的值按照以下规则比较:
+//   - 数值之间精确比较, 不区分具体的数值类型, 参见 CompareNumbers, 其中 NaN 大于任何数值且 NaN 之间相等;
+//     无法解析的 json.Number 排在所有合法的数值之后, 彼此之间按照字符串比较
+//   - 切片与数组按照元素逐一比较, 元素相同时较短的一方较小; map 先比较长度, 再按照键的顺序逐一比较键值对
+//   - 相同类型的结构体使用 Compare 的规则(包括 compare 标签)比较, 只有 Compare 无法比较的字段才使用 TotalOrder 比较;
+//     不同类型的结构体按照类型名比较
+//   - 指针比较其指向的值
+//
+// Example:
+// sort.Slice(s, func(i, j int) bool { return TotalOrder(s[i], s[j]) < 0 })
+// TotalOrder(nil, false) 返回 -1
+// TotalOrder(2, "1") 返回 -1
+func TotalOrder(x, y interface{}) int {
+	return defaultTotalOrder.compare(reflect.ValueOf(x), reflect.ValueOf(y), newOptions([]Option{NaNsLast()}))
+}
+
+// TotalOrderWith 函数用于获取一个按照 classes 指定的类别顺序比较任意两个值的比较器, 未指定的类别按照默认顺序排列在指定的类别之后.
+//
+// Example:
+// TotalOrderWith(ClassString, ClassNumber) 返回一个字符串排在数值之前的比较器
+func TotalOrderWith(classes ...ValueClass) Type {
+	t := newTotalOrder(classes)
+	return func(x, y interface{}) int {
+		return t.compare(reflect.ValueOf(x), reflect.ValueOf(y), newOptions([]Option{NaNsLast()}))
+	}
+}
+
+// classOf 函数用于获取值的类别, v 不能为接口或者指向非数值类型的指针.
+func classOf(v reflect.Value) ValueClass {
+	if !v.IsValid() {
+		return ClassNil
+	}
+	if isBigNumber(v.Type()) || isBigValue(v.Type()) {
+		return ClassNumber
+	}
+	switch v.Kind() {
+	case reflect.Bool:
+		return ClassBool
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return ClassNumber
+	case reflect.String:
+		return ClassString
+	case reflect.Slice, reflect.Array:
+		return ClassSlice
+	case reflect.Map:
+		return ClassMap
+	case reflect.Struct:
+		if v.Type() == timeType {
+			return ClassTime
+		}
+		return ClassStruct
+	default:
+		return ClassOther
+	}
+}
+
+// indirectTotal 函数用于获取接口中保存的动态值, 值为 nil 的接口与指针返回无效的 reflect.Value.
+func indirectTotal(v reflect.Value) reflect.Value {
+	for v.Kind() == reflect.Interface {
+		v = v.Elem()
+	}
+	if v.Kind() == reflect.Pointer && v.IsNil() {
+		return reflect.Value{}
+	}
+	return v
+}
+
+// derefTotal 函数用于判断 v 是否为需要比较其指向的值的指针, math/big 中的数值类型除外.
+func derefTotal(v reflect.Value) bool {
+	return v.Kind() == reflect.Pointer && !isBigNumber(v.Type())
+}
+
+func (t *totalOrder) compare(va, vb reflect.Value, o *options) int {
+	va, vb = indirectTotal(va), indirectTotal(vb)
+	// 逐层比较指针指向的值, 循环引用的指针对视为相等
+	if da, db := derefTotal(va), derefTotal(vb); da || db {
+		if da && db {
+			if va.UnsafePointer() == vb.UnsafePointer() {
+				return 0
+			}
+			k, ok := o.visit(va, vb)
+			if !ok {
+				return 0
+			}
+			defer o.unvisit(k)
+		}
+		if da {
+			va = va.Elem()
+		}
+		if db {
+			vb = vb.Elem()
+		}
+		return t.compare(va, vb, o)
+	}
+	ca, cb := classOf(va), classOf(vb)
+	if ca != cb {
+		return typed.Compare(t[ca], t[cb])
+	}
+	switch ca {
+	case ClassNil:
+		return 0
+	case ClassBool:
+		return Bool(va.Bool(), vb.Bool())
+	case ClassNumber:
+		if r, ok := compareNumbers(va, vb, o); ok {
+			return int(toOrdering(r))
+		}
+		// 无法解析的 json.Number(以及值为 nil 的 math/big 指针)排在所有合法的数值之后, 彼此之间依次按照类型、字符串比较,
+		// 如果与合法的数值混在一起按照字符串比较, 将无法保证传递性
+		if _, ok := numberOf(va); ok {
+			return -1
+		}
+		if _, ok := numberOf(vb); ok {
+			return 1
+		}
+		if r := t.compareTypes(va, vb); r != 0 {
+			return r
+		}
+		return typed.Compare(va.String(), vb.String())
+	case ClassString:
+		return typed.Compare(va.String(), vb.String())
+	case ClassTime:
+		t1, o1 := timeOf(va)
+		t2, o2 := timeOf(vb)
+		if !o1 || !o2 {
+			return t.compareStructs(va, vb, o)
+		}
+		return int(toOrdering(o.compareTimes(t1, t2)))
+	case ClassSlice:
+		return t.compareSlices(va, vb, o)
+	case ClassMap:
+		return t.compareMaps(va, vb, o)
+	case ClassStruct:
+		return t.compareStructs(va, vb, o)
+	default:
+		return t.compareOthers(va, vb)
+	}
+}
+
+// compareTypes 函数用于比较两个值的类型, 先比较类型名, 再比较包路径.
+func (t *totalOrder) compareTypes(va, vb reflect.Value) int {
+	ta, tb := va.Type(), vb.Type()
+	if r := typed.Compare(ta.String(), tb.String()); r != 0 {
+		return r
+	}
+	return typed.Compare(ta.PkgPath(), tb.PkgPath())
+}
+
+func (t *totalOrder) compareSlices(va, vb reflect.Value, o *options) int {
+	if va.Kind() == reflect.Slice && vb.Kind() == reflect.Slice && !va.IsNil() && !vb.IsNil() {
+		k, ok := o.visit(va, vb)
+		if !ok {
+			return 0
+		}
+		defer o.unvisit(k)
+	}
+	for i := 0; i < va.Len() && i < vb.Len(); i++ {
+		if r := t.compare(va.Index(i), vb.Index(i), o); r != 0 {
+			return r
+		}
+	}
+	return typed.Compare(va.Len(), vb.Len())
+}
+
+func (t *totalOrder) compareMaps(va, vb reflect.Value, o *options) int {
+	if r := typed.Compare(va.Len(), vb.Len()); r != 0 {
+		return r
+	}
+	if !va.IsNil() && !vb.IsNil() {
+		k, ok := o.visit(va, vb)
+		if !ok {
+			return 0
+		}
+		defer o.unvisit(k)
+	}
+	ka, kb := t.sortedKeys(va, o), t.sortedKeys(vb, o)
+	for i := range ka {
+		if r := t.compare(ka[i], kb[i], o); r != 0 {
+			return r
+		}
+		if r := t.compare(va.MapIndex(ka[i]), vb.MapIndex(kb[i]), o); r != 0 {
+			return r
+		}
+	}
+	return 0
+}
+
+func (t *totalOrder) sortedKeys(v reflect.Value, o *options) []reflect.Value {
+	keys := v.MapKeys()
+	sort.Slice(keys, func(i, j int) bool { return t.compare(keys[i], keys[j], o) < 0 })
+	return keys
+}
+
+// compareStructs 函数用于比较两个结构体. 相同类型的结构体始终按照 Compare 的规则比较: 自身定义了比较方式的类型作为一个整体比较,
+// 其余的类型按照 compare 标签指定的优先级逐一比较字段, 只有 Compare 无法比较的字段才使用全序比较, 从而保证同一类型的结构体之间
+// 只使用一种规则, 满足传递性.
+func (t *totalOrder) compareStructs(va, vb reflect.Value, o *options) int {
+	typ := va.Type()
+	if typ != vb.Type() {
+		return t.compareTypes(va, vb)
+	}
+	if hasOwnOrder(va, o) {
+		if r, _ := reflectCompareValue(nil, nil, va, vb, true, o); r != invalid {
+			return int(toOrdering(r))
+		}
+	}
+	spec := structSpecOf(typ)
+	if spec.err != nil {
+		// 标签非法时 Compare 无法比较该类型的任何值, 因此按照声明的顺序对所有字段使用全序比较
+		for i := 0; i < va.NumField(); i++ {
+			if r := t.compare(va.Field(i), vb.Field(i), o); r != 0 {
+				return r
+			}
+		}
+		return 0
+	}
+	for i := range spec.fields {
+		f := &spec.fields[i]
+		if (o.ignoreUnexported || len(o.ignoreFields) > 0) && o.ignoreField(typ, typ.Field(f.index)) {
+			continue
+		}
+		f1, f2 := va.Field(f.index), vb.Field(f.index)
+		r, _ := compareField(nil, nil, f1, f2, f, o)
+		if r == invalid {
+			if r = fromResult(t.compare(f1, f2, o)); f.desc {
+				r = reverseResult(r)
+			}
+		}
+		if r != equal {
+			return int(toOrdering(r))
+		}
+	}
+	return 0
+}
+
+// hasOwnOrder 函数用于判断结构体是否自身定义了比较方式, 例如注册了比较器、实现了 Iface 或 Comparer 接口的类型,
+// 以及 time.Time、math/big、net/netip 中的类型.
+func hasOwnOrder(v reflect.Value, o *options) bool {
+	t := v.Type()
+	if t == timeType || isBigValue(t) {
+		return true
+	}
+	if !v.CanInterface() {
+		return false
+	}
+	if _, ok := o.lookup(t); ok {
+		return true
+	}
+	if _, ok := netComparators[t]; ok {
+		return true
+	}
+	if _, ok := comparerMethod(t); ok {
+		return true
+	}
+	return t.Implements(ifaceType)
+}
+
+// compareOthers 函数用于比较复数、通道、函数等其它类型的值, 复数按照先实部后虚部的顺序比较, 其余类型按照地址比较.
+func (t *totalOrder) compareOthers(va, vb reflect.Value) int {
+	if r := t.compareTypes(va, vb); r != 0 {
+		return r
+	}
+	switch va.Kind() {
+	case reflect.Complex64, reflect.Complex128:
+		x, y := va.Complex(), vb.Complex()
+		if r := TotalFloat64(real(x), real(y)); r != 0 {
+			return r
+		}
+		return TotalFloat64(imag(x), imag(y))
+	case reflect.Chan, reflect.Func, reflect.UnsafePointer:
+		return typed.Compare(va.Pointer(), vb.Pointer())
+	default:
+		return 0
+	}
+}
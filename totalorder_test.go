@@ -0,0 +1,171 @@
+package comparator
+
+import (
+	"encoding/json"
+	"math"
+	"math/big"
+	"math/rand"
+	"sort"
+	"testing"
+	"time"
+)
+
+/**
+ *
+ * @Author AiTao
+ * @Date 2026-10-20 15:00
+ * @Url
+ **/
+
+func TestTotalOrder(t *testing.T) {
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	one := 1
+	type point struct{ X, Y int }
+	type label struct{ Name string }
+	// 按照从小到大的顺序排列, 同一行中的值相等
+	groups := [][]interface{}{
+		{nil, (*int)(nil)},
+		{false},
+		{true},
+		{math.Inf(-1)},
+		{int8(-128)},
+		{-0.5},
+		{0, 0.0, math.Copysign(0, -1), uint(0)},
+		{1, &one, int64(1), 1.0, json.Number("1"), big.NewInt(1), big.NewRat(2, 2)},
+		{uint64(math.MaxUint64)},
+		{new(big.Int).Lsh(big.NewInt(1), 100)},
+		{math.Inf(1)},
+		{math.NaN(), float32(math.NaN())},
+		{""},
+		{"1"},
+		{"a"},
+		{now},
+		{now.Add(time.Second)},
+		{[]int{}, [0]string{}},
+		{[]interface{}{1, "a"}, [2]interface{}{1.0, "a"}},
+		{[]interface{}{1, "a", nil}},
+		{[]interface{}{1, "b"}},
+		{[]int{2}},
+		{map[string]int{}},
+		{map[string]interface{}{"a": 1}, map[string]float64{"a": 1}},
+		{map[string]interface{}{"a": "x"}},
+		{map[interface{}]int{"a": 1, 2: 1}},
+		{label{"a"}},
+		{label{"b"}},
+		{point{1, 2}, &point{1, 2}},
+		{point{2, 1}},
+		{1 + 2i},
+	}
+	var all []interface{}
+	rank := make(map[int]int)
+	for g, vs := range groups {
+		for _, v := range vs {
+			rank[len(all)] = g
+			all = append(all, v)
+		}
+	}
+	for i, a := range all {
+		for j, b := range all {
+			want := 0
+			if rank[i] < rank[j] {
+				want = -1
+			} else if rank[i] > rank[j] {
+				want = 1
+			}
+			if got := TotalOrder(a, b); got != want {
+				t.Errorf("TotalOrder(%#v, %#v) = %d, want %d", a, b, got, want)
+			}
+		}
+	}
+}
+
+func TestTotalOrderSort(t *testing.T) {
+	values := []interface{}{"b", 3, nil, 2.5, true, []int{1}, "a", map[string]int{"k": 1}, uint8(1), json.Number("2")}
+	want := []interface{}{nil, true, uint8(1), json.Number("2"), 2.5, 3, "a", "b", []int{1}, map[string]int{"k": 1}}
+	for seed := int64(0); seed < 10; seed++ {
+		s := append([]interface{}(nil), values...)
+		rand.New(rand.NewSource(seed)).Shuffle(len(s), func(i, j int) { s[i], s[j] = s[j], s[i] })
+		sort.SliceStable(s, func(i, j int) bool { return TotalOrder(s[i], s[j]) < 0 })
+		if !Equals(s, want) {
+			t.Fatalf("sorted = %v, want %v", s, want)
+		}
+	}
+}
+
+func TestTotalOrderWith(t *testing.T) {
+	cmp := TotalOrderWith(ClassString, ClassNumber)
+	if got := cmp("z", 1); got != -1 {
+		t.Errorf("cmp(\"z\", 1) = %d, want -1", got)
+	}
+	if got := cmp(1, nil); got != -1 {
+		t.Errorf("cmp(1, nil) = %d, want -1", got)
+	}
+	if got := cmp(nil, false); got != -1 {
+		t.Errorf("cmp(nil, false) = %d, want -1", got)
+	}
+	if got := ClassTime.String(); got != "Time" {
+		t.Errorf("ClassTime.String() = %q, want %q", got, "Time")
+	}
+}
+
+func TestTotalOrderStructTags(t *testing.T) {
+	type record struct {
+		Name string
+		Kind interface{} `compare:"order=1"`
+		Rank int         `compare:"order=2,desc"`
+	}
+	// Kind 的类型不一致时 Compare 无法比较该字段, 只有该字段使用全序比较, 其余字段仍然按照标签指定的规则比较,
+	// 否则 x < y(Rank) < z(Name) < x(Name) 会形成环. 与 Compare 一致, 值为 nil 的 Kind 排在最后面
+	x, y, z := record{"z", 1, 3}, record{"a", 1, 1}, record{"m", "k", 0}
+	values := []interface{}{x, y, z, record{"b", 2.5, 0}, record{"c", "k", 5}, record{"d", nil, 0}}
+	for _, a := range values {
+		for _, b := range values {
+			for _, c := range values {
+				if TotalOrder(a, b) <= 0 && TotalOrder(b, c) <= 0 && TotalOrder(a, c) > 0 {
+					t.Errorf("TotalOrder is not transitive: %v <= %v <= %v but %v > %v", a, b, c, a, c)
+				}
+			}
+		}
+	}
+	want := []interface{}{x, y, record{"b", 2.5, 0}, record{"c", "k", 5}, z, record{"d", nil, 0}}
+	sort.SliceStable(values, func(i, j int) bool { return TotalOrder(values[i], values[j]) < 0 })
+	if !Equals(values, want) {
+		t.Errorf("sorted = %v, want %v", values, want)
+	}
+}
+
+func TestTotalOrderCycle(t *testing.T) {
+	a := &listNode{Value: 1}
+	a.Next = a
+	b := &listNode{Value: 1}
+	b.Next = b
+	runWithTimeout(t, "TotalOrder", func() {
+		if got := TotalOrder([]interface{}{a}, []interface{}{b}); got != 0 {
+			t.Errorf("TotalOrder(cycle) = %d, want 0", got)
+		}
+	})
+}
+
+func TestTotalOrderInvalidNumber(t *testing.T) {
+	// 无法解析的 json.Number 排在所有合法的数值之后, 否则 "9" < "10" < "1a" < "9" 会形成环
+	values := []interface{}{json.Number("9"), json.Number("10"), json.Number("1a"), json.Number("x"), 2.5, uint8(100), json.Number("-1e3"), (*big.Int)(nil),
+		json.Number("0x10"), json.Number("1/3"), json.Number("0b1"), 16}
+	for _, a := range values {
+		for _, b := range values {
+			for _, c := range values {
+				if TotalOrder(a, b) <= 0 && TotalOrder(b, c) <= 0 && TotalOrder(a, c) > 0 {
+					t.Errorf("TotalOrder is not transitive: %v <= %v <= %v but %v > %v", a, b, c, a, c)
+				}
+			}
+		}
+	}
+	if got := TotalOrder(json.Number("1a"), json.Number("9")); got != 1 {
+		t.Errorf("TotalOrder(1a, 9) = %d, want 1", got)
+	}
+	if got := TotalOrder(json.Number("0x10"), 16); got != 1 {
+		t.Errorf("TotalOrder(0x10, 16) = %d, want 1", got)
+	}
+	if got := TotalOrder(json.Number("1a"), json.Number("x")); got != -1 {
+		t.Errorf("TotalOrder(1a, x) = %d, want -1", got)
+	}
+}
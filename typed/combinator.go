@@ -0,0 +1,114 @@
+package typed
+
+/**
+ *
+ * @Author AiTao
+ * @Date 2026-10-17 17:30
+ * @Url
+ **/
+
+// Reverse 函数用于获取 cmp 的逆序比较器.
+func Reverse[T any](cmp func(a, b T) int) func(a, b T) int {
+	return func(a, b T) int { return cmp(b, a) }
+}
+
+// By 函数用于获取一个比较器, 该比较器使用 cmp 比较 key 从两个值中提取的键.
+//
+// Example:
+// By(func(u User) int { return u.Age }, Compare[int]) 返回一个按照年龄比较 User 的比较器
+func By[T, K any](key func(T) K, cmp func(a, b K) int) func(a, b T) int {
+	return func(a, b T) int { return cmp(key(a), key(b)) }
+}
+
+// Mapping 函数用于获取一个比较器, 该比较器先使用 transform 规范化两个值, 然后使用 cmp 比较规范化之后的值.
+//
+// Example:
+// Mapping(strings.ToLower, Compare[string]) 返回一个忽略大小写的字符串比较器
+func Mapping[T any](transform func(T) T, cmp func(a, b T) int) func(a, b T) int {
+	return By(transform, cmp)
+}
+
+// Then 函数用于获取一个组合比较器, 该比较器依次使用 cmps 比较两个值, 并返回第一个不为0的比较结果.
+func Then[T any](cmps ...func(a, b T) int) func(a, b T) int {
+	return func(a, b T) int {
+		for _, cmp := range cmps {
+			if r := cmp(a, b); r != 0 {
+				return r
+			}
+		}
+		return 0
+	}
+}
+
+// NullsFirst 函数用于获取一个指针比较器, 值为 nil 的指针小于任何非 nil 指针, 两个非 nil 指针使用 cmp 比较其指向的值.
+func NullsFirst[T any](cmp func(a, b T) int) func(a, b *T) int {
+	return nulls(cmp, -1)
+}
+
+// NullsLast 函数用于获取一个指针比较器, 值为 nil 的指针大于任何非 nil 指针, 两个非 nil 指针使用 cmp 比较其指向的值.
+func NullsLast[T any](cmp func(a, b T) int) func(a, b *T) int {
+	return nulls(cmp, 1)
+}
+
+func nulls[T any](cmp func(a, b T) int, order int) func(a, b *T) int {
+	return func(a, b *T) int {
+		switch {
+		case a == nil && b == nil:
+			return 0
+		case a == nil:
+			return order
+		case b == nil:
+			return -order
+		default:
+			return cmp(*a, *b)
+		}
+	}
+}
+
+// Lexicographic 函数用于获取一个按照字典序比较切片的比较器, 切片中的元素使用 cmp 进行比较,
+// 当其中一个切片是另一个切片的前缀时, 较短的切片较小.
+func Lexicographic[T any](cmp func(a, b T) int) func(a, b []T) int {
+	return func(a, b []T) int {
+		for i := 0; i < len(a) && i < len(b); i++ {
+			if r := cmp(a[i], b[i]); r != 0 {
+				return r
+			}
+		}
+		return Compare(len(a), len(b))
+	}
+}
+
+// Tuple 函数用于获取一个比较元组的比较器, 元组中下标为 i 的元素使用 cmps[i] 进行比较,
+// 超出 cmps 长度的元素不参与比较; 当元组长度不足时, 较短的元组较小.
+func Tuple[T any](cmps ...func(a, b T) int) func(a, b []T) int {
+	return func(a, b []T) int {
+		for i, cmp := range cmps {
+			if i >= len(a) || i >= len(b) {
+				return Compare(len(a), len(b))
+			}
+			if r := cmp(a[i], b[i]); r != 0 {
+				return r
+			}
+		}
+		return 0
+	}
+}
+
+// Couple 表示一个由两个不同类型的元素组成的二元组.
+type Couple[A, B any] struct {
+	First  A
+	Second B
+}
+
+// Pair 函数用于获取一个比较二元组的比较器, 二元组中的第一个元素使用 first 比较, 第二个元素使用 second 比较.
+//
+// Example:
+// Pair(Compare[string], Compare[int])(Couple[string, int]{"go", 1}, Couple[string, int]{"go", 2}) 返回 -1
+func Pair[A, B any](first func(a, b A) int, second func(a, b B) int) func(a, b Couple[A, B]) int {
+	return func(a, b Couple[A, B]) int {
+		if r := first(a.First, b.First); r != 0 {
+			return r
+		}
+		return second(a.Second, b.Second)
+	}
+}
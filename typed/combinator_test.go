@@ -0,0 +1,69 @@
+package typed
+
+import (
+	"sort"
+	"strings"
+	"testing"
+)
+
+/**
+ *
+ * @Author AiTao
+ * @Date 2026-10-17 17:30
+ * @Url
+ **/
+
+type member struct {
+	Name string
+	Age  int
+}
+
+func TestThenAssociativity(t *testing.T) {
+	ms := []member{{"bob", 30}, {"Alice", 30}, {"carol", 25}, {"alice", 25}, {"Bob", 30}}
+	cmps := []func(a, b member) int{
+		By(func(m member) int { return m.Age }, Compare[int]),
+		By(func(m member) string { return m.Name }, Mapping(strings.ToLower, Compare[string])),
+		Reverse(By(func(m member) string { return m.Name }, Compare[string])),
+	}
+	for _, x := range ms {
+		for _, y := range ms {
+			for _, c1 := range cmps {
+				for _, c2 := range cmps {
+					for _, c3 := range cmps {
+						if l, r := Then(Then(c1, c2), c3)(x, y), Then(c1, Then(c2, c3))(x, y); l != r {
+							t.Fatalf("Then is not associative for %v, %v", x, y)
+						}
+					}
+				}
+			}
+		}
+	}
+	cmp := Then(cmps...)
+	sort.Slice(ms, func(i, j int) bool { return cmp(ms[i], ms[j]) < 0 })
+	if ms[0] != (member{"alice", 25}) || ms[2] != (member{"Alice", 30}) || ms[3] != (member{"bob", 30}) {
+		t.Errorf("sorted = %v", ms)
+	}
+}
+
+func TestCombinators(t *testing.T) {
+	x, y := 1, 2
+	if r := NullsFirst(Compare[int])(nil, &x); r != -1 {
+		t.Errorf("NullsFirst() = %d", r)
+	}
+	if r := NullsLast(Compare[int])(nil, &x); r != 1 {
+		t.Errorf("NullsLast() = %d", r)
+	}
+	if r := NullsLast(Compare[int])(&y, &x); r != 1 {
+		t.Errorf("NullsLast() = %d", r)
+	}
+	if r := Lexicographic(Compare[int])([]int{1, 2}, []int{1, 2, 0}); r != -1 {
+		t.Errorf("Lexicographic() = %d", r)
+	}
+	if r := Tuple(Compare[string], Reverse(Compare[string]))([]string{"a", "b"}, []string{"a", "c"}); r != 1 {
+		t.Errorf("Tuple() = %d", r)
+	}
+	pair := Pair(Compare[string], Compare[int])
+	if r := pair(Couple[string, int]{"go", 1}, Couple[string, int]{"go", 2}); r != -1 {
+		t.Errorf("Pair() = %d", r)
+	}
+}
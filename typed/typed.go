@@ -0,0 +1,101 @@
+// Package typed implements generic compare functions for ordered types without reflection.
+package typed
+
+/**
+ *
+ * @Author AiTao
+ * @Date 2026-10-17 15:30
+ * @Url
+ **/
+
+// Signed 约束了全部有符号整数类型, 包括以这些类型为底层类型的新类型.
+type Signed interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64
+}
+
+// Unsigned 约束了全部无符号整数类型, 包括以这些类型为底层类型的新类型.
+type Unsigned interface {
+	~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Float 约束了全部浮点数类型, 包括以这些类型为底层类型的新类型.
+type Float interface {
+	~float32 | ~float64
+}
+
+// Ordered 约束了支持 <、<=、>、>= 运算符的全部类型, 包括整数、浮点数、字符串以及以这些类型为底层类型的新类型.
+type Ordered interface {
+	Signed | Unsigned | Float | ~string
+}
+
+// Compare 函数用于比较两个值的大小, 如果 a > b, 返回1; 如果 a = b, 返回0; 如果 a < b, 返回-1.
+// 与 comparator 包中的 Float64 等函数保持一致, NaN 与任何值比较均返回0.
+func Compare[T Ordered](a, b T) int {
+	switch {
+	case a > b:
+		return 1
+	case a < b:
+		return -1
+	default:
+		return 0
+	}
+}
+
+// Of 函数用于获取类型 T 的比较器.
+//
+// Example:
+// Of[int]() 返回一个 int 类型的比较器
+// Of[time.Duration]() 返回一个 time.Duration 类型的比较器
+func Of[T Ordered]() func(a, b T) int {
+	return Compare[T]
+}
+
+// Less 函数用于判断 a 是否小于 b.
+func Less[T Ordered](a, b T) bool {
+	return a < b
+}
+
+// Max 函数用于获取参数中的最大值, 存在多个最大值时返回第一个.
+//
+// Example:
+// Max(3, 1, 2) 返回 3
+func Max[T Ordered](x T, y ...T) T {
+	for _, v := range y {
+		if v > x {
+			x = v
+		}
+	}
+	return x
+}
+
+// Min 函数用于获取参数中的最小值, 存在多个最小值时返回第一个.
+//
+// Example:
+// Min(3, 1, 2) 返回 1
+func Min[T Ordered](x T, y ...T) T {
+	for _, v := range y {
+		if v < x {
+			x = v
+		}
+	}
+	return x
+}
+
+// Clamp 函数用于将 v 限制在区间 [lo, hi] 中, 当 v < lo 时返回 lo, 当 v > hi 时返回 hi, 否则返回 v.
+// 当 lo > hi 时会发生 panic.
+//
+// Example:
+// Clamp(15, 0, 10) 返回 10
+func Clamp[T Ordered](v, lo, hi T) T {
+	if lo > hi {
+		panic("typed: Clamp called with lo > hi")
+	}
+	switch {
+	case v < lo:
+		return lo
+	case v > hi:
+		return hi
+	default:
+		return v
+	}
+}
@@ -0,0 +1,61 @@
+package typed
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+/**
+ *
+ * @Author AiTao
+ * @Date 2026-10-17 15:30
+ * @Url
+ **/
+
+type celsius float64
+
+func TestCompare(t *testing.T) {
+	if r := Compare(1, 2); r != -1 {
+		t.Errorf("Compare(1, 2) = %d", r)
+	}
+	if r := Compare("b", "a"); r != 1 {
+		t.Errorf(`Compare("b", "a") = %d`, r)
+	}
+	if r := Of[time.Duration]()(time.Second, time.Second); r != 0 {
+		t.Errorf("Of[time.Duration]() = %d", r)
+	}
+	if r := Of[celsius]()(-1.5, 2); r != -1 {
+		t.Errorf("Of[celsius]() = %d", r)
+	}
+	if r := Compare(math.NaN(), 1); r != 0 {
+		t.Errorf("Compare(NaN, 1) = %d", r)
+	}
+	if !Less[uint8](1, 2) || Less("b", "a") {
+		t.Errorf("Less() mismatch")
+	}
+}
+
+func TestMinMaxClamp(t *testing.T) {
+	if v := Max(3, 7, 1); v != 7 {
+		t.Errorf("Max() = %d", v)
+	}
+	if v := Min("go", "c", "rust"); v != "c" {
+		t.Errorf("Min() = %s", v)
+	}
+	if v := Max[int8](5); v != 5 {
+		t.Errorf("Max() = %d", v)
+	}
+	tests := []struct{ v, want int }{{-1, 0}, {5, 5}, {11, 10}}
+	for _, tt := range tests {
+		if got := Clamp(tt.v, 0, 10); got != tt.want {
+			t.Errorf("Clamp(%d, 0, 10) = %d, want %d", tt.v, got, tt.want)
+		}
+	}
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Clamp(lo > hi) did not panic")
+		}
+	}()
+	Clamp(1, 10, 0)
+}
@@ -25,4 +25,40 @@ type Iface interface {
 	CompareTo(Iface) int
 }
 
+// Comparer 是一个泛型可比较接口, 与 Iface 不同的是, CompareTo 的参数类型即为实现类型本身, 实现时无需进行类型断言.
+// 深度比较过程中会通过反射识别实现了 Comparer 接口的类型(CompareTo 方法的签名为 func(T) int), 并使用 CompareTo 方法比较两个值.
+//
+//	func (v Version) CompareTo(o Version) int {
+//		return typed.Compare(v.Major, o.Major)
+//	}
+type Comparer[T any] interface {
+	CompareTo(T) int
+}
+
 type Type func(any, any) int
+
+// Ordering 表示两个值之间的比较结果, 其中 LessThan、EqualTo、GreaterThan 的取值与常规比较器的 -1、0、1 保持一致,
+// Incomparable 表示两个值之间无法建立比较关系(例如类型不一致). 由于包中已经存在 Less、Greater 函数,
+// 常量分别命名为 LessThan、EqualTo、GreaterThan, 其字符串表示形式仍然为 "Less"、"Equal"、"Greater".
+type Ordering int
+
+const (
+	LessThan     Ordering = -1
+	EqualTo      Ordering = 0
+	GreaterThan  Ordering = 1
+	Incomparable Ordering = 2
+)
+
+// String 返回比较结果的字符串表示形式.
+func (o Ordering) String() string {
+	switch o {
+	case LessThan:
+		return "Less"
+	case EqualTo:
+		return "Equal"
+	case GreaterThan:
+		return "Greater"
+	default:
+		return "Incomparable"
+	}
+}
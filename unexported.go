@@ -0,0 +1,186 @@
+package comparator
+
+import (
+	"math/big"
+	"math/bits"
+	"reflect"
+	"time"
+)
+
+/**
+ *
+ * @Author AiTao
+ * @Date 2026-10-23 14:20
+ * @Url
+ **/
+
+// 不可导出字段中的值无法通过 Interface 方法获取, 也无法赋值给其它的值, 但是其内部字段仍然可以通过
+// reflect.Value 的只读方法(Uint、Int、Bool 等)读取. 本文件中的函数据此为 time.Time 以及 math/big 中的数值类型
+// 复制一份可访问的副本, 使得它们与可导出字段中的值一样按照 Compare、Cmp 等方法比较, 而不是比较其内部字段.
+// 当类型的内部结构与预期不符时返回 false, 此时调用方返回 ErrIncomparable.
+
+// time.Time 中 wall 字段的编码方式, 参见 time 包.
+const (
+	timeHasMonotonic   = 1 << 63
+	timeNsecMask       = 1<<30 - 1
+	timeNsecShift      = 30
+	timeSecondsPerDay  = 24 * 60 * 60
+	timeUnixToInternal = (1969*365 + 1969/4 - 1969/100 + 1969/400) * timeSecondsPerDay
+	timeWallToInternal = (1884*365 + 1884/4 - 1884/100 + 1884/400) * timeSecondsPerDay
+)
+
+// copyUnexported 函数用于复制一份无法访问的 time.Time、big.Int、big.Float 或 big.Rat 类型的值, 返回指向副本的指针.
+func copyUnexported(v reflect.Value) (reflect.Value, bool) {
+	switch v.Type() {
+	case timeType:
+		if t, ok := readTime(v); ok {
+			return reflect.ValueOf(&t), true
+		}
+	case bigIntValueType:
+		if x, ok := readBigInt(v); ok {
+			return reflect.ValueOf(x), true
+		}
+	case bigFloatValueType:
+		if x, ok := readBigFloat(v); ok {
+			return reflect.ValueOf(x), true
+		}
+	case bigRatValueType:
+		if x, ok := readBigRat(v); ok {
+			return reflect.ValueOf(x), true
+		}
+	}
+	return reflect.Value{}, false
+}
+
+// fieldOf 函数用于获取结构体 v 中名称为 name、类型种类为 kind 的字段.
+func fieldOf(v reflect.Value, name string, kind reflect.Kind) (reflect.Value, bool) {
+	f := v.FieldByName(name)
+	return f, f.IsValid() && f.Kind() == kind
+}
+
+// readTime 函数用于读取 time.Time 类型的值. 单调时钟读数无法写入副本, 因此会被忽略.
+func readTime(v reflect.Value) (time.Time, bool) {
+	wall, ok1 := fieldOf(v, "wall", reflect.Uint64)
+	ext, ok2 := fieldOf(v, "ext", reflect.Int64)
+	loc, ok3 := fieldOf(v, "loc", reflect.Pointer)
+	if !ok1 || !ok2 || !ok3 {
+		return time.Time{}, false
+	}
+	w, sec := wall.Uint(), ext.Int()
+	if w&timeHasMonotonic != 0 {
+		sec = timeWallToInternal + int64(w<<1>>(timeNsecShift+1))
+	}
+	l, ok := readLocation(loc)
+	if !ok {
+		return time.Time{}, false
+	}
+	return time.Unix(sec-timeUnixToInternal, int64(w&timeNsecMask)).In(l), true
+}
+
+// readLocation 函数用于读取 time.Time 中的时区. 只有一个时区偏移量的时区(如 time.FixedZone 创建的时区)直接复制,
+// 其余的时区按照名称重新加载.
+func readLocation(p reflect.Value) (*time.Location, bool) {
+	if p.IsNil() {
+		return time.UTC, true
+	}
+	if p.Pointer() == reflect.ValueOf(time.Local).Pointer() {
+		return time.Local, true
+	}
+	l := p.Elem()
+	if l.Kind() != reflect.Struct {
+		return nil, false
+	}
+	name, ok1 := fieldOf(l, "name", reflect.String)
+	zones, ok2 := fieldOf(l, "zone", reflect.Slice)
+	if !ok1 || !ok2 {
+		return nil, false
+	}
+	if zones.Len() == 1 && zones.Index(0).Kind() == reflect.Struct {
+		zn, ok1 := fieldOf(zones.Index(0), "name", reflect.String)
+		offset, ok2 := fieldOf(zones.Index(0), "offset", reflect.Int)
+		if ok1 && ok2 && zn.String() == name.String() {
+			return time.FixedZone(zn.String(), int(offset.Int())), true
+		}
+	}
+	loc, err := time.LoadLocation(name.String())
+	return loc, err == nil
+}
+
+// readNat 函数用于读取 math/big 中以 []Word 表示的无符号整数.
+func readNat(v reflect.Value) ([]big.Word, bool) {
+	if v.Kind() != reflect.Slice || v.Type().Elem().Kind() != reflect.Uint {
+		return nil, false
+	}
+	words := make([]big.Word, v.Len())
+	for i := range words {
+		words[i] = big.Word(v.Index(i).Uint())
+	}
+	return words, true
+}
+
+// readBigInt 函数用于读取 big.Int 类型的值.
+func readBigInt(v reflect.Value) (*big.Int, bool) {
+	neg, ok1 := fieldOf(v, "neg", reflect.Bool)
+	abs, ok2 := fieldOf(v, "abs", reflect.Slice)
+	if !ok1 || !ok2 {
+		return nil, false
+	}
+	words, ok := readNat(abs)
+	if !ok {
+		return nil, false
+	}
+	x := new(big.Int).SetBits(words)
+	if neg.Bool() {
+		x.Neg(x)
+	}
+	return x, true
+}
+
+// readBigRat 函数用于读取 big.Rat 类型的值, 分母的符号会被忽略, 值为 0 的分母视为 1.
+func readBigRat(v reflect.Value) (*big.Rat, bool) {
+	a, ok1 := fieldOf(v, "a", reflect.Struct)
+	b, ok2 := fieldOf(v, "b", reflect.Struct)
+	if !ok1 || !ok2 || a.Type() != bigIntValueType || b.Type() != bigIntValueType {
+		return nil, false
+	}
+	x, ok1 := readBigInt(a)
+	y, ok2 := readBigInt(b)
+	if !ok1 || !ok2 {
+		return nil, false
+	}
+	if y.Sign() == 0 {
+		return new(big.Rat).SetInt(x), true
+	}
+	return new(big.Rat).SetFrac(x, y.Abs(y)), true
+}
+
+// readBigFloat 函数用于读取 big.Float 类型的值. 有限值的尾数 mant 表示区间 [0.5, 1) 中的小数, 其值为 0.mant × 2^exp.
+func readBigFloat(v reflect.Value) (*big.Float, bool) {
+	prec, ok1 := fieldOf(v, "prec", reflect.Uint32)
+	form, ok2 := fieldOf(v, "form", reflect.Uint8)
+	neg, ok3 := fieldOf(v, "neg", reflect.Bool)
+	mant, ok4 := fieldOf(v, "mant", reflect.Slice)
+	exp, ok5 := fieldOf(v, "exp", reflect.Int32)
+	if !ok1 || !ok2 || !ok3 || !ok4 || !ok5 {
+		return nil, false
+	}
+	x := new(big.Float).SetPrec(uint(prec.Uint()))
+	switch form.Uint() {
+	case 0: // zero
+	case 1: // finite
+		words, ok := readNat(mant)
+		if !ok {
+			return nil, false
+		}
+		x.SetInt(new(big.Int).SetBits(words))
+		x.SetMantExp(x, int(exp.Int())-len(words)*bits.UintSize)
+	case 2: // inf
+		return x.SetInf(neg.Bool()), true
+	default:
+		return nil, false
+	}
+	if neg.Bool() {
+		x.Neg(x)
+	}
+	return x, true
+}
@@ -0,0 +1,80 @@
+package comparator
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+)
+
+/**
+ *
+ * @Author AiTao
+ * @Date 2026-10-23 14:20
+ * @Url
+ **/
+
+func TestCopyUnexported(t *testing.T) {
+	huge, _ := new(big.Int).SetString("-123456789012345678901234567890", 10)
+	third := new(big.Float).SetPrec(200).Quo(big.NewFloat(1), big.NewFloat(3))
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		berlin = time.FixedZone("CET", 3600)
+	}
+	base := time.Date(2024, 5, 6, 7, 8, 9, 123456789, time.UTC)
+	values := []interface{}{
+		base,
+		time.Now(),
+		time.Time{},
+		time.Date(1, 1, 1, 0, 0, 0, 1, time.UTC),
+		time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC),
+		base.In(time.FixedZone("CEST", 2*3600)),
+		base.In(time.Local),
+		base.In(berlin),
+		*big.NewInt(0),
+		*big.NewInt(-42),
+		*huge,
+		*big.NewFloat(0),
+		*new(big.Float).Neg(big.NewFloat(0)),
+		*big.NewFloat(-2.5e-300),
+		*third,
+		*new(big.Float).SetInf(true),
+		big.Rat{},
+		*big.NewRat(-2, 6),
+		*new(big.Rat).SetFrac(huge, big.NewInt(7)),
+	}
+	for _, x := range values {
+		// 通过结构体的不可导出字段获取无法访问的值
+		v := reflect.ValueOf(struct{ v interface{} }{x}).Field(0).Elem()
+		if v.CanInterface() {
+			t.Fatalf("%T: value should not be accessible", x)
+		}
+		p, ok := copyUnexported(v)
+		if !ok {
+			t.Errorf("copyUnexported(%T) failed", x)
+			continue
+		}
+		switch want := x.(type) {
+		case time.Time:
+			got := *p.Interface().(*time.Time)
+			if !got.Equal(want) || got.Location().String() != want.Location().String() || compareLocations(got, want) != 0 {
+				t.Errorf("copyUnexported(%v) = %v", want, got)
+			}
+		case big.Int:
+			if got := p.Interface().(*big.Int); got.Cmp(&want) != 0 {
+				t.Errorf("copyUnexported(%v) = %v", &want, got)
+			}
+		case big.Float:
+			if got := p.Interface().(*big.Float); got.Cmp(&want) != 0 || got.Signbit() != want.Signbit() || got.Prec() != want.Prec() {
+				t.Errorf("copyUnexported(%v) = %v", &want, got)
+			}
+		case big.Rat:
+			if got := p.Interface().(*big.Rat); got.Cmp(&want) != 0 {
+				t.Errorf("copyUnexported(%v) = %v", &want, got)
+			}
+		}
+	}
+	if _, ok := copyUnexported(reflect.ValueOf(struct{ v int }{1}).Field(0)); ok {
+		t.Error("copyUnexported(int) = true, want false")
+	}
+}
@@ -0,0 +1,132 @@
+package version
+
+import (
+	"strconv"
+	"strings"
+
+	comparator "github.com/lmlat/go-comparator"
+	"github.com/lmlat/go-comparator/typed"
+)
+
+/**
+ *
+ * @Author AiTao
+ * @Date 2026-10-19 09:30
+ * @Url
+ **/
+
+// Debian 表示一个 Debian 软件包版本号, 格式为 [epoch:]upstream_version[-debian_revision].
+type Debian struct {
+	Epoch    uint64
+	Upstream string
+	Revision string // Debian 修订号, 为空表示没有修订号
+}
+
+// ParseDebian 函数用于解析 Debian 软件包版本号, 其中修订号为最后一个 "-" 之后的部分.
+func ParseDebian(s string) (Debian, error) {
+	var v Debian
+	rest := strings.TrimSpace(s)
+	if e, r, ok := strings.Cut(rest, ":"); ok {
+		n, err := strconv.ParseUint(e, 10, 64)
+		if err != nil {
+			return Debian{}, invalid("Debian", s)
+		}
+		v.Epoch, rest = n, r
+	}
+	if i := strings.LastIndexByte(rest, '-'); i >= 0 {
+		rest, v.Revision = rest[:i], rest[i+1:]
+		if !validDebian(v.Revision, "+.~") {
+			return Debian{}, invalid("Debian", s)
+		}
+	}
+	if rest == "" || !isDigit(rest[0]) || !validDebian(rest, ".+-~:") {
+		return Debian{}, invalid("Debian", s)
+	}
+	v.Upstream = rest
+	return v, nil
+}
+
+func validDebian(s, symbols string) bool {
+	for i := 0; i < len(s); i++ {
+		if c := s[i]; !isDigit(c) && !isAlpha(c) && strings.IndexByte(symbols, c) < 0 {
+			return false
+		}
+	}
+	return s != ""
+}
+
+// CompareTo 函数用于按照 dpkg 的规则比较两个版本号, 依次比较纪元、上游版本号与修订号.
+func (v Debian) CompareTo(o comparator.Iface) int {
+	w := o.(Debian)
+	if r := typed.Compare(v.Epoch, w.Epoch); r != 0 {
+		return r
+	}
+	if r := debianCompare(v.Upstream, w.Upstream); r != 0 {
+		return r
+	}
+	return debianCompare(v.Revision, w.Revision)
+}
+
+// debianCompare 函数用于按照 dpkg 的 verrevcmp 算法比较两个版本字符串: 交替比较非数字部分与数字部分,
+// 非数字部分中字母排在其它符号之前, "~" 排在所有字符(包括字符串结尾)之前, 数字部分按照数值比较.
+func debianCompare(a, b string) int {
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		for i < len(a) && !isDigit(a[i]) || j < len(b) && !isDigit(b[j]) {
+			ac, bc := debianOrder(a, i), debianOrder(b, j)
+			if ac != bc {
+				return typed.Compare(ac, bc)
+			}
+			i, j = i+1, j+1
+		}
+		si, sj := i, j
+		for i < len(a) && isDigit(a[i]) {
+			i++
+		}
+		for j < len(b) && isDigit(b[j]) {
+			j++
+		}
+		if r := compareNumeric(a[si:i], b[sj:j]); r != 0 {
+			return r
+		}
+	}
+	return 0
+}
+
+// debianOrder 函数用于获取 s[i] 在非数字部分中的排序权重, 数字与字符串结尾的权重为0.
+func debianOrder(s string, i int) int {
+	if i >= len(s) {
+		return 0
+	}
+	switch c := s[i]; {
+	case isDigit(c):
+		return 0
+	case isAlpha(c):
+		return int(c)
+	case c == '~':
+		return -1
+	default:
+		return int(c) + 256
+	}
+}
+
+// String 返回 Debian 软件包版本号的字符串表示形式.
+func (v Debian) String() string {
+	s := v.Upstream
+	if v.Epoch != 0 {
+		s = strconv.FormatUint(v.Epoch, 10) + ":" + s
+	}
+	if v.Revision != "" {
+		s += "-" + v.Revision
+	}
+	return s
+}
+
+// CompareDebian 函数用于比较两个 Debian 软件包版本号字符串, 参数无法解析时触发 panic.
+//
+// Example:
+// CompareDebian("1:1.0", "2.0") 返回 1
+// CompareDebian("1.0~rc1", "1.0") 返回 -1
+func CompareDebian(x, y interface{}) int {
+	return mustParse(x, ParseDebian).CompareTo(mustParse(y, ParseDebian))
+}
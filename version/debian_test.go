@@ -0,0 +1,50 @@
+package version
+
+import (
+	"errors"
+	"testing"
+)
+
+/**
+ *
+ * @Author AiTao
+ * @Date 2026-10-19 09:30
+ * @Url
+ **/
+
+func TestCompareDebian(t *testing.T) {
+	assertAscending(t, CompareDebian, []string{
+		"1.0~~", "1.0~~a", "1.0~", "1.0~rc1", "1.0~rc2", "1.0", "1.0-1", "1.0-1ubuntu1", "1.0-2",
+		"1.0a", "1.0+b1", "1.0.1", "1.2", "1.10", "2.3", "2.30", "1:0.1-0~bpo1", "1:0.1", "1:0.1-1",
+	})
+	tests := []struct {
+		a, b string
+	}{
+		{"1.0", "0:1.0"},
+		{"1.01", "1.1"},
+		{"1.0-0", "1.0"},
+	}
+	for _, tt := range tests {
+		if got := CompareDebian(tt.a, tt.b); got != 0 {
+			t.Errorf("CompareDebian(%q, %q) = %d, want 0", tt.a, tt.b, got)
+		}
+	}
+}
+
+func TestParseDebian(t *testing.T) {
+	v, err := ParseDebian("1:2.30-1-ubuntu~18.04")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.Epoch != 1 || v.Upstream != "2.30-1" || v.Revision != "ubuntu~18.04" {
+		t.Errorf("ParseDebian = %+v", v)
+	}
+	if got, want := v.String(), "1:2.30-1-ubuntu~18.04"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+	for _, s := range []string{"", "a1.0", "x:1.0", "1.0-", "1.0 1", ":1.0"} {
+		if _, err := ParseDebian(s); !errors.Is(err, ErrInvalid) {
+			t.Errorf("ParseDebian(%q) error = %v, want ErrInvalid", s, err)
+		}
+	}
+}
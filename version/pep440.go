@@ -0,0 +1,293 @@
+package version
+
+import (
+	"strconv"
+	"strings"
+
+	comparator "github.com/lmlat/go-comparator"
+	"github.com/lmlat/go-comparator/typed"
+)
+
+/**
+ *
+ * @Author AiTao
+ * @Date 2026-10-19 09:30
+ * @Url
+ **/
+
+// PEP440 表示一个 Python 包版本号(PEP 440), 格式为 [N!]N(.N)*[{a|b|rc}N][.postN][.devN][+local].
+type PEP440 struct {
+	Epoch   int
+	Release []int
+	Pre     string   // 预发布类别, 取值为 "a"、"b"、"rc", 为空表示不是预发布版本
+	PreNum  int      // 预发布序号
+	Post    int      // 发布后版本序号, -1 表示不是发布后版本
+	Dev     int      // 开发版本序号, -1 表示不是开发版本
+	Local   []string // 本地版本标识的各个段, 例如 1.0+ubuntu.1 为 ["ubuntu" "1"]
+}
+
+// preSpellings 为预发布类别的全部写法, 较长的写法排在前面以便优先匹配.
+var preSpellings = []struct{ spelling, kind string }{
+	{"preview", "rc"}, {"alpha", "a"}, {"beta", "b"}, {"pre", "rc"}, {"rc", "rc"}, {"a", "a"}, {"b", "b"}, {"c", "rc"},
+}
+
+// ParsePEP440 函数用于解析 Python 包版本号, 支持 PEP 440 中定义的各种可选写法并进行规范化,
+// 例如 "1.0-ALPHA1" 与 "1.0a1" 等价, "1.0-1" 与 "1.0.post1" 等价.
+func ParsePEP440(s string) (PEP440, error) {
+	v := PEP440{Post: -1, Dev: -1}
+	p := &pepScanner{s: strings.ToLower(strings.TrimSpace(s))}
+	p.s = strings.TrimPrefix(p.s, "v")
+	n, ok := p.number()
+	if !ok {
+		return PEP440{}, invalid("PEP 440", s)
+	}
+	if p.consume("!") {
+		v.Epoch = n
+		if n, ok = p.number(); !ok {
+			return PEP440{}, invalid("PEP 440", s)
+		}
+	}
+	v.Release = append(v.Release, n)
+	for {
+		save := p.s
+		if !p.consume(".") {
+			break
+		}
+		if n, ok = p.number(); !ok {
+			p.s = save
+			break
+		}
+		v.Release = append(v.Release, n)
+	}
+	// 预发布段
+	save := p.s
+	p.separator()
+	if kind, ok := p.word(preSpellings); ok {
+		v.Pre = kind
+		p.separator()
+		v.PreNum, _ = p.number()
+	} else {
+		p.s = save
+	}
+	// 发布后段, 包括隐式的 "-N" 写法
+	save = p.s
+	if p.consume("-") {
+		if v.Post, ok = p.number(); !ok {
+			v.Post, p.s = -1, save
+		}
+	}
+	if v.Post < 0 {
+		save = p.s
+		p.separator()
+		if _, ok := p.word([]struct{ spelling, kind string }{{"post", ""}, {"rev", ""}, {"r", ""}}); ok {
+			p.separator()
+			v.Post, _ = p.number()
+		} else {
+			p.s = save
+		}
+	}
+	// 开发段
+	save = p.s
+	p.separator()
+	if _, ok := p.word([]struct{ spelling, kind string }{{"dev", ""}}); ok {
+		p.separator()
+		v.Dev, _ = p.number()
+	} else {
+		p.s = save
+	}
+	// 本地版本标识
+	if p.consume("+") {
+		v.Local = strings.Split(strings.NewReplacer("-", ".", "_", ".").Replace(p.s), ".")
+		for _, seg := range v.Local {
+			if !validLocal(seg) {
+				return PEP440{}, invalid("PEP 440", s)
+			}
+		}
+		p.s = ""
+	}
+	if p.s != "" {
+		return PEP440{}, invalid("PEP 440", s)
+	}
+	return v, nil
+}
+
+func validLocal(seg string) bool {
+	for i := 0; i < len(seg); i++ {
+		if !isDigit(seg[i]) && !isAlpha(seg[i]) {
+			return false
+		}
+	}
+	return seg != ""
+}
+
+// pepScanner 用于逐段解析 PEP 440 版本号.
+type pepScanner struct {
+	s string
+}
+
+func (p *pepScanner) consume(prefix string) bool {
+	if strings.HasPrefix(p.s, prefix) {
+		p.s = p.s[len(prefix):]
+		return true
+	}
+	return false
+}
+
+func (p *pepScanner) separator() {
+	if p.s != "" && strings.IndexByte("-_.", p.s[0]) >= 0 {
+		p.s = p.s[1:]
+	}
+}
+
+func (p *pepScanner) number() (int, bool) {
+	i := 0
+	for i < len(p.s) && isDigit(p.s[i]) {
+		i++
+	}
+	n, err := strconv.Atoi(p.s[:i])
+	if err != nil {
+		return 0, false
+	}
+	p.s = p.s[i:]
+	return n, true
+}
+
+func (p *pepScanner) word(words []struct{ spelling, kind string }) (string, bool) {
+	for _, w := range words {
+		if p.consume(w.spelling) {
+			return w.kind, true
+		}
+	}
+	return "", false
+}
+
+// CompareTo 函数用于按照 PEP 440 的规则比较两个版本号, 依次比较纪元、发布段(忽略末尾的0)、预发布段、
+// 发布后段、开发段以及本地版本标识. 其中仅包含开发段的版本排在同一发布段的预发布版本之前.
+func (v PEP440) CompareTo(o comparator.Iface) int {
+	w := o.(PEP440)
+	if r := typed.Compare(v.Epoch, w.Epoch); r != 0 {
+		return r
+	}
+	if r := compareRelease(v.Release, w.Release); r != 0 {
+		return r
+	}
+	if r := compareKeys(v.preKey(), w.preKey()); r != 0 {
+		return r
+	}
+	if r := typed.Compare(v.Post, w.Post); r != 0 {
+		return r
+	}
+	if r := typed.Compare(v.devKey(), w.devKey()); r != 0 {
+		return r
+	}
+	return compareLocal(v.Local, w.Local)
+}
+
+var preOrder = map[string]int{"a": 1, "b": 2, "rc": 3}
+
+// preKey 函数用于获取预发布段的排序键, 仅包含开发段的版本最小, 正式版本与发布后版本最大.
+func (v PEP440) preKey() [2]int {
+	switch {
+	case v.Pre != "":
+		return [2]int{preOrder[v.Pre], v.PreNum}
+	case v.Post < 0 && v.Dev >= 0:
+		return [2]int{0, 0}
+	default:
+		return [2]int{4, 0}
+	}
+}
+
+// devKey 函数用于获取开发段的排序键, 开发版本排在对应的非开发版本之前.
+func (v PEP440) devKey() int {
+	if v.Dev < 0 {
+		return int(^uint(0) >> 1)
+	}
+	return v.Dev
+}
+
+func compareKeys(a, b [2]int) int {
+	if r := typed.Compare(a[0], b[0]); r != 0 {
+		return r
+	}
+	return typed.Compare(a[1], b[1])
+}
+
+// compareRelease 函数用于比较两个发布段, 较短的发布段使用0补齐.
+func compareRelease(a, b []int) int {
+	for i := 0; i < len(a) || i < len(b); i++ {
+		var x, y int
+		if i < len(a) {
+			x = a[i]
+		}
+		if i < len(b) {
+			y = b[i]
+		}
+		if r := typed.Compare(x, y); r != 0 {
+			return r
+		}
+	}
+	return 0
+}
+
+// compareLocal 函数用于比较两个本地版本标识, 数字段按照数值比较且大于字母段, 字母段按照字典序比较.
+func compareLocal(a, b []string) int {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		na, nb := allDigits(a[i]), allDigits(b[i])
+		var r int
+		switch {
+		case na && nb:
+			r = compareNumeric(a[i], b[i])
+		case na:
+			r = 1
+		case nb:
+			r = -1
+		default:
+			r = typed.Compare(a[i], b[i])
+		}
+		if r != 0 {
+			return r
+		}
+	}
+	return typed.Compare(len(a), len(b))
+}
+
+// String 返回规范化之后的版本号.
+func (v PEP440) String() string {
+	var sb strings.Builder
+	if v.Epoch != 0 {
+		sb.WriteString(strconv.Itoa(v.Epoch))
+		sb.WriteByte('!')
+	}
+	for i, n := range v.Release {
+		if i > 0 {
+			sb.WriteByte('.')
+		}
+		sb.WriteString(strconv.Itoa(n))
+	}
+	if v.Pre != "" {
+		sb.WriteString(v.Pre)
+		sb.WriteString(strconv.Itoa(v.PreNum))
+	}
+	if v.Post >= 0 {
+		sb.WriteString(".post")
+		sb.WriteString(strconv.Itoa(v.Post))
+	}
+	if v.Dev >= 0 {
+		sb.WriteString(".dev")
+		sb.WriteString(strconv.Itoa(v.Dev))
+	}
+	if len(v.Local) > 0 {
+		sb.WriteByte('+')
+		sb.WriteString(strings.Join(v.Local, "."))
+	}
+	return sb.String()
+}
+
+// ComparePEP440 函数用于比较两个 PEP 440 版本号字符串, 参数无法解析时触发 panic.
+//
+// Example:
+// ComparePEP440("1.0rc1", "1.0") 返回 -1
+// ComparePEP440("1.0.post1", "1.0") 返回 1
+func ComparePEP440(x, y interface{}) int {
+	return mustParse(x, ParsePEP440).CompareTo(mustParse(y, ParsePEP440))
+}
@@ -0,0 +1,54 @@
+package version
+
+import (
+	"errors"
+	"testing"
+)
+
+/**
+ *
+ * @Author AiTao
+ * @Date 2026-10-19 09:30
+ * @Url
+ **/
+
+func TestComparePEP440(t *testing.T) {
+	// PEP 440 中给出的排序示例
+	assertAscending(t, ComparePEP440, []string{
+		"1.0.dev456", "1.0a1", "1.0a2.dev456", "1.0a12.dev456", "1.0a12", "1.0b1.dev456", "1.0b2",
+		"1.0b2.post345.dev456", "1.0b2.post345", "1.0rc1.dev456", "1.0rc1", "1.0", "1.0+abc.5", "1.0+abc.7",
+		"1.0+5", "1.0.post456.dev34", "1.0.post456", "1.0.15", "1.1.dev1", "1!0.1",
+	})
+	tests := []struct {
+		a, b string
+	}{
+		{"1.0", "1.0.0"},
+		{"1.0-ALPHA1", "1.0a1"},
+		{"1.0-beta.2", "1.0b2"},
+		{"1.0pre1", "1.0rc1"},
+		{"1.0-1", "1.0.post1"},
+		{"1.0-r1", "1.0.post1"},
+		{"v1.0.dev", "1.0.dev0"},
+		{"1.0+Ubuntu-1", "1.0+ubuntu.1"},
+	}
+	for _, tt := range tests {
+		if got := ComparePEP440(tt.a, tt.b); got != 0 {
+			t.Errorf("ComparePEP440(%q, %q) = %d, want 0", tt.a, tt.b, got)
+		}
+	}
+}
+
+func TestParsePEP440(t *testing.T) {
+	v, err := ParsePEP440("2!1.2.3-RC.4-post5.dev6+local.7")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := v.String(), "2!1.2.3rc4.post5.dev6+local.7"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+	for _, s := range []string{"", "a", "1.0x", "1.0+", "1.0+a..b", "1.0.", "1!", "1.0 rc1"} {
+		if _, err := ParsePEP440(s); !errors.Is(err, ErrInvalid) {
+			t.Errorf("ParsePEP440(%q) error = %v, want ErrInvalid", s, err)
+		}
+	}
+}
@@ -0,0 +1,170 @@
+package version
+
+import (
+	"strconv"
+	"strings"
+
+	comparator "github.com/lmlat/go-comparator"
+	"github.com/lmlat/go-comparator/typed"
+)
+
+/**
+ *
+ * @Author AiTao
+ * @Date 2026-10-19 09:30
+ * @Url
+ **/
+
+// RPM 表示一个 RPM 软件包版本号, 格式为 [epoch:]version[-release].
+type RPM struct {
+	Epoch   uint64
+	Version string
+	Release string // 发布号, 为空表示没有发布号
+}
+
+// ParseRPM 函数用于解析 RPM 软件包版本号, 其中发布号为最后一个 "-" 之后的部分.
+func ParseRPM(s string) (RPM, error) {
+	var v RPM
+	rest := strings.TrimSpace(s)
+	if e, r, ok := strings.Cut(rest, ":"); ok {
+		n, err := strconv.ParseUint(e, 10, 64)
+		if err != nil {
+			return RPM{}, invalid("RPM", s)
+		}
+		v.Epoch, rest = n, r
+	}
+	if i := strings.LastIndexByte(rest, '-'); i >= 0 {
+		rest, v.Release = rest[:i], rest[i+1:]
+		if v.Release == "" {
+			return RPM{}, invalid("RPM", s)
+		}
+	}
+	if rest == "" {
+		return RPM{}, invalid("RPM", s)
+	}
+	v.Version = rest
+	return v, nil
+}
+
+// CompareTo 函数用于按照 rpm 的规则比较两个版本号, 依次比较纪元、版本号与发布号.
+// 没有发布号的版本排在具有发布号的同一版本之前.
+func (v RPM) CompareTo(o comparator.Iface) int {
+	w := o.(RPM)
+	if r := typed.Compare(v.Epoch, w.Epoch); r != 0 {
+		return r
+	}
+	if r := rpmvercmp(v.Version, w.Version); r != 0 {
+		return r
+	}
+	return rpmvercmp(v.Release, w.Release)
+}
+
+// rpmvercmp 函数实现了 rpm 的版本比较算法: 将字符串拆分为连续的数字段与字母段逐段比较, 其它字符仅作为分隔符.
+// 数字段按照数值比较且大于字母段, "~" 排在所有内容(包括字符串结尾)之前, "^" 排在除字符串结尾之外的所有内容之前.
+func rpmvercmp(a, b string) int {
+	if a == b {
+		return 0
+	}
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		for i < len(a) && !isRPMChar(a[i]) {
+			i++
+		}
+		for j < len(b) && !isRPMChar(b[j]) {
+			j++
+		}
+		if at(a, i) == '~' || at(b, j) == '~' {
+			if at(a, i) != '~' {
+				return 1
+			}
+			if at(b, j) != '~' {
+				return -1
+			}
+			i, j = i+1, j+1
+			continue
+		}
+		if at(a, i) == '^' || at(b, j) == '^' {
+			switch {
+			case i >= len(a):
+				return -1
+			case j >= len(b):
+				return 1
+			case a[i] != '^':
+				return 1
+			case b[j] != '^':
+				return -1
+			}
+			i, j = i+1, j+1
+			continue
+		}
+		if i >= len(a) || j >= len(b) {
+			break
+		}
+		si, sj := i, j
+		numeric := isDigit(a[i])
+		class := isAlpha
+		if numeric {
+			class = isDigit
+		}
+		for i < len(a) && class(a[i]) {
+			i++
+		}
+		for j < len(b) && class(b[j]) {
+			j++
+		}
+		if j == sj {
+			// 两个段的类型不同, 数字段大于字母段
+			if numeric {
+				return 1
+			}
+			return -1
+		}
+		var r int
+		if numeric {
+			r = compareNumeric(a[si:i], b[sj:j])
+		} else {
+			r = typed.Compare(a[si:i], b[sj:j])
+		}
+		if r != 0 {
+			return r
+		}
+	}
+	switch {
+	case i >= len(a) && j >= len(b):
+		return 0
+	case i < len(a):
+		return 1
+	default:
+		return -1
+	}
+}
+
+func isRPMChar(c byte) bool { return isDigit(c) || isAlpha(c) || c == '~' || c == '^' }
+
+func at(s string, i int) byte {
+	if i < len(s) {
+		return s[i]
+	}
+	return 0
+}
+
+// String 返回 RPM 软件包版本号的字符串表示形式.
+func (v RPM) String() string {
+	s := v.Version
+	if v.Epoch != 0 {
+		s = strconv.FormatUint(v.Epoch, 10) + ":" + s
+	}
+	if v.Release != "" {
+		s += "-" + v.Release
+	}
+	return s
+}
+
+// CompareRPM 函数用于比较两个 RPM 软件包版本号字符串, 参数无法解析时触发 panic.
+//
+// Example:
+// CompareRPM("1.0a", "1.0.1") 返回 -1
+// CompareRPM("1.0~rc1", "1.0") 返回 -1
+func CompareRPM(x, y interface{}) int {
+	return mustParse(x, ParseRPM).CompareTo(mustParse(y, ParseRPM))
+}
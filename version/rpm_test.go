@@ -0,0 +1,71 @@
+package version
+
+import (
+	"errors"
+	"testing"
+)
+
+/**
+ *
+ * @Author AiTao
+ * @Date 2026-10-19 09:30
+ * @Url
+ **/
+
+func TestRpmvercmp(t *testing.T) {
+	// 取自 rpm 自带的 rpmvercmp 测试用例
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"1.0", "1.0", 0},
+		{"1.0", "2.0", -1},
+		{"2.0.1", "2.0", 1},
+		{"2.0.1a", "2.0.1", 1},
+		{"5.5p1", "5.5p10", -1},
+		{"5.6p1", "5.5p10", 1},
+		{"10xyz", "10.1xyz", -1},
+		{"xyz10", "xyz10.1", -1},
+		{"xyz.4", "8", -1},
+		{"1.0a", "1.0.1", -1},
+		{"1b.fc17", "1.fc17", -1},
+		{"1.0010", "1.9", 1},
+		{"1.05", "1.5", 0},
+		{"2_0", "2.0", 0},
+		{"1.0~rc1", "1.0", -1},
+		{"1.0~rc1", "1.0~rc2", -1},
+		{"1.0~rc1~git123", "1.0~rc1", -1},
+		{"1.0^", "1.0", 1},
+		{"1.0^git1", "1.0", 1},
+		{"1.0^git1", "1.01", -1},
+		{"1.0^20160101", "1.0.1", -1},
+		{"1.0~rc1^git1", "1.0~rc1", 1},
+		{"1.0^git1~pre", "1.0^git1", -1},
+	}
+	for _, tt := range tests {
+		if got := rpmvercmp(tt.a, tt.b); got != tt.want {
+			t.Errorf("rpmvercmp(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+		if got := rpmvercmp(tt.b, tt.a); got != -tt.want {
+			t.Errorf("rpmvercmp(%q, %q) = %d, want %d", tt.b, tt.a, got, -tt.want)
+		}
+	}
+}
+
+func TestCompareRPM(t *testing.T) {
+	assertAscending(t, CompareRPM, []string{
+		"1.0~rc1-1", "1.0", "1.0-1", "1.0-1.el8", "1.0-2", "1.0.1-1", "1:0.9-1",
+	})
+	v, err := ParseRPM("2:1.2.3-4.fc39")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.Epoch != 2 || v.Version != "1.2.3" || v.Release != "4.fc39" || v.String() != "2:1.2.3-4.fc39" {
+		t.Errorf("ParseRPM = %+v", v)
+	}
+	for _, s := range []string{"", "x:1.0", "1.0-", "-1"} {
+		if _, err := ParseRPM(s); !errors.Is(err, ErrInvalid) {
+			t.Errorf("ParseRPM(%q) error = %v, want ErrInvalid", s, err)
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package version
+
+import (
+	"strconv"
+	"strings"
+
+	comparator "github.com/lmlat/go-comparator"
+	"github.com/lmlat/go-comparator/typed"
+)
+
+/**
+ *
+ * @Author AiTao
+ * @Date 2026-10-19 09:30
+ * @Url
+ **/
+
+// SemVer 表示一个语义化版本号(Semantic Versioning 2.0.0), 格式为 MAJOR.MINOR.PATCH[-PRERELEASE][+BUILD].
+type SemVer struct {
+	Major, Minor, Patch uint64
+	Prerelease          []string // 先行版本号的各个标识符, 例如 1.0.0-alpha.1 为 ["alpha" "1"]
+	Build               []string // 版本编译信息的各个标识符, 不参与比较
+}
+
+// ParseSemVer 函数用于解析语义化版本号, 允许带有前缀 "v", 例如 "v1.2.3".
+func ParseSemVer(s string) (SemVer, error) {
+	var v SemVer
+	rest := strings.TrimPrefix(s, "v")
+	rest, build, hasBuild := strings.Cut(rest, "+")
+	rest, pre, hasPre := strings.Cut(rest, "-")
+	parts := strings.Split(rest, ".")
+	if len(parts) != 3 {
+		return SemVer{}, invalid("semantic", s)
+	}
+	nums := [3]*uint64{&v.Major, &v.Minor, &v.Patch}
+	for i, p := range parts {
+		if !allDigits(p) || len(p) > 1 && p[0] == '0' {
+			return SemVer{}, invalid("semantic", s)
+		}
+		n, err := strconv.ParseUint(p, 10, 64)
+		if err != nil {
+			return SemVer{}, invalid("semantic", s)
+		}
+		*nums[i] = n
+	}
+	if hasPre {
+		v.Prerelease = strings.Split(pre, ".")
+		for _, id := range v.Prerelease {
+			if !validIdentifier(id) || allDigits(id) && len(id) > 1 && id[0] == '0' {
+				return SemVer{}, invalid("semantic", s)
+			}
+		}
+	}
+	if hasBuild {
+		v.Build = strings.Split(build, ".")
+		for _, id := range v.Build {
+			if !validIdentifier(id) {
+				return SemVer{}, invalid("semantic", s)
+			}
+		}
+	}
+	return v, nil
+}
+
+// validIdentifier 函数用于判断标识符是否为非空且仅由 [0-9A-Za-z-] 组成.
+func validIdentifier(id string) bool {
+	for i := 0; i < len(id); i++ {
+		if c := id[i]; !isDigit(c) && !isAlpha(c) && c != '-' {
+			return false
+		}
+	}
+	return id != ""
+}
+
+// CompareTo 函数用于按照语义化版本号的优先级比较两个版本号, 其中先行版本的优先级低于相关联的正式版本,
+// 版本编译信息不参与比较.
+func (v SemVer) CompareTo(o comparator.Iface) int {
+	w := o.(SemVer)
+	if r := typed.Compare(v.Major, w.Major); r != 0 {
+		return r
+	}
+	if r := typed.Compare(v.Minor, w.Minor); r != 0 {
+		return r
+	}
+	if r := typed.Compare(v.Patch, w.Patch); r != 0 {
+		return r
+	}
+	switch {
+	case len(v.Prerelease) == 0 && len(w.Prerelease) == 0:
+		return 0
+	case len(v.Prerelease) == 0:
+		return 1
+	case len(w.Prerelease) == 0:
+		return -1
+	}
+	for i := 0; i < len(v.Prerelease) && i < len(w.Prerelease); i++ {
+		if r := comparePrerelease(v.Prerelease[i], w.Prerelease[i]); r != 0 {
+			return r
+		}
+	}
+	return typed.Compare(len(v.Prerelease), len(w.Prerelease))
+}
+
+// comparePrerelease 函数用于比较两个先行版本标识符, 纯数字的标识符按照数值比较, 且优先级低于非数字标识符.
+func comparePrerelease(a, b string) int {
+	na, nb := allDigits(a), allDigits(b)
+	switch {
+	case na && nb:
+		return compareNumeric(a, b)
+	case na:
+		return -1
+	case nb:
+		return 1
+	default:
+		return typed.Compare(a, b)
+	}
+}
+
+// String 返回语义化版本号的字符串表示形式.
+func (v SemVer) String() string {
+	var sb strings.Builder
+	sb.WriteString(strconv.FormatUint(v.Major, 10))
+	sb.WriteByte('.')
+	sb.WriteString(strconv.FormatUint(v.Minor, 10))
+	sb.WriteByte('.')
+	sb.WriteString(strconv.FormatUint(v.Patch, 10))
+	if len(v.Prerelease) > 0 {
+		sb.WriteByte('-')
+		sb.WriteString(strings.Join(v.Prerelease, "."))
+	}
+	if len(v.Build) > 0 {
+		sb.WriteByte('+')
+		sb.WriteString(strings.Join(v.Build, "."))
+	}
+	return sb.String()
+}
+
+// CompareSemVer 函数用于比较两个语义化版本号字符串, 参数无法解析时触发 panic.
+//
+// Example:
+// CompareSemVer("1.10.0", "1.9.0") 返回 1
+// CompareSemVer("1.0.0-alpha", "1.0.0") 返回 -1
+func CompareSemVer(x, y interface{}) int {
+	return mustParse(x, ParseSemVer).CompareTo(mustParse(y, ParseSemVer))
+}
@@ -0,0 +1,83 @@
+package version
+
+import (
+	"errors"
+	"sort"
+	"testing"
+
+	comparator "github.com/lmlat/go-comparator"
+)
+
+/**
+ *
+ * @Author AiTao
+ * @Date 2026-10-19 09:30
+ * @Url
+ **/
+
+// assertAscending 函数用于断言 versions 中的版本号按照 cmp 严格递增.
+func assertAscending(t *testing.T, cmp comparator.Type, versions []string) {
+	t.Helper()
+	for i := range versions {
+		for j := range versions {
+			want := 0
+			if i < j {
+				want = -1
+			} else if i > j {
+				want = 1
+			}
+			if got := cmp(versions[i], versions[j]); got != want {
+				t.Errorf("compare(%q, %q) = %d, want %d", versions[i], versions[j], got, want)
+			}
+		}
+	}
+}
+
+func TestCompareSemVer(t *testing.T) {
+	assertAscending(t, CompareSemVer, []string{
+		"1.0.0-alpha", "1.0.0-alpha.1", "1.0.0-alpha.beta", "1.0.0-beta", "1.0.0-beta.2",
+		"1.0.0-beta.11", "1.0.0-rc.1", "1.0.0", "1.9.0", "1.10.0", "2.0.0", "10.0.0",
+	})
+	if got := CompareSemVer("1.0.0+build.1", "v1.0.0+build.2"); got != 0 {
+		t.Errorf("CompareSemVer ignores build metadata: got %d, want 0", got)
+	}
+	if got := CompareSemVer("1.0.0-18446744073709551616", "1.0.0-9"); got != 1 {
+		t.Errorf("CompareSemVer large numeric identifier: got %d, want 1", got)
+	}
+}
+
+func TestParseSemVer(t *testing.T) {
+	v, err := ParseSemVer("v1.2.3-rc.1+exp.sha.5114f85")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.Major != 1 || v.Minor != 2 || v.Patch != 3 || len(v.Prerelease) != 2 || len(v.Build) != 3 {
+		t.Errorf("ParseSemVer = %+v", v)
+	}
+	if got, want := v.String(), "1.2.3-rc.1+exp.sha.5114f85"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+	for _, s := range []string{"", "1.2", "1.2.3.4", "01.2.3", "1.2.3-01", "1.2.3-", "1.2.3+", "1.2.3-a..b", "1.2.3-a_b", "a.b.c"} {
+		if _, err := ParseSemVer(s); !errors.Is(err, ErrInvalid) {
+			t.Errorf("ParseSemVer(%q) error = %v, want ErrInvalid", s, err)
+		}
+	}
+}
+
+func TestSemVerIface(t *testing.T) {
+	var vs []comparator.Iface
+	for _, s := range []string{"1.10.0", "1.2.0", "1.9.0-rc.1", "1.9.0"} {
+		v, err := ParseSemVer(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		vs = append(vs, v)
+	}
+	sort.Slice(vs, func(i, j int) bool { return comparator.Comparable(vs[i], vs[j]) < 0 })
+	want := []string{"1.2.0", "1.9.0-rc.1", "1.9.0", "1.10.0"}
+	for i, v := range vs {
+		if v.(SemVer).String() != want[i] {
+			t.Fatalf("sorted = %v, want %v", vs, want)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+// Package version implements parsers and comparators for common version schemes:
+// Semantic Versioning 2.0, PEP 440, Debian and RPM.
+package version
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/lmlat/go-comparator/typed"
+)
+
+/**
+ *
+ * @Author AiTao
+ * @Date 2026-10-19 09:30
+ * @Url
+ **/
+
+// ErrInvalid 表示版本号的格式无效.
+var ErrInvalid = errors.New("version: invalid version")
+
+func invalid(scheme, s string) error {
+	return fmt.Errorf("%w: %q is not a valid %s version", ErrInvalid, s, scheme)
+}
+
+// mustParse 函数用于解析比较器的参数, 解析失败时触发 panic, 与 comparator 包中类型断言失败时的行为保持一致.
+func mustParse[T any](x interface{}, parse func(string) (T, error)) T {
+	s, ok := x.(string)
+	if !ok {
+		panic(fmt.Sprintf("illegal argument: %T is not a string", x))
+	}
+	v, err := parse(s)
+	if err != nil {
+		panic(fmt.Sprintf("illegal argument: %v", err))
+	}
+	return v
+}
+
+// compareNumeric 函数用于比较两个由数字组成的字符串, 忽略前导零, 不受整数位数的限制.
+func compareNumeric(a, b string) int {
+	a, b = strings.TrimLeft(a, "0"), strings.TrimLeft(b, "0")
+	if r := typed.Compare(len(a), len(b)); r != 0 {
+		return r
+	}
+	return typed.Compare(a, b)
+}
+
+func isDigit(c byte) bool { return c >= '0' && c <= '9' }
+
+func isAlpha(c byte) bool { return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' }
+
+func allDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if !isDigit(s[i]) {
+			return false
+		}
+	}
+	return s != ""
+}